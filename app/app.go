@@ -111,6 +111,7 @@ import (
 	seeletypes "github.com/Seele-N/Seele/x/seele/types"
 
 	"github.com/Seele-N/Seele/x/mintx"
+	mintxclient "github.com/Seele-N/Seele/x/mintx/client"
 	mintxkeeper "github.com/Seele-N/Seele/x/mintx/keeper"
 	mintxtypes "github.com/Seele-N/Seele/x/mintx/types"
 )
@@ -137,6 +138,11 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		upgradeclient.CancelProposalHandler,
 		ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
 		seeleclient.ProposalHandler,
+		seeleclient.PendingTokenApprovalProposalHandler,
+		seeleclient.QuarantinedDepositReleaseProposalHandler,
+		mintxclient.AddMintPlanProposalHandler,
+		mintxclient.RemoveMintPlanProposalHandler,
+		mintxclient.UpdateDefaultRewardProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
 
@@ -347,14 +353,20 @@ func New(
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
-	app.MintxKeeper = mintxkeeper.NewKeeper(
-		appCodec, keys[mintxtypes.StoreKey], app.GetSubspace(mintxtypes.ModuleName), &stakingKeeper,
-		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
-	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
 	)
+	app.MintxKeeper = mintxkeeper.NewKeeper(
+		appCodec, keys[mintxtypes.StoreKey], app.GetSubspace(mintxtypes.ModuleName), &stakingKeeper,
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, authtypes.FeeCollectorName,
+	)
+	// the recipients of the minted coins are validated against the module accounts and the blocked addresses
+	moduleAccounts := make([]string, 0, len(maccPerms))
+	for name := range maccPerms {
+		moduleAccounts = append(moduleAccounts, name)
+	}
+	mintxtypes.SetRecipientAccounts(moduleAccounts, app.ModuleAccountAddrs())
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName),
 	)
@@ -406,18 +418,19 @@ func New(
 	)
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
-	app.SeeleKeeper = *seelekeeper.NewKeeper(
+	app.SeeleKeeper = seelekeeper.NewKeeper(
 		appCodec,
 		keys[seeletypes.StoreKey],
 		keys[seeletypes.MemStoreKey],
 		app.GetSubspace(seeletypes.ModuleName),
 		app.BankKeeper,
 		app.TransferKeeper,
-		gravityKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		NewSeeleGravityKeeper(gravityKeeper),
 		app.EvmKeeper,
 		app.StakingKeeper,
-	)
-	seeleModule := seele.NewAppModule(appCodec, app.SeeleKeeper)
+	).WithEvmTracer(cast.ToString(appOpts.Get(seeletypes.FlagEvmTracer)))
+	seeleModule := seele.NewAppModule(appCodec, app.SeeleKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.GravityKeeper = *gravityKeeper.SetHooks(app.SeeleKeeper)
 
@@ -428,7 +441,8 @@ func New(
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
-		AddRoute(seeletypes.RouterKey, seele.NewTokenMappingChangeProposalHandler(app.SeeleKeeper))
+		AddRoute(seeletypes.RouterKey, seele.NewTokenMappingChangeProposalHandler(app.SeeleKeeper)).
+		AddRoute(mintxtypes.RouterKey, mintx.NewMintPlanProposalHandler(app.MintxKeeper))
 
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 
@@ -452,11 +466,12 @@ func New(
 		seelekeeper.NewSendSnpClaimRewardHandler(app.BankKeeper, app.DistrKeeper, app.SeeleKeeper),
 		seelekeeper.NewSendSnpClaimCommissionHandler(app.BankKeeper, app.DistrKeeper, app.SeeleKeeper),
 		seelekeeper.NewSendReSnpStakeHandler(app.BankKeeper, &stakingKeeper, app.SeeleKeeper),
+		seelekeeper.NewSendToEthereumHandler(app.SeeleKeeper),
 	))
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, seele.NewTransferMiddleware(transferModule, app.SeeleKeeper))
 	// this line is used by starport scaffolding # ibc/app/router
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -515,6 +530,7 @@ func New(
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
 		evmtypes.ModuleName,
+		seeletypes.ModuleName,
 		gravitytypes.ModuleName,
 	)
 
@@ -547,8 +563,10 @@ func New(
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	// the messages are counted by the seele keeper to trace its evm calls by message index
+	app.configurator = module.NewConfigurator(app.appCodec, app.SeeleKeeper.TracingMsgServiceRouter(app.MsgServiceRouter(), encodingConfig.TxConfig.TxDecoder()), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
+	app.RegisterUpgradeHandlers()
 
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
@@ -572,6 +590,7 @@ func New(
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
+		seeleModule,
 	)
 
 	app.sm.RegisterStoreDecoders()
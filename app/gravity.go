@@ -0,0 +1,21 @@
+package app
+
+import (
+	gravitykeeper "github.com/peggyjv/gravity-bridge/module/x/gravity/keeper"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// SeeleGravityKeeper exposes the gravity keeper together with its msg server,
+// so the seele module can send tokens to ethereum.
+type SeeleGravityKeeper struct {
+	gravitykeeper.Keeper
+	gravitytypes.MsgServer
+}
+
+// NewSeeleGravityKeeper wraps the gravity keeper for the seele module
+func NewSeeleGravityKeeper(k gravitykeeper.Keeper) SeeleGravityKeeper {
+	return SeeleGravityKeeper{
+		Keeper:    k,
+		MsgServer: gravitykeeper.NewMsgServerImpl(k),
+	}
+}
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	v040 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v040"
+	v043 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v043"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	mintxv2 "github.com/Seele-N/Seele/x/mintx/legacy/v2"
+	mintxtypes "github.com/Seele-N/Seele/x/mintx/types"
+	seelev2 "github.com/Seele-N/Seele/x/seele/legacy/v2"
+	seeletypes "github.com/Seele-N/Seele/x/seele/types"
+)
+
+// GenesisMigrations maps the target versions of the genesis migrations to their callback, the sdk versions
+// migrate the sdk modules and the seele versions migrate the seele modules like the upgrade of the same name.
+// The height of the client context is the last height of the exported state.
+var GenesisMigrations = genutiltypes.MigrationMap{
+	"v0.42": v040.Migrate, // NOTE: v0.40, v0.41 and v0.42 are genesis compatible.
+	"v0.43": v043.Migrate,
+	"v2":    MigrateGenesisV2,
+}
+
+// MigrateGenesisV2 migrates the genesis of x/mintx and x/seele exported by v1 to v2
+func MigrateGenesisV2(appState genutiltypes.AppMap, clientCtx client.Context) genutiltypes.AppMap {
+	cdc := clientCtx.Codec
+
+	if appState[mintxtypes.ModuleName] != nil {
+		var oldMintxState mintxtypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[mintxtypes.ModuleName], &oldMintxState)
+
+		// the total minted can't exceed the supply of the mint denom
+		supply := sdk.ZeroInt()
+		if appState[banktypes.ModuleName] != nil {
+			var bankState banktypes.GenesisState
+			cdc.MustUnmarshalJSON(appState[banktypes.ModuleName], &bankState)
+			supply = bankState.Supply.AmountOf(oldMintxState.Params.MintDenom)
+		}
+
+		appState[mintxtypes.ModuleName] = cdc.MustMarshalJSON(mintxv2.MigrateGenesis(oldMintxState, clientCtx.Height, supply))
+	}
+
+	if appState[seeletypes.ModuleName] != nil {
+		var oldSeeleState seeletypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[seeletypes.ModuleName], &oldSeeleState)
+
+		appState[seeletypes.ModuleName] = cdc.MustMarshalJSON(seelev2.MigrateGenesis(oldSeeleState))
+	}
+
+	return appState
+}
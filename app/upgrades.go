@@ -0,0 +1,50 @@
+package app
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// Upgrade is a software upgrade handled by the app. Its handler runs the migrations of the modules
+// whose consensus version changed, the store upgrades mount the stores added by the upgrade and
+// delete the removed ones.
+type Upgrade struct {
+	Name          string
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// Upgrades lists the upgrades the app can apply, by the name of their upgrade plan
+var Upgrades = []Upgrade{
+	{
+		// migrates the params of x/mintx and x/seele, and the total minted by x/mintx
+		Name: "v2",
+	},
+}
+
+// RegisterUpgradeHandlers registers the handlers of the upgrades and sets the store loader
+// of the upgrade to apply at the current height, it must be called before loading the stores.
+func (app *App) RegisterUpgradeHandlers() {
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(upgrade.Name, func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		})
+	}
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+	for _, upgrade := range Upgrades {
+		if upgrade.Name == upgradeInfo.Name {
+			storeUpgrades := upgrade.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	mintxkeeper "github.com/Seele-N/Seele/x/mintx/keeper"
+	mintxtypes "github.com/Seele-N/Seele/x/mintx/types"
+	seeletypes "github.com/Seele-N/Seele/x/seele/types"
+)
+
+// setupV1State rewrites the state of the modules to the state of their version 1, which had
+// fewer params and didn't track the total minted.
+func setupV1State(t *testing.T, app *App, ctx sdk.Context) {
+	deleteParams := func(moduleName string, keys ...[]byte) {
+		subspace := app.GetSubspace(moduleName)
+		store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), append([]byte(subspace.Name()), '/'))
+		for _, key := range keys {
+			store.Delete(key)
+			require.False(t, subspace.Has(ctx, key))
+		}
+	}
+	deleteParams(mintxtypes.ModuleName,
+		mintxtypes.KeyDefaultRewardPerBlock, mintxtypes.KeyBlocksPerYear, mintxtypes.KeyMaxSupply,
+		mintxtypes.KeyDistributionRecipients, mintxtypes.KeyMintMode, mintxtypes.KeyBondedRatioStartHeight,
+		mintxtypes.KeyInflationRateChange, mintxtypes.KeyInflationMax, mintxtypes.KeyInflationMin,
+		mintxtypes.KeyGoalBonded, mintxtypes.KeyTimeMintPlans, mintxtypes.KeyMaxCatchUpDuration,
+	)
+	deleteParams(seeletypes.ModuleName,
+		seeletypes.KeyGravityBridgeContract, seeletypes.KeyTransferRecordRetention,
+		seeletypes.KeyDeploymentPolicies, seeletypes.KeyUnconvertedGravityDenoms,
+		seeletypes.KeyEvmCallGasLimit, seeletypes.KeyEvmGasRatio,
+	)
+
+	// v1 minted 2 tokens per block from the height adjustment to the fee collector
+	plans := []mintxtypes.MintPlan{mintxtypes.NewMintPlan(0, 1000, sdk.NewDec(2))}
+	app.GetSubspace(mintxtypes.ModuleName).Set(ctx, mintxtypes.KeyMintPlans, &plans)
+	app.MintxKeeper.SetMinter(ctx, mintxtypes.Minter{HeightAdjustment: 11})
+	var mintDenom string
+	app.GetSubspace(mintxtypes.ModuleName).Get(ctx, mintxtypes.KeyMintDenom, &mintDenom)
+	minted := sdk.NewCoins(sdk.NewCoin(mintDenom, sdk.NewInt(180).Mul(mintxtypes.RewardPrecision.TruncateInt())))
+	require.NoError(t, app.MintxKeeper.MintCoins(ctx, minted))
+	require.NoError(t, app.MintxKeeper.AddCollectedFees(ctx, minted))
+
+	vm := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	vm[mintxtypes.ModuleName] = 1
+	vm[seeletypes.ModuleName] = 1
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, vm)
+}
+
+func TestUpgradeV2(t *testing.T) {
+	app := Setup(false, "")
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 101})
+	setupV1State(t, app, ctx)
+	seeleParams := app.GetSubspace(seeletypes.ModuleName)
+	var ibcCroDenom string
+	seeleParams.Get(ctx, seeletypes.KeyIbcCroDenom, &ibcCroDenom)
+
+	require.NotPanics(t, func() {
+		app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v2", Height: ctx.BlockHeight()})
+	})
+
+	vm := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.Equal(t, uint64(2), vm[mintxtypes.ModuleName])
+	require.Equal(t, uint64(2), vm[seeletypes.ModuleName])
+
+	// the v1 params are kept and the new ones are set to their defaults
+	params := app.MintxKeeper.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.Len(t, params.MintPlans, 1)
+	require.Equal(t, uint64(1000), params.MintPlans[0].EndHeight)
+	require.Equal(t, sdk.NewDec(2), params.MintPlans[0].RewardPerBlock)
+	defaults := mintxtypes.DefaultParams()
+	require.Equal(t, defaults.DefaultRewardPerBlock, params.DefaultRewardPerBlock)
+	require.Equal(t, defaults.DistributionRecipients, params.DistributionRecipients)
+	require.Equal(t, defaults.MintMode, params.MintMode)
+	require.Equal(t, defaults.MaxCatchUpDuration, params.MaxCatchUpDuration)
+
+	// the blocks 11 to 100 were minted by v1
+	expected := sdk.NewInt(180).Mul(mintxtypes.RewardPrecision.TruncateInt())
+	require.Equal(t, expected, app.MintxKeeper.GetMinter(ctx).TotalMinted)
+	feeCollector := app.MintxKeeper.GetRecipientTotal(ctx, mintxtypes.RecipientTypeFeeCollector, "")
+	require.Equal(t, expected, feeCollector.Distributed)
+	_, broken := mintxkeeper.AllInvariants(app.MintxKeeper)(ctx)
+	require.False(t, broken)
+
+	seeleParamsV2 := app.SeeleKeeper.GetParams(ctx)
+	require.NoError(t, seeleParamsV2.Validate())
+	require.Equal(t, ibcCroDenom, seeleParamsV2.IbcCroDenom)
+	require.Empty(t, seeleParamsV2.GravityBridgeContract)
+	require.Zero(t, seeleParamsV2.TransferRecordRetention)
+	require.Equal(t, seeletypes.EvmCallGasLimitDefaultValue, seeleParamsV2.EvmCallGasLimit)
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+
+	"github.com/Seele-N/Seele/cmd/seeled/config"
+	seeletypes "github.com/Seele-N/Seele/x/seele/types"
+)
+
+// DebugCmd returns the debug commands, the stock address conversion is replaced by one knowing
+// the evm hex addresses and the prefixes of both networks.
+func DebugCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Tool for helping with debugging your application",
+		RunE:  client.ValidateCmd,
+	}
+
+	cmd.AddCommand(debug.PubkeyCmd())
+	cmd.AddCommand(AddrCmd())
+	cmd.AddCommand(debug.RawBytesCmd())
+	cmd.AddCommand(ModuleAddressCmd())
+	cmd.AddCommand(ContractAddressCmd())
+	cmd.AddCommand(StoreKeyCmd())
+
+	return cmd
+}
+
+// AddrCmd returns a command converting an address between the evm hex form and the bech32 forms
+func AddrCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "addr [address]",
+		Short: "Convert an address between evm hex and the account, valoper and valcons bech32 forms",
+		Long: fmt.Sprintf(`Convert an address given as evm hex (0x...), raw hex bytes or bech32 with any prefix
+to the evm hex form and to the account, valoper and valcons bech32 forms of the mainnet and the testnet.
+
+Example:
+$ %s debug addr 0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2
+$ %s debug addr %svaloper1...
+`, version.AppName, version.AppName, config.Bech32Prefix),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := parseDebugAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Address (hex): %s\n", common.BytesToAddress(addr).Hex())
+			cmd.Printf("Address (bytes): %X\n", addr)
+			for _, prefix := range []string{seeletypes.MainnetBech32Prefix, seeletypes.TestnetBech32Prefix} {
+				for _, form := range []struct {
+					name   string
+					prefix string
+				}{
+					{"Acc", prefix},
+					{"Valoper", prefix + sdk.PrefixValidator + sdk.PrefixOperator},
+					{"Valcons", prefix + sdk.PrefixValidator + sdk.PrefixConsensus},
+				} {
+					bech, err := bech32.ConvertAndEncode(form.prefix, addr)
+					if err != nil {
+						return err
+					}
+					cmd.Printf("Bech32 %s (%s): %s\n", form.name, prefix, bech)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// parseDebugAddress decodes an evm hex address, raw hex bytes or a bech32 address with any prefix
+func parseDebugAddress(s string) ([]byte, error) {
+	if common.IsHexAddress(s) {
+		return common.HexToAddress(s).Bytes(), nil
+	}
+	if bz, err := hex.DecodeString(s); err == nil && len(bz) > 0 {
+		return bz, nil
+	}
+	_, bz, err := bech32.DecodeAndConvert(s)
+	if err != nil {
+		return nil, fmt.Errorf("expected evm hex, raw hex or bech32 address: %w", err)
+	}
+	return bz, nil
+}
+
+// ModuleAddressCmd returns a command printing the address of the module deploying the SRC20 contracts
+func ModuleAddressCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "module-address",
+		Short: "Print the evm address of the seele module deploying the SRC20 contracts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.Printf("Address (hex): %s\n", seeletypes.EVMModuleAddress.Hex())
+			cmd.Printf("Bech32 Acc: %s\n", sdk.AccAddress(seeletypes.EVMModuleAddress.Bytes()))
+			return nil
+		},
+	}
+}
+
+// ContractAddressCmd returns a command computing the address of a contract created by the module
+func ContractAddressCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract-address [nonce]",
+		Short: "Compute the address of the contract created by the seele module at a nonce",
+		Long: fmt.Sprintf(`Compute the address of the contract created by the seele module at a nonce,
+the SRC20 contracts are created with CREATE2 and their address is given by the src20-address query.
+
+Example:
+$ %s debug contract-address 0
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			contract := crypto.CreateAddress(seeletypes.EVMModuleAddress, nonce)
+			cmd.Printf("Address (hex): %s\n", contract.Hex())
+			cmd.Printf("Bech32 Acc: %s\n", sdk.AccAddress(contract.Bytes()))
+			return nil
+		},
+	}
+}
+
+// StoreKeyCmd returns a command decoding a key of the seele store
+func StoreKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "store-key [key]",
+		Short: "Decode a key of the seele store given in hex or base64",
+		Long: fmt.Sprintf(`Decode a key of the seele store given in hex or base64.
+
+Example:
+$ %s debug store-key 0A0000000000000001
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				if key, err = base64.StdEncoding.DecodeString(args[0]); err != nil {
+					return fmt.Errorf("expected hex or base64 store key: %w", err)
+				}
+			}
+			description, err := seeletypes.DescribeStoreKey(key)
+			if err != nil {
+				return err
+			}
+			cmd.Println(description)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	flagGenesisTime    = "genesis-time"
+	flagSkipValidation = "skip-validation"
+)
+
+// MigrateGenesisCmd returns a command migrating an exported genesis with the migrations of the sdk and of the
+// seele modules, the migrated genesis is validated by the module basics unless the validation is skipped.
+func MigrateGenesisCmd(mbm module.BasicManager, migrations genutiltypes.MigrationMap) *cobra.Command {
+	versions := make([]string, 0, len(migrations))
+	for v := range migrations {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+
+	cmd := &cobra.Command{
+		Use:   "migrate [target-version] [genesis-file]",
+		Short: "Migrate genesis to a specified target version",
+		Long: fmt.Sprintf(`Migrate the source genesis into the target version and print to STDOUT.
+The target versions are %s, the genesis must have been exported at the previous version.
+
+Example:
+$ %s migrate v2 /path/to/genesis.json --chain-id=seele_777-2 --genesis-time=2021-11-22T17:00:00Z
+`, strings.Join(versions, ", "), version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			target := args[0]
+			importGenesis := args[1]
+
+			migrationFunc := migrations[target]
+			if migrationFunc == nil {
+				return fmt.Errorf("unknown migration function for version: %s", target)
+			}
+
+			genDoc, err := tmtypes.GenesisDocFromFile(importGenesis)
+			if err != nil {
+				return fmt.Errorf("failed to read genesis document from file %s: %w", importGenesis, err)
+			}
+
+			var initialState genutiltypes.AppMap
+			if err := json.Unmarshal(genDoc.AppState, &initialState); err != nil {
+				return fmt.Errorf("failed to JSON unmarshal initial genesis state: %w", err)
+			}
+
+			// the state was exported at the height before the initial height of the genesis
+			newGenState := migrationFunc(initialState, clientCtx.WithHeight(genDoc.InitialHeight-1))
+
+			if skip, _ := cmd.Flags().GetBool(flagSkipValidation); !skip {
+				if err := mbm.ValidateGenesis(clientCtx.Codec, clientCtx.TxConfig, newGenState); err != nil {
+					return fmt.Errorf("failed to validate migrated genesis state: %w", err)
+				}
+			}
+
+			genDoc.AppState, err = json.Marshal(newGenState)
+			if err != nil {
+				return fmt.Errorf("failed to JSON marshal migrated genesis state: %w", err)
+			}
+
+			genesisTime, _ := cmd.Flags().GetString(flagGenesisTime)
+			if genesisTime != "" {
+				var t time.Time
+
+				err := t.UnmarshalText([]byte(genesisTime))
+				if err != nil {
+					return fmt.Errorf("failed to unmarshal genesis time: %w", err)
+				}
+
+				genDoc.GenesisTime = t
+			}
+
+			chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+			if chainID != "" {
+				genDoc.ChainID = chainID
+			}
+
+			bz, err := tmjson.Marshal(genDoc)
+			if err != nil {
+				return fmt.Errorf("failed to marshal genesis doc: %w", err)
+			}
+
+			sortedBz, err := sdk.SortJSON(bz)
+			if err != nil {
+				return fmt.Errorf("failed to sort JSON genesis doc: %w", err)
+			}
+
+			cmd.Println(string(sortedBz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGenesisTime, "", "override genesis_time with this flag")
+	cmd.Flags().String(flags.FlagChainID, "", "override chain_id with this flag")
+	cmd.Flags().Bool(flagSkipValidation, false, "skip the validation of the migrated genesis, for a genesis migrated again before use")
+
+	return cmd
+}
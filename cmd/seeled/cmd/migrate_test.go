@@ -0,0 +1,121 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/Seele-N/Seele/cmd/seeled/cmd"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of the genesis migrations")
+
+// TestMigrateGenesis migrates a genesis exported by v1 and compares it with the golden file, the root
+// command sets the global config so it's created only once.
+func TestMigrateGenesis(t *testing.T) {
+	rootCmd, _ := cmd.NewRootCmd()
+	home := t.TempDir()
+	// the flags added by svrcmd.Execute can't be added again at every execution
+	rootCmd.PersistentFlags().String(flags.FlagLogLevel, zerolog.InfoLevel.String(), "")
+	rootCmd.PersistentFlags().String(flags.FlagLogFormat, tmcfg.LogFormatPlain, "")
+	executor := tmcli.PrepareBaseCmd(rootCmd, "", home)
+	migrateCmd, _, err := rootCmd.Find([]string{"migrate"})
+	require.NoError(t, err)
+	migrate := func(args ...string) (string, error) {
+		// the pre run of the root command keeps the output of the executed command
+		var out bytes.Buffer
+		migrateCmd.SetOut(&out)
+		rootCmd.SetArgs(append([]string{"migrate"}, args...))
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+		ctx = context.WithValue(ctx, server.ServerContextKey, server.NewDefaultContext())
+		err := executor.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	// the migrated genesis matches the golden file and keeps the exported height
+	out, err := migrate("v2", filepath.Join("testdata", "genesis_v1.json"), "--skip-validation=false")
+	require.NoError(t, err)
+	golden := filepath.Join("testdata", "genesis_v2.json")
+	if *updateGolden {
+		var indented bytes.Buffer
+		require.NoError(t, json.Indent(&indented, []byte(out), "", "  "))
+		require.NoError(t, ioutil.WriteFile(golden, indented.Bytes(), 0o600))
+	}
+	expected, err := ioutil.ReadFile(golden)
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), out)
+
+	var genDoc struct {
+		InitialHeight string `json:"initial_height"`
+		AppState      struct {
+			Mintx struct {
+				Minter struct {
+					TotalMinted string
+				} `json:"minter"`
+				RecipientTotals []struct {
+					Distributed string `json:"distributed"`
+				} `json:"recipient_totals"`
+			} `json:"mintx"`
+			Seele struct {
+				EthereumTransfers     []json.RawMessage `json:"ethereum_transfers"`
+				TransferRecords       []json.RawMessage `json:"transfer_records"`
+				LastTransferRecordID  string            `json:"last_transfer_record_id"`
+				QuarantinedDeposits   []json.RawMessage `json:"quarantined_deposits"`
+				PendingTokenDeposits  []json.RawMessage `json:"pending_token_deposits"`
+				PendingTokenApprovals []json.RawMessage `json:"pending_token_approvals"`
+			} `json:"seele"`
+		} `json:"app_state"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &genDoc))
+	require.Equal(t, "4", genDoc.InitialHeight)
+	// the 3 blocks of v1 minted 200 seele each
+	require.Equal(t, "600000000000000000000", genDoc.AppState.Mintx.Minter.TotalMinted)
+	require.Len(t, genDoc.AppState.Mintx.RecipientTotals, 1)
+	require.Equal(t, "600000000000000000000", genDoc.AppState.Mintx.RecipientTotals[0].Distributed)
+	// the transfers, the records, the deposits and the approvals of v1 are kept
+	migrated := genDoc.AppState.Seele
+	require.Len(t, migrated.EthereumTransfers, 1)
+	require.Len(t, migrated.TransferRecords, 4)
+	require.Equal(t, "4", migrated.LastTransferRecordID)
+	require.Len(t, migrated.QuarantinedDeposits, 1)
+	require.Len(t, migrated.PendingTokenDeposits, 1)
+	require.Len(t, migrated.PendingTokenApprovals, 1)
+
+	// the chain id and the genesis time can be overridden
+	out, err = migrate("v2", filepath.Join("testdata", "genesis_v1.json"), "--chain-id", "seele_777-2", "--genesis-time", "2021-11-22T17:00:00Z")
+	require.NoError(t, err)
+	require.Contains(t, out, `"chain_id":"seele_777-2"`)
+	require.Contains(t, out, `"genesis_time":"2021-11-22T17:00:00Z"`)
+
+	_, err = migrate("v1", filepath.Join("testdata", "genesis_v1.json"))
+	require.Error(t, err)
+
+	// the migrated genesis is validated unless the validation is skipped
+	var invalid map[string]interface{}
+	bz, err := ioutil.ReadFile(filepath.Join("testdata", "genesis_v1.json"))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, &invalid))
+	seele := invalid["app_state"].(map[string]interface{})["seele"].(map[string]interface{})
+	seele["params"].(map[string]interface{})["seele_admin"] = "invalid"
+	invalidFile := filepath.Join(t.TempDir(), "genesis.json")
+	bz, err = json.Marshal(invalid)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(invalidFile, bz, 0o600))
+
+	_, err = migrate("v2", invalidFile)
+	require.Error(t, err)
+	_, err = migrate("v2", invalidFile, "--skip-validation")
+	require.NoError(t, err)
+}
@@ -19,7 +19,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
-	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -40,6 +39,7 @@ import (
 	ethermint "github.com/tharsis/ethermint/types"
 
 	seelecli "github.com/Seele-N/Seele/x/seele/client/cli"
+	seeletypes "github.com/Seele-N/Seele/x/seele/types"
 
 	"github.com/Seele-N/Seele/app"
 	// this line is used by starport scaffolding # stargate/root/import
@@ -111,13 +111,13 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 			genutilcli.InitCmd(app.ModuleBasics, app.DefaultNodeHome),
 		),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
-		genutilcli.MigrateGenesisCmd(),
+		MigrateGenesisCmd(app.ModuleBasics, app.GenesisMigrations),
 		genutilcli.GenTxCmd(app.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
-		ethermintclient.TestnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		TestnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
+		DebugCmd(),
 		config.Cmd(),
 		// this line is used by starport scaffolding # stargate/root/commands
 	)
@@ -195,10 +195,42 @@ func txCommand() *cobra.Command {
 	return cmd
 }
 
+// SeeleConfig defines the configuration of the seele module of the node
+type SeeleConfig struct {
+	// EvmTracer is the tracer of the evm calls made by the module: "", "struct" or "call"
+	EvmTracer string `mapstructure:"evm-tracer"`
+}
+
+// AppConfig extends the ethermint app config with the seele section
+type AppConfig struct {
+	servercfg.Config `mapstructure:",squash"`
+
+	Seele SeeleConfig `mapstructure:"seele"`
+}
+
+// seeleConfigTemplate is the app.toml template of the seele section
+const seeleConfigTemplate = `
+###############################################################################
+###                             Seele Configuration                         ###
+###############################################################################
+
+[seele]
+
+# Tracer of the evm calls made by the seele module, the traces of the recent txs are kept in memory
+# and served by the evm traces query. Valid types are: "" (disabled), "struct" and "call".
+evm-tracer = "{{ .Seele.EvmTracer }}"
+`
+
 // initAppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
-	return servercfg.AppConfig(ethermint.AttoPhoton)
+	template, cfg := servercfg.AppConfig(ethermint.AttoPhoton)
+	return template + seeleConfigTemplate, AppConfig{
+		Config: cfg.(servercfg.Config),
+		Seele: SeeleConfig{
+			EvmTracer: seeletypes.EvmTracerNone,
+		},
+	}
 }
 
 type appCreator struct {
@@ -0,0 +1,596 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+	"github.com/spf13/cobra"
+	tmconfig "github.com/tendermint/tendermint/config"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+	"github.com/tharsis/ethermint/crypto/hd"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/Seele-N/Seele/app"
+	mintxtypes "github.com/Seele-N/Seele/x/mintx/types"
+	seeletypes "github.com/Seele-N/Seele/x/seele/types"
+)
+
+const (
+	flagNodeDirPrefix      = "node-dir-prefix"
+	flagNumValidators      = "v"
+	flagOutputDir          = "output-dir"
+	flagNodeDaemonHome     = "node-daemon-home"
+	flagIPAddrs            = "ip-addresses"
+	flagSeeleAdmin         = "seele-admin"
+	flagAutoDeployment     = "auto-deployment"
+	flagPredeployDenoms    = "predeploy-denoms"
+	flagIbcChannel         = "ibc-channel"
+	flagIbcBaseDenom       = "ibc-base-denom"
+	flagGravityContract    = "gravity-contract"
+	flagGravityChainID     = "gravity-chain-id"
+	flagMintRewardPerBlock = "mint-reward-per-block"
+	flagEvmAccounts        = "evm-accounts"
+	flagEvmAccountCoins    = "evm-account-coins"
+)
+
+const nodeDirPerm = 0o755
+
+// testnetConfig holds the options of the testnet command
+type testnetConfig struct {
+	outputDir      string
+	chainID        string
+	minGasPrices   string
+	nodeDirPrefix  string
+	nodeDaemonHome string
+	keyringBackend string
+	algo           string
+	ipAddresses    []string
+	numValidators  int
+
+	// seele admin, the first validator when empty
+	seeleAdmin      string
+	autoDeployment  bool
+	predeployDenoms []string
+	// the counterparty of the ibc seele denom, the default params are kept when the base denom is empty
+	ibcChannel   string
+	ibcBaseDenom string
+	// the gravity counterparty, the default params are kept when the contract is empty
+	gravityContract string
+	gravityChainID  uint64
+	// a single mint plan replacing the default ones when positive
+	mintRewardPerBlock sdk.Dec
+	evmAccounts        []common.Address
+	evmAccountCoins    sdk.Coins
+}
+
+// TestnetCmd initializes all the files of a local seele testnet: the seele params, the mint plans and the
+// bridge counterparties are configured, the system contracts are deployed at genesis and evm accounts are funded
+func TestnetCmd(mbm module.BasicManager, genBalIterator banktypes.GenesisBalancesIterator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "testnet",
+		Short: "Initialize files for a Seele testnet",
+		Long: `testnet will create "v" number of directories and populate each with
+necessary files (private validator, genesis, config, etc.).
+
+The seele module is administered by the first validator unless --seele-admin is given, the module SRC20
+contracts of --predeploy-denoms are deployed at genesis along with the system contracts, and the evm accounts
+of --evm-accounts are funded. The ibc and gravity counterparties are configured with the --ibc-* and
+--gravity-* flags.
+
+Note, strict routability for addresses is turned off in the config file.`,
+
+		Example: "seeled testnet --v 4 --keyring-backend test --output-dir ./output --ip-addresses 192.168.10.2 --evm-accounts 0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			nodeConfig := serverCtx.Config
+
+			cfg, err := parseTestnetFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			return initTestnet(clientCtx, cmd, nodeConfig, mbm, genBalIterator, cfg)
+		},
+	}
+
+	cmd.Flags().Int(flagNumValidators, 4, "Number of validators to initialize the testnet with")
+	cmd.Flags().StringP(flagOutputDir, "o", "./mytestnet", "Directory to store initialization data for the testnet")
+	cmd.Flags().String(flagNodeDirPrefix, "node", "Prefix the directory name for each node with (node results in node0, node1, ...)")
+	cmd.Flags().String(flagNodeDaemonHome, app.Name+"d", "Home directory of the node's daemon configuration")
+	cmd.Flags().StringSlice(flagIPAddrs, []string{"192.168.0.1"}, "List of IP addresses to use (i.e. `192.168.0.1,172.168.0.1` results in persistent peers list ID0@192.168.0.1:46656, ID1@172.168.0.1)")
+	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
+	cmd.Flags().String(server.FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 0.01seele)")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flags.FlagKeyAlgorithm, string(hd.EthSecp256k1Type), "Key signing algorithm to generate keys for")
+
+	cmd.Flags().String(flagSeeleAdmin, "", "The bech32 address of the seele admin, the first validator by default")
+	cmd.Flags().Bool(flagAutoDeployment, true, "Enable the auto deployment of the SRC20 contracts")
+	cmd.Flags().StringSlice(flagPredeployDenoms, []string{app.DefaultBondDenom}, "The denoms whose SRC20 contract is deployed at genesis")
+	cmd.Flags().String(flagIbcChannel, "channel-0", "The channel of the counterparty sending the ibc seele vouchers")
+	cmd.Flags().String(flagIbcBaseDenom, app.DefaultMintDenom, "The counterparty denom of the ibc seele vouchers, the default params are kept when empty")
+	cmd.Flags().String(flagGravityContract, "", "The gravity bridge contract of the ethereum counterparty")
+	cmd.Flags().Uint64(flagGravityChainID, 0, "The chain id of the ethereum counterparty of the gravity bridge")
+	cmd.Flags().String(flagMintRewardPerBlock, "", "A constant reward per block replacing the default mint plans")
+	cmd.Flags().StringSlice(flagEvmAccounts, []string{}, "The hex addresses of the evm accounts funded at genesis")
+	cmd.Flags().String(flagEvmAccountCoins, "1000000000000000000000"+app.DefaultMintDenom+",1000000000000000000000"+app.DefaultBondDenom, "The coins of each evm account")
+	return cmd
+}
+
+// parseTestnetFlags reads and validates the flags of the testnet command
+func parseTestnetFlags(cmd *cobra.Command) (testnetConfig, error) {
+	var cfg testnetConfig
+	cfg.outputDir, _ = cmd.Flags().GetString(flagOutputDir)
+	cfg.keyringBackend, _ = cmd.Flags().GetString(flags.FlagKeyringBackend)
+	cfg.chainID, _ = cmd.Flags().GetString(flags.FlagChainID)
+	cfg.minGasPrices, _ = cmd.Flags().GetString(server.FlagMinGasPrices)
+	cfg.nodeDirPrefix, _ = cmd.Flags().GetString(flagNodeDirPrefix)
+	cfg.nodeDaemonHome, _ = cmd.Flags().GetString(flagNodeDaemonHome)
+	cfg.ipAddresses, _ = cmd.Flags().GetStringSlice(flagIPAddrs)
+	cfg.numValidators, _ = cmd.Flags().GetInt(flagNumValidators)
+	cfg.algo, _ = cmd.Flags().GetString(flags.FlagKeyAlgorithm)
+
+	cfg.seeleAdmin, _ = cmd.Flags().GetString(flagSeeleAdmin)
+	cfg.autoDeployment, _ = cmd.Flags().GetBool(flagAutoDeployment)
+	cfg.predeployDenoms, _ = cmd.Flags().GetStringSlice(flagPredeployDenoms)
+	cfg.ibcChannel, _ = cmd.Flags().GetString(flagIbcChannel)
+	cfg.ibcBaseDenom, _ = cmd.Flags().GetString(flagIbcBaseDenom)
+	cfg.gravityContract, _ = cmd.Flags().GetString(flagGravityContract)
+	cfg.gravityChainID, _ = cmd.Flags().GetUint64(flagGravityChainID)
+
+	if len(cfg.ipAddresses) == 0 {
+		return cfg, errors.New("IP address list cannot be empty")
+	}
+
+	if cfg.seeleAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(cfg.seeleAdmin); err != nil {
+			return cfg, fmt.Errorf("invalid seele admin: %w", err)
+		}
+	}
+
+	if cfg.gravityContract != "" && !common.IsHexAddress(cfg.gravityContract) {
+		return cfg, fmt.Errorf("invalid gravity contract: %s", cfg.gravityContract)
+	}
+
+	cfg.mintRewardPerBlock = sdk.ZeroDec()
+	if reward, _ := cmd.Flags().GetString(flagMintRewardPerBlock); reward != "" {
+		var err error
+		if cfg.mintRewardPerBlock, err = sdk.NewDecFromStr(reward); err != nil {
+			return cfg, fmt.Errorf("invalid mint reward per block: %w", err)
+		}
+	}
+
+	evmAccounts, _ := cmd.Flags().GetStringSlice(flagEvmAccounts)
+	for _, account := range evmAccounts {
+		if !common.IsHexAddress(account) {
+			return cfg, fmt.Errorf("invalid evm account: %s", account)
+		}
+		cfg.evmAccounts = append(cfg.evmAccounts, common.HexToAddress(account))
+	}
+
+	coins, _ := cmd.Flags().GetString(flagEvmAccountCoins)
+	var err error
+	if cfg.evmAccountCoins, err = sdk.ParseCoinsNormalized(coins); err != nil {
+		return cfg, fmt.Errorf("invalid evm account coins: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// initTestnet initializes the testnet configuration
+func initTestnet(
+	clientCtx client.Context,
+	cmd *cobra.Command,
+	nodeConfig *tmconfig.Config,
+	mbm module.BasicManager,
+	genBalIterator banktypes.GenesisBalancesIterator,
+	cfg testnetConfig,
+) error {
+	if cfg.chainID == "" {
+		cfg.chainID = fmt.Sprintf("%s_%d-1", app.Name, tmrand.Int63n(9999999999999)+1)
+	}
+
+	if !ethermint.IsValidChainID(cfg.chainID) {
+		return fmt.Errorf("invalid chain-id: %s", cfg.chainID)
+	}
+
+	numValidators := cfg.numValidators
+	if len(cfg.ipAddresses) > 1 {
+		numValidators = len(cfg.ipAddresses)
+	}
+
+	nodeIDs := make([]string, numValidators)
+	valPubKeys := make([]cryptotypes.PubKey, numValidators)
+
+	configTemplate, customConfig := initAppConfig()
+	appConfig := customConfig.(AppConfig)
+	appConfig.MinGasPrices = cfg.minGasPrices
+	appConfig.API.Enable = true
+	appConfig.Telemetry.Enabled = true
+	appConfig.Telemetry.PrometheusRetentionTime = 60
+	appConfig.Telemetry.EnableHostnameLabel = false
+	appConfig.Telemetry.GlobalLabels = [][]string{{"chain_id", cfg.chainID}}
+	srvconfig.SetConfigTemplate(configTemplate)
+
+	var (
+		genAccounts []authtypes.GenesisAccount
+		genBalances []banktypes.Balance
+		genFiles    []string
+		valAddrs    []sdk.AccAddress
+	)
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	// generate private keys, node IDs, and initial transactions
+	for i := 0; i < numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", cfg.nodeDirPrefix, i)
+		nodeDir := filepath.Join(cfg.outputDir, nodeDirName, cfg.nodeDaemonHome)
+		gentxsDir := filepath.Join(cfg.outputDir, "gentxs")
+
+		nodeConfig.SetRoot(nodeDir)
+		nodeConfig.RPC.ListenAddress = "tcp://0.0.0.0:26657"
+
+		if err := os.MkdirAll(filepath.Join(nodeDir, "config"), nodeDirPerm); err != nil {
+			_ = os.RemoveAll(cfg.outputDir)
+			return err
+		}
+
+		nodeConfig.Moniker = nodeDirName
+
+		var (
+			ip  string
+			err error
+		)
+
+		if len(cfg.ipAddresses) == 1 {
+			ip, err = getIP(i, cfg.ipAddresses[0])
+			if err != nil {
+				_ = os.RemoveAll(cfg.outputDir)
+				return err
+			}
+		} else {
+			ip = cfg.ipAddresses[i]
+		}
+
+		nodeIDs[i], valPubKeys[i], err = genutil.InitializeNodeValidatorFiles(nodeConfig)
+		if err != nil {
+			_ = os.RemoveAll(cfg.outputDir)
+			return err
+		}
+
+		memo := fmt.Sprintf("%s@%s:26656", nodeIDs[i], ip)
+		genFiles = append(genFiles, nodeConfig.GenesisFile())
+
+		kb, err := keyring.New(
+			sdk.KeyringServiceName(),
+			cfg.keyringBackend,
+			nodeDir,
+			inBuf,
+			hd.EthSecp256k1Option(),
+		)
+		if err != nil {
+			return err
+		}
+
+		keyringAlgos, _ := kb.SupportedAlgorithms()
+		algo, err := keyring.NewSigningAlgoFromString(cfg.algo, keyringAlgos)
+		if err != nil {
+			return err
+		}
+
+		addr, secret, err := server.GenerateSaveCoinKey(kb, nodeDirName, true, algo)
+		if err != nil {
+			_ = os.RemoveAll(cfg.outputDir)
+			return err
+		}
+		valAddrs = append(valAddrs, addr)
+
+		info := map[string]string{"secret": secret}
+
+		cliPrint, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+
+		// save private key seed words
+		if err := writeFile(fmt.Sprintf("%v.json", "key_seed"), nodeDir, cliPrint); err != nil {
+			return err
+		}
+
+		// the validators hold the bond denom to stake and the evm denom to pay the evm gas
+		accTokens := sdk.TokensFromConsensusPower(5000, ethermint.PowerReduction)
+		coins := sdk.NewCoins(
+			sdk.NewCoin(app.DefaultBondDenom, accTokens),
+			sdk.NewCoin(app.DefaultMintDenom, accTokens),
+		)
+
+		genBalances = append(genBalances, banktypes.Balance{Address: addr.String(), Coins: coins})
+		genAccounts = append(genAccounts, newEthAccount(addr))
+
+		valTokens := sdk.TokensFromConsensusPower(100, ethermint.PowerReduction)
+		createValMsg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(addr),
+			valPubKeys[i],
+			sdk.NewCoin(app.DefaultBondDenom, valTokens),
+			stakingtypes.NewDescription(nodeDirName, "", "", "", ""),
+			stakingtypes.NewCommissionRates(sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			sdk.OneInt(),
+		)
+		if err != nil {
+			return err
+		}
+
+		txBuilder := clientCtx.TxConfig.NewTxBuilder()
+		if err := txBuilder.SetMsgs(createValMsg); err != nil {
+			return err
+		}
+
+		txBuilder.SetMemo(memo)
+
+		txFactory := tx.Factory{}
+		txFactory = txFactory.
+			WithChainID(cfg.chainID).
+			WithMemo(memo).
+			WithKeybase(kb).
+			WithTxConfig(clientCtx.TxConfig)
+
+		if err := tx.Sign(txFactory, nodeDirName, txBuilder, false); err != nil {
+			return err
+		}
+
+		txBz, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return err
+		}
+
+		if err := writeFile(fmt.Sprintf("%v.json", nodeDirName), gentxsDir, txBz); err != nil {
+			return err
+		}
+
+		srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config/app.toml"), appConfig)
+	}
+
+	for _, account := range cfg.evmAccounts {
+		addr := sdk.AccAddress(account.Bytes())
+		genBalances = append(genBalances, banktypes.Balance{Address: addr.String(), Coins: cfg.evmAccountCoins})
+		genAccounts = append(genAccounts, newEthAccount(addr))
+	}
+
+	if cfg.seeleAdmin == "" {
+		cfg.seeleAdmin = valAddrs[0].String()
+	}
+
+	if err := initGenFiles(clientCtx, mbm, cfg, genAccounts, genBalances, genFiles); err != nil {
+		return err
+	}
+
+	err := collectGenFiles(
+		clientCtx, nodeConfig, cfg.chainID, nodeIDs, valPubKeys, numValidators,
+		cfg.outputDir, cfg.nodeDirPrefix, cfg.nodeDaemonHome, genBalIterator,
+	)
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintErrf("Successfully initialized %d node directories\n", numValidators)
+	for i, addr := range valAddrs {
+		cmd.PrintErrf("Validator %d: %s (%s)\n", i, addr, common.BytesToAddress(addr).Hex())
+	}
+	cmd.PrintErrf("Seele admin: %s\n", cfg.seeleAdmin)
+	return nil
+}
+
+// newEthAccount returns a genesis account without code
+func newEthAccount(addr sdk.AccAddress) *ethermint.EthAccount {
+	return &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(addr, nil, 0, 0),
+		CodeHash:    common.BytesToHash(evmtypes.EmptyCodeHash).Hex(),
+	}
+}
+
+// initGenFiles writes the genesis files of the validators, the module genesis states are the defaults
+// of the app configured for the testnet
+func initGenFiles(
+	clientCtx client.Context,
+	mbm module.BasicManager,
+	cfg testnetConfig,
+	genAccounts []authtypes.GenesisAccount,
+	genBalances []banktypes.Balance,
+	genFiles []string,
+) error {
+	appGenState := mbm.DefaultGenesis(clientCtx.Codec)
+
+	// set the accounts in the genesis state
+	var authGenState authtypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[authtypes.ModuleName], &authGenState)
+
+	accounts, err := authtypes.PackAccounts(genAccounts)
+	if err != nil {
+		return err
+	}
+
+	authGenState.Accounts = accounts
+	appGenState[authtypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&authGenState)
+
+	// set the balances in the genesis state
+	var bankGenState banktypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[banktypes.ModuleName], &bankGenState)
+
+	bankGenState.Balances = genBalances
+	appGenState[banktypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&bankGenState)
+
+	var seeleGenState seeletypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[seeletypes.ModuleName], &seeleGenState)
+
+	seeleGenState.Params.SeeleAdmin = cfg.seeleAdmin
+	seeleGenState.Params.EnableAutoDeployment = cfg.autoDeployment
+	seeleGenState.PredeployedDenoms = cfg.predeployDenoms
+	if cfg.ibcBaseDenom != "" {
+		trace := ibctransfertypes.DenomTrace{
+			Path:      fmt.Sprintf("%s/%s", ibctransfertypes.PortID, cfg.ibcChannel),
+			BaseDenom: cfg.ibcBaseDenom,
+		}
+		seeleGenState.Params.IbcCroDenom = trace.IBCDenom()
+	}
+	if cfg.gravityContract != "" {
+		seeleGenState.Params.GravityBridgeContract = common.HexToAddress(cfg.gravityContract).Hex()
+	}
+	if err := seeleGenState.Validate(); err != nil {
+		return err
+	}
+	appGenState[seeletypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&seeleGenState)
+
+	var gravityGenState gravitytypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[gravitytypes.ModuleName], &gravityGenState)
+
+	if cfg.gravityContract != "" {
+		gravityGenState.Params.BridgeEthereumAddress = common.HexToAddress(cfg.gravityContract).Hex()
+	}
+	if cfg.gravityChainID != 0 {
+		gravityGenState.Params.BridgeChainId = cfg.gravityChainID
+	}
+	appGenState[gravitytypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&gravityGenState)
+
+	var mintGenState mintxtypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[mintxtypes.ModuleName], &mintGenState)
+
+	if cfg.mintRewardPerBlock.IsPositive() {
+		mintGenState.Params.DefaultRewardPerBlock = cfg.mintRewardPerBlock
+		mintGenState.Params.MintPlans = []mintxtypes.MintPlan{
+			mintxtypes.NewMintPlan(0, ^uint64(0), cfg.mintRewardPerBlock),
+		}
+	}
+	if err := mintxtypes.ValidateGenesis(mintGenState); err != nil {
+		return err
+	}
+	appGenState[mintxtypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&mintGenState)
+
+	appGenStateJSON, err := json.MarshalIndent(appGenState, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	genDoc := tmtypes.GenesisDoc{
+		ChainID:    cfg.chainID,
+		AppState:   appGenStateJSON,
+		Validators: nil,
+	}
+
+	// generate empty genesis files for each validator and save
+	for _, genFile := range genFiles {
+		if err := genDoc.SaveAs(genFile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func collectGenFiles(
+	clientCtx client.Context, nodeConfig *tmconfig.Config, chainID string,
+	nodeIDs []string, valPubKeys []cryptotypes.PubKey, numValidators int,
+	outputDir, nodeDirPrefix, nodeDaemonHome string, genBalIterator banktypes.GenesisBalancesIterator,
+) error {
+	var appState json.RawMessage
+	genTime := tmtime.Now()
+
+	for i := 0; i < numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
+		nodeDir := filepath.Join(outputDir, nodeDirName, nodeDaemonHome)
+		gentxsDir := filepath.Join(outputDir, "gentxs")
+		nodeConfig.Moniker = nodeDirName
+
+		nodeConfig.SetRoot(nodeDir)
+
+		nodeID, valPubKey := nodeIDs[i], valPubKeys[i]
+		initCfg := genutiltypes.NewInitConfig(chainID, gentxsDir, nodeID, valPubKey)
+
+		genDoc, err := tmtypes.GenesisDocFromFile(nodeConfig.GenesisFile())
+		if err != nil {
+			return err
+		}
+
+		nodeAppState, err := genutil.GenAppStateFromConfig(clientCtx.Codec, clientCtx.TxConfig, nodeConfig, initCfg, *genDoc, genBalIterator)
+		if err != nil {
+			return err
+		}
+
+		if appState == nil {
+			// set the canonical application state (they should not differ)
+			appState = nodeAppState
+		}
+
+		genFile := nodeConfig.GenesisFile()
+
+		// overwrite each validator's genesis file to have a canonical genesis time
+		if err := genutil.ExportGenesisFileWithTime(genFile, chainID, nil, appState, genTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getIP(i int, startingIPAddr string) (ip string, err error) {
+	if len(startingIPAddr) == 0 {
+		ip, err = server.ExternalIP()
+		if err != nil {
+			return "", err
+		}
+		return ip, nil
+	}
+	return calculateIP(startingIPAddr, i)
+}
+
+func calculateIP(ip string, i int) (string, error) {
+	ipv4 := net.ParseIP(ip).To4()
+	if ipv4 == nil {
+		return "", fmt.Errorf("%v: non ipv4 address", ip)
+	}
+
+	for j := 0; j < i; j++ {
+		ipv4[3]++
+	}
+
+	return ipv4.String(), nil
+}
+
+func writeFile(name, dir string, contents []byte) error {
+	file := filepath.Join(dir, name)
+
+	if err := tmos.EnsureDir(dir, nodeDirPerm); err != nil {
+		return err
+	}
+
+	return tmos.WriteFile(file, contents, 0o644)
+}
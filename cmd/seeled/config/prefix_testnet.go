@@ -2,11 +2,15 @@
 
 package config
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	seeletypes "github.com/Seele-N/Seele/x/seele/types"
+)
 
 const (
 	// Bech32Prefix defines the Bech32 prefix used for Seele Accounts
-	Bech32Prefix = "tseele"
+	Bech32Prefix = seeletypes.TestnetBech32Prefix
 
 	// Bech32PrefixAccAddr defines the Bech32 prefix of an account's address
 	Bech32PrefixAccAddr = Bech32Prefix
@@ -9,7 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var Precision_Mul = sdk.NewDec(10).Power(18)
+var Precision_Mul = types.RewardPrecision
 
 // BeginBlocker mints new tokens for the previous block.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
@@ -22,12 +22,45 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 
-	rewardAmount := params.GetRewardByHeight(uint64(ctx.BlockHeight()))
+	if err := k.ReleaseVestedCoins(ctx, params); err != nil {
+		panic(err)
+	}
+
+	// the time of every block is recorded so that the time mode only mints the time elapsed since the previous block
+	lastMintTime := minter.LastMintTime
+	minter.LastMintTime = ctx.BlockTime()
+
+	var provision sdk.Int
+	var bondedRatio sdk.Dec
+	if params.IsBondedRatioTargeting(uint64(ctx.BlockHeight())) {
+		// recalculate the inflation rate toward the goal bonded ratio
+		bondedRatio = k.BondedRatio(ctx)
+		inflation := k.GetTargetingInflation(ctx, params, minter)
+		minter.Inflation = params.NextInflationRate(inflation, bondedRatio)
+		minter.InflationStarted = true
+		provision = params.BlockProvision(minter.Inflation, k.GetSupply(ctx, params.MintDenom).Amount)
+	} else if params.IsTimeMinting() {
+		// the amount doesn't depend on the block times, the period after a halt is capped
+		from := params.CatchUpStartTime(lastMintTime, ctx.BlockTime())
+		provision = params.GetMintedAmountBetweenTimes(from, ctx.BlockTime())
+	} else {
+		rewardAmount := params.GetRewardByHeight(uint64(ctx.BlockHeight()))
+
+		// decimals have a precision of 18 digits so the conversion to the base unit is exact,
+		// the rounding dust comes from the split between the recipients
+		rewardAmount = rewardAmount.Mul(Precision_Mul)
+		provision = rewardAmount.TruncateInt()
+	}
+
+	// the last reward is clipped to the max supply, nothing is minted afterwards
+	amount := params.ClipToMaxSupply(minter.GetTotalMinted(), provision)
+	if !amount.IsPositive() {
+		k.SetMinter(ctx, minter)
+		return
+	}
 
-	rewardAmount = rewardAmount.Mul(Precision_Mul)
-	//rewardAmount.
 	// mint coins
-	mintedCoin := sdk.NewCoin(params.MintDenom, rewardAmount.TruncateInt())
+	mintedCoin := sdk.NewCoin(params.MintDenom, amount)
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
@@ -35,20 +68,25 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// split the minted coins between the distribution recipients
+	err = k.DistributeMintedCoins(ctx, params, mintedCoin.Amount)
 	if err != nil {
 		panic(err)
 	}
 
+	minter.TotalMinted = minter.GetTotalMinted().Add(mintedCoin.Amount)
+	k.SetMinter(ctx, minter)
+
 	if mintedCoin.Amount.IsInt64() {
 		defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeMint,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
-		),
-	)
+	attributes := []sdk.Attribute{sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String())}
+	if !bondedRatio.IsNil() {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyBondedRatio, bondedRatio.String()),
+			sdk.NewAttribute(types.AttributeKeyInflation, minter.Inflation.String()),
+		)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMint, attributes...))
 }
@@ -0,0 +1,231 @@
+package mintx_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Seele-N/Seele/app"
+	"github.com/Seele-N/Seele/x/mintx"
+	"github.com/Seele-N/Seele/x/mintx/keeper"
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+func TestBeginBlockerMaxSupply(t *testing.T) {
+	seeleApp := app.Setup(false, "")
+	ctx := seeleApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	k := seeleApp.MintxKeeper
+
+	params := k.GetParams(ctx)
+	params.MintPlans = []types.MintPlan{{StartHeight: 0, EndHeight: ^uint64(0), RewardPerBlock: sdk.NewDec(2)}}
+	reward := params.GetMintedAmountByHeight(1)
+	// the cap is reached in the middle of the third block
+	params.MaxSupply = reward.MulRaw(5).QuoRaw(2)
+	k.SetParams(ctx, params)
+	k.SetMinter(ctx, types.NewMinter(0))
+
+	expected := []sdk.Int{reward, reward.MulRaw(2), params.MaxSupply, params.MaxSupply}
+	for i, total := range expected {
+		ctx = ctx.WithBlockHeight(int64(i + 1))
+		mintx.BeginBlocker(ctx, k)
+		require.Equal(t, total.String(), k.GetMinter(ctx).GetTotalMinted().String(), "height %d", i+1)
+	}
+
+	msg, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+
+	// the burns of the mint denom aren't tracked, the supply dropping below the total minted breaks nothing
+	minter := k.GetMinter(ctx)
+	minter.TotalMinted = k.GetSupply(ctx, params.MintDenom).Amount.AddRaw(1)
+	k.SetMinter(ctx, minter)
+	params.MaxSupply = minter.TotalMinted
+	k.SetParams(ctx, params)
+	msg, broken = keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+
+	// lowering the cap below the minted amount breaks the invariant
+	params.MaxSupply = reward
+	k.SetParams(ctx, params)
+	_, broken = keeper.AllInvariants(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestBeginBlockerDistribution(t *testing.T) {
+	seeleApp := app.Setup(false, "")
+	ctx := seeleApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	k := seeleApp.MintxKeeper
+	addr := sdk.AccAddress("vesting_recipient___")
+
+	params := k.GetParams(ctx)
+	// 7 base units are minted every block
+	params.MintPlans = []types.MintPlan{{StartHeight: 0, EndHeight: ^uint64(0), RewardPerBlock: sdk.NewDecWithPrec(7, 18)}}
+	params.DistributionRecipients = []types.DistributionRecipient{
+		{Type: types.RecipientTypeFeeCollector, Weight: sdk.NewDecWithPrec(5, 1), ReceivesDust: true},
+		{Type: types.RecipientTypeCommunityPool, Weight: sdk.NewDecWithPrec(3, 1)},
+		{Type: types.RecipientTypeAddress, Address: addr.String(), Weight: sdk.NewDecWithPrec(2, 1), VestingEndHeight: 3},
+	}
+	k.SetParams(ctx, params)
+	k.SetMinter(ctx, types.NewMinter(0))
+
+	feeCollector := seeleApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := seeleApp.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount
+	poolBefore := seeleApp.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(params.MintDenom)
+
+	for height := int64(1); height <= 2; height++ {
+		mintx.BeginBlocker(ctx.WithBlockHeight(height), k)
+	}
+	// the share of the address is kept by the module until the end of the vesting
+	require.True(t, seeleApp.BankKeeper.GetBalance(ctx, addr, params.MintDenom).IsZero())
+	total := k.GetRecipientTotal(ctx, types.RecipientTypeAddress, addr.String())
+	require.Equal(t, sdk.NewInt(2), total.Vesting)
+	require.Equal(t, sdk.ZeroInt(), total.Distributed)
+	msg, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+
+	mintx.BeginBlocker(ctx.WithBlockHeight(3), k)
+	require.Equal(t, sdk.NewInt(3), seeleApp.BankKeeper.GetBalance(ctx, addr, params.MintDenom).Amount)
+	total = k.GetRecipientTotal(ctx, types.RecipientTypeAddress, addr.String())
+	require.Equal(t, sdk.ZeroInt(), total.Vesting)
+	require.Equal(t, sdk.NewInt(3), total.Distributed)
+
+	// the truncated shares leave one unit of dust every block to the fee collector
+	fees := seeleApp.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount.Sub(feesBefore)
+	require.Equal(t, sdk.NewInt(3*4), fees)
+	require.Equal(t, sdk.NewInt(3*4), k.GetRecipientTotal(ctx, types.RecipientTypeFeeCollector, "").Distributed)
+	pool := seeleApp.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(params.MintDenom).Sub(poolBefore)
+	require.Equal(t, sdk.NewDec(3*2), pool)
+	require.Equal(t, sdk.NewInt(3*2), k.GetRecipientTotal(ctx, types.RecipientTypeCommunityPool, "").Distributed)
+}
+
+func TestBeginBlockerUnreachableRecipients(t *testing.T) {
+	seeleApp := app.Setup(false, "")
+	ctx := seeleApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	k := seeleApp.MintxKeeper
+	blocked := seeleApp.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+
+	// the recipients are checked against the accounts of the app, an upgrade can still remove a module
+	// account or block an address of the recipients
+	types.SetRecipientAccounts([]string{"removed"}, nil)
+	defer func() {
+		var moduleAccounts []string
+		for name := range app.GetMaccPerms() {
+			moduleAccounts = append(moduleAccounts, name)
+		}
+		types.SetRecipientAccounts(moduleAccounts, seeleApp.ModuleAccountAddrs())
+	}()
+
+	params := k.GetParams(ctx)
+	// 7 base units are minted every block
+	params.MintPlans = []types.MintPlan{{StartHeight: 0, EndHeight: ^uint64(0), RewardPerBlock: sdk.NewDecWithPrec(7, 18)}}
+	params.DistributionRecipients = []types.DistributionRecipient{
+		{Type: types.RecipientTypeFeeCollector, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Type: types.RecipientTypeModuleAccount, Address: "removed", Weight: sdk.NewDecWithPrec(2, 1), ReceivesDust: true},
+		{Type: types.RecipientTypeAddress, Address: blocked.String(), Weight: sdk.NewDecWithPrec(3, 1), VestingEndHeight: 3},
+	}
+	k.SetParams(ctx, params)
+	k.SetMinter(ctx, types.NewMinter(0))
+
+	feeCollector := seeleApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := seeleApp.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount
+	blockedBefore := seeleApp.BankKeeper.GetBalance(ctx, blocked, params.MintDenom).Amount
+
+	for height := int64(1); height <= 3; height++ {
+		require.NotPanics(t, func() { mintx.BeginBlocker(ctx.WithBlockHeight(height), k) })
+	}
+	// the fee collector receives the dust, the vested coins and the share of the blocked address
+	fees := seeleApp.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount.Sub(feesBefore)
+	require.Equal(t, sdk.NewInt(3*7), fees)
+	require.Equal(t, sdk.NewInt(3*7), k.GetRecipientTotal(ctx, types.RecipientTypeFeeCollector, "").Distributed)
+	require.Equal(t, blockedBefore, seeleApp.BankKeeper.GetBalance(ctx, blocked, params.MintDenom).Amount)
+	total := k.GetRecipientTotal(ctx, types.RecipientTypeAddress, blocked.String())
+	require.Equal(t, sdk.ZeroInt(), total.Vesting)
+	require.Equal(t, sdk.ZeroInt(), total.Distributed)
+	msg, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestBeginBlockerBondedRatio(t *testing.T) {
+	seeleApp := app.Setup(false, "")
+	ctx := seeleApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	k := seeleApp.MintxKeeper
+
+	params := k.GetParams(ctx)
+	params.MintPlans = []types.MintPlan{{StartHeight: 0, EndHeight: ^uint64(0), RewardPerBlock: sdk.NewDec(2)}}
+	params.MintMode = types.MintModeBondedRatio
+	params.BondedRatioStartHeight = 2
+	k.SetParams(ctx, params)
+	k.SetMinter(ctx, types.NewMinter(0))
+
+	// the plans are used until the start height
+	for height := int64(1); height <= 2; height++ {
+		mintx.BeginBlocker(ctx.WithBlockHeight(height), k)
+	}
+	reward := params.GetMintedAmountByHeight(1)
+	minter := k.GetMinter(ctx)
+	require.Equal(t, reward.MulRaw(2), minter.GetTotalMinted())
+	require.False(t, minter.InflationStarted)
+
+	// then the inflation starts from the one of the plans and moves toward the goal bonded ratio
+	ctx = ctx.WithBlockHeight(3)
+	supply := k.GetSupply(ctx, params.MintDenom).Amount
+	inflation := params.NextInflationRate(k.GetPlanInflation(ctx, params), k.BondedRatio(ctx))
+	mintx.BeginBlocker(ctx, k)
+
+	minter = k.GetMinter(ctx)
+	require.Equal(t, inflation, minter.Inflation)
+	require.Equal(t, reward.MulRaw(2).Add(params.BlockProvision(inflation, supply)), minter.GetTotalMinted())
+	require.Equal(t, inflation.MulInt(k.GetSupply(ctx, params.MintDenom).Amount).TruncateDec(), k.GetAnnualProvisions(ctx))
+	require.True(t, minter.InflationStarted)
+
+	// a zero inflation is kept rather than restarting from the inflation of the plans
+	params.InflationMin = sdk.ZeroDec()
+	k.SetParams(ctx, params)
+	minter.Inflation = sdk.ZeroDec()
+	k.SetMinter(ctx, minter)
+	ctx = ctx.WithBlockHeight(4)
+	inflation = params.NextInflationRate(sdk.ZeroDec(), k.BondedRatio(ctx))
+	require.False(t, inflation.Equal(params.NextInflationRate(k.GetPlanInflation(ctx, params), k.BondedRatio(ctx))))
+	mintx.BeginBlocker(ctx, k)
+	require.Equal(t, inflation, k.GetMinter(ctx).Inflation)
+}
+
+func TestBeginBlockerTimePlans(t *testing.T) {
+	seeleApp := app.Setup(false, "")
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := seeleApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: start})
+	k := seeleApp.MintxKeeper
+
+	params := k.GetParams(ctx)
+	params.MintMode = types.MintModeTimePlans
+	params.TimeMintPlans = []types.TimeMintPlan{types.NewTimeMintPlan(start, start.Add(24*time.Hour), sdk.NewDec(2))}
+	params.MaxCatchUpDuration = time.Minute
+	k.SetParams(ctx, params)
+	k.SetMinter(ctx, types.NewMinter(0))
+	perSecond := params.GetMintedAmountBetweenTimes(start, start.Add(time.Second))
+
+	// the first block only records its time
+	mintx.BeginBlocker(ctx, k)
+	require.True(t, k.GetMinter(ctx).GetTotalMinted().IsZero())
+	require.Equal(t, start, k.GetMinter(ctx).LastMintTime)
+
+	// the amount follows the block times, not the number of blocks
+	blockTimes := []time.Duration{3 * time.Second, 10 * time.Second, 17 * time.Second}
+	for i, blockTime := range blockTimes {
+		ctx = ctx.WithBlockHeight(int64(i + 2)).WithBlockTime(start.Add(blockTime))
+		mintx.BeginBlocker(ctx, k)
+	}
+	require.Equal(t, perSecond.MulRaw(17), k.GetMinter(ctx).GetTotalMinted())
+
+	// the first block after a halt mints at most the catch up duration
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(start.Add(time.Hour))
+	mintx.BeginBlocker(ctx, k)
+	minter := k.GetMinter(ctx)
+	require.Equal(t, perSecond.MulRaw(17+60), minter.GetTotalMinted())
+	require.Equal(t, start.Add(time.Hour), minter.LastMintTime)
+	require.Equal(t, perSecond.MulRaw(types.SecondsPerYear).ToDec(), k.GetAnnualProvisions(ctx))
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -22,6 +24,13 @@ func GetQueryCmd() *cobra.Command {
 
 	mintingQueryCmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQueryBlockReward(),
+		GetCmdQueryTotalMinted(),
+		GetCmdQueryInflation(),
+		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryProjectedSupply(),
+		GetCmdQueryDistributionTotals(),
+		GetCmdQueryScheduleHistory(),
 	)
 
 	return mintingQueryCmd
@@ -56,3 +65,218 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryBlockReward implements a command to return the reward minted
+// at a height, the current height is used if omitted.
+func GetCmdQueryBlockReward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-reward [height]",
+		Short: "Query the reward minted at a block height",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBlockRewardRequest{}
+			if len(args) > 0 {
+				req.Height, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.BlockReward(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalMinted implements a command to return the amount minted
+// since genesis.
+func GetCmdQueryTotalMinted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-minted",
+		Short: "Query the amount minted since genesis",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalMinted(context.Background(), &types.QueryTotalMintedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.TotalMinted)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInflation implements a command to return the current minting
+// inflation value.
+func GetCmdQueryInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation",
+		Short: "Query the current minting inflation value",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Inflation(context.Background(), &types.QueryInflationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.Inflation))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAnnualProvisions implements a command to return the current minting
+// annual provisions value.
+func GetCmdQueryAnnualProvisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annual-provisions",
+		Short: "Query the current minting annual provisions value",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AnnualProvisions(context.Background(), &types.QueryAnnualProvisionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.AnnualProvisions))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProjectedSupply implements a command to return the supply of the
+// mint denom expected at a future height according to the mint plans.
+func GetCmdQueryProjectedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-supply [height]",
+		Short: "Query the supply of the mint denom expected at a future height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProjectedSupply(context.Background(), &types.QueryProjectedSupplyRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDistributionTotals implements a command to return the cumulative
+// amounts distributed to the recipients of the minted coins.
+func GetCmdQueryDistributionTotals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-totals",
+		Short: "Query the cumulative amounts distributed to the recipients of the minted coins",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DistributionTotals(context.Background(), &types.QueryDistributionTotalsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryScheduleHistory implements a command to return the changes of the
+// mint schedule made by governance.
+func GetCmdQueryScheduleHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-history",
+		Short: "Query the changes of the mint schedule made by governance",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ScheduleHistory(context.Background(), &types.QueryScheduleHistoryRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "schedule-history")
+
+	return cmd
+}
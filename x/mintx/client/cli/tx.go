@@ -0,0 +1,256 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+// Flags of the add mint plan proposal
+const (
+	FlagCurve       = "curve"
+	FlagInterval    = "interval"
+	FlagDecayAmount = "decay-amount"
+	FlagDecayFactor = "decay-factor"
+)
+
+// NewSubmitAddMintPlanProposalTxCmd returns a CLI command handler for creating
+// an add mint plan proposal governance transaction.
+func NewSubmitAddMintPlanProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-mint-plan [start-height] [end-height] [reward-per-block]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to add a plan to the mint schedule",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to add a plan to the mint schedule. The reward is minted for the heights
+after the start height until the end height included. The plan must not start in the past and must follow
+the existing plans without overlap or gap, future plans can be removed first with a remove-mint-plan proposal.
+
+Example:
+$ %s tx gov submit-proposal add-mint-plan 63072000 73584000 10 --curve=CURVE_TYPE_HALVING --interval=2102400 --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			plan, err := parseMintPlan(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAddMintPlanProposal(title, description, plan)
+
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().String(FlagCurve, types.CurveTypeConstant.String(), "The emission curve of the plan")
+	cmd.Flags().Uint64(FlagInterval, 0, "The number of blocks between two decays of the reward")
+	cmd.Flags().String(FlagDecayAmount, "0", "The amount removed from the reward at every interval of a linear decay")
+	cmd.Flags().String(FlagDecayFactor, "0", "The factor applied to the reward at every interval of an exponential decay")
+
+	return cmd
+}
+
+// NewSubmitRemoveMintPlanProposalTxCmd returns a CLI command handler for creating
+// a remove mint plan proposal governance transaction.
+func NewSubmitRemoveMintPlanProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-mint-plan [start-height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to remove a plan which hasn't started from the mint schedule",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to remove the plan starting at the height from the mint schedule.
+
+Example:
+$ %s tx gov submit-proposal remove-mint-plan 63072000 --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveMintPlanProposal(title, description, startHeight)
+
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
+// NewSubmitUpdateDefaultRewardProposalTxCmd returns a CLI command handler for creating
+// an update default reward proposal governance transaction.
+func NewSubmitUpdateDefaultRewardProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-default-reward [reward-per-block]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to change the reward of the heights without mint plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to change the reward of the heights without mint plan.
+
+Example:
+$ %s tx gov submit-proposal update-default-reward 20 --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			reward, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateDefaultRewardProposal(title, description, reward)
+
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
+// parseMintPlan returns the plan defined by the arguments and the flags of the add mint plan command
+func parseMintPlan(cmd *cobra.Command, args []string) (types.MintPlan, error) {
+	startHeight, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return types.MintPlan{}, err
+	}
+	endHeight, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return types.MintPlan{}, err
+	}
+	reward, err := sdk.NewDecFromStr(args[2])
+	if err != nil {
+		return types.MintPlan{}, err
+	}
+
+	curveName, err := cmd.Flags().GetString(FlagCurve)
+	if err != nil {
+		return types.MintPlan{}, err
+	}
+	curve, ok := types.CurveType_value[curveName]
+	if !ok {
+		return types.MintPlan{}, fmt.Errorf("unknown curve type: %s", curveName)
+	}
+	interval, err := cmd.Flags().GetUint64(FlagInterval)
+	if err != nil {
+		return types.MintPlan{}, err
+	}
+	decayAmount, err := decFlag(cmd, FlagDecayAmount)
+	if err != nil {
+		return types.MintPlan{}, err
+	}
+	decayFactor, err := decFlag(cmd, FlagDecayFactor)
+	if err != nil {
+		return types.MintPlan{}, err
+	}
+
+	return types.MintPlan{
+		StartHeight:    startHeight,
+		EndHeight:      endHeight,
+		RewardPerBlock: reward,
+		Curve:          types.CurveType(curve),
+		Interval:       interval,
+		DecayAmount:    decayAmount,
+		DecayFactor:    decayFactor,
+	}, nil
+}
+
+func decFlag(cmd *cobra.Command, name string) (sdk.Dec, error) {
+	str, err := cmd.Flags().GetString(name)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return sdk.NewDecFromStr(str)
+}
+
+// submitProposal broadcasts a proposal with the deposit of the flags
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content) error {
+	from := clientCtx.GetFromAddress()
+
+	strDeposit, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(strDeposit)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
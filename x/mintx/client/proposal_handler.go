@@ -0,0 +1,17 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/Seele-N/Seele/x/mintx/client/cli"
+	"github.com/Seele-N/Seele/x/mintx/client/rest"
+)
+
+// AddMintPlanProposalHandler is the add mint plan proposal handler.
+var AddMintPlanProposalHandler = govclient.NewProposalHandler(cli.NewSubmitAddMintPlanProposalTxCmd, rest.AddMintPlanProposalRESTHandler)
+
+// RemoveMintPlanProposalHandler is the remove mint plan proposal handler.
+var RemoveMintPlanProposalHandler = govclient.NewProposalHandler(cli.NewSubmitRemoveMintPlanProposalTxCmd, rest.RemoveMintPlanProposalRESTHandler)
+
+// UpdateDefaultRewardProposalHandler is the update default reward proposal handler.
+var UpdateDefaultRewardProposalHandler = govclient.NewProposalHandler(cli.NewSubmitUpdateDefaultRewardProposalTxCmd, rest.UpdateDefaultRewardProposalRESTHandler)
@@ -0,0 +1,133 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+type (
+	// AddMintPlanProposalReq defines an add mint plan proposal request body.
+	AddMintPlanProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Plan        types.MintPlan `json:"plan" yaml:"plan"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// RemoveMintPlanProposalReq defines a remove mint plan proposal request body.
+	RemoveMintPlanProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		StartHeight uint64         `json:"start_height" yaml:"start_height"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// UpdateDefaultRewardProposalReq defines an update default reward proposal request body.
+	UpdateDefaultRewardProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title                 string         `json:"title" yaml:"title"`
+		Description           string         `json:"description" yaml:"description"`
+		DefaultRewardPerBlock sdk.Dec        `json:"default_reward_per_block" yaml:"default_reward_per_block"`
+		Proposer              sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit               sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// AddMintPlanProposalRESTHandler returns a ProposalRESTHandler that exposes the add mint plan
+// REST handler with a given sub-route.
+func AddMintPlanProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_mint_plan",
+		Handler:  postAddMintPlanProposalHandlerFn(clientCtx),
+	}
+}
+
+// RemoveMintPlanProposalRESTHandler returns a ProposalRESTHandler that exposes the remove mint plan
+// REST handler with a given sub-route.
+func RemoveMintPlanProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_mint_plan",
+		Handler:  postRemoveMintPlanProposalHandlerFn(clientCtx),
+	}
+}
+
+// UpdateDefaultRewardProposalRESTHandler returns a ProposalRESTHandler that exposes the update default
+// reward REST handler with a given sub-route.
+func UpdateDefaultRewardProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_default_reward",
+		Handler:  postUpdateDefaultRewardProposalHandlerFn(clientCtx),
+	}
+}
+
+func postAddMintPlanProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AddMintPlanProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		content := types.NewAddMintPlanProposal(req.Title, req.Description, req.Plan)
+		writeProposalTx(w, clientCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+	}
+}
+
+func postRemoveMintPlanProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RemoveMintPlanProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		content := types.NewRemoveMintPlanProposal(req.Title, req.Description, req.StartHeight)
+		writeProposalTx(w, clientCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+	}
+}
+
+func postUpdateDefaultRewardProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateDefaultRewardProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		content := types.NewUpdateDefaultRewardProposal(req.Title, req.Description, req.DefaultRewardPerBlock)
+		writeProposalTx(w, clientCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+	}
+}
+
+// writeProposalTx writes the unsigned transaction submitting the proposal
+func writeProposalTx(
+	w http.ResponseWriter, clientCtx client.Context, baseReq rest.BaseReq,
+	content govtypes.Content, deposit sdk.Coins, proposer sdk.AccAddress,
+) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
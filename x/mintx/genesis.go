@@ -10,6 +10,12 @@ import (
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper, data *types.GenesisState) {
 	keeper.SetMinter(ctx, data.Minter)
 	keeper.SetParams(ctx, data.Params)
+	for _, total := range data.RecipientTotals {
+		keeper.SetRecipientTotal(ctx, total)
+	}
+	for _, change := range data.ScheduleHistory {
+		keeper.SetScheduleChange(ctx, change)
+	}
 	ak.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -17,5 +23,5 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper,
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParams(ctx)
-	return types.NewGenesisState(minter, params)
+	return types.NewGenesisState(minter, params, keeper.GetRecipientTotals(ctx), keeper.GetAllScheduleChanges(ctx))
 }
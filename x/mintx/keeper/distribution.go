@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+// feeCollectorRecipient receives the minted coins which can't be sent to their recipient
+var feeCollectorRecipient = types.DistributionRecipient{Type: types.RecipientTypeFeeCollector}
+
+// GetRecipientTotal returns the cumulative amounts distributed to a recipient
+func (k Keeper) GetRecipientTotal(ctx sdk.Context, recipientType types.RecipientType, address string) types.RecipientTotal {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RecipientTotalKey(recipientType, address))
+	if len(bz) == 0 {
+		return types.NewRecipientTotal(recipientType, address)
+	}
+	var total types.RecipientTotal
+	k.cdc.MustUnmarshal(bz, &total)
+	return total
+}
+
+// SetRecipientTotal stores the cumulative amounts distributed to a recipient
+func (k Keeper) SetRecipientTotal(ctx sdk.Context, total types.RecipientTotal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RecipientTotalKey(total.Type, total.Address), k.cdc.MustMarshal(&total))
+}
+
+// GetRecipientTotals returns the cumulative amounts distributed to all the recipients
+func (k Keeper) GetRecipientTotals(ctx sdk.Context) []types.RecipientTotal {
+	var totals []types.RecipientTotal
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RecipientTotalKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var total types.RecipientTotal
+		k.cdc.MustUnmarshal(iter.Value(), &total)
+		totals = append(totals, total)
+	}
+	return totals
+}
+
+// DistributeMintedCoins splits the coins minted to the module account between the distribution recipients.
+// The amounts are truncated, the remainder goes to the dust recipient along with the share of the recipients
+// which can't receive coins, or to the fee collector if the dust recipient can't receive them either.
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, params types.Params, amount sdk.Int) error {
+	height := uint64(ctx.BlockHeight())
+	dust := amount
+	var dustRecipient types.DistributionRecipient
+	for _, recipient := range params.DistributionRecipients {
+		if recipient.ReceivesDust {
+			dustRecipient = recipient
+			continue
+		}
+
+		share := amount.ToDec().Mul(recipient.Weight).TruncateInt()
+		if !share.IsPositive() {
+			continue
+		}
+		cacheCtx, commit := ctx.CacheContext()
+		if err := k.sendToRecipient(cacheCtx, params.MintDenom, recipient, share, height); err != nil {
+			k.Logger(ctx).Error("failed to distribute minted coins", "recipient", recipient.Name(), "error", err)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		dust = dust.Sub(share)
+	}
+
+	if !dust.IsPositive() {
+		return nil
+	}
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.sendToRecipient(cacheCtx, params.MintDenom, dustRecipient, dust, height); err != nil {
+		// the fee collector receives the dust rather than halting the chain
+		k.Logger(ctx).Error("failed to distribute minted coins", "recipient", dustRecipient.Name(), "error", err)
+		return k.sendToRecipient(ctx, params.MintDenom, feeCollectorRecipient, dust, height)
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// sendToRecipient sends an amount of the minted coins to a recipient and adds it to its totals
+func (k Keeper) sendToRecipient(ctx sdk.Context, denom string, recipient types.DistributionRecipient, amount sdk.Int, height uint64) error {
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	total := k.GetRecipientTotal(ctx, recipient.Type, recipient.Address)
+
+	vesting := recipient.IsVesting(height)
+	switch {
+	case vesting:
+		// the coins stay in the module account until the end of the vesting
+		total.Vesting = total.Vesting.Add(amount)
+	case recipient.Type == types.RecipientTypeFeeCollector:
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, coins); err != nil {
+			return err
+		}
+	case recipient.Type == types.RecipientTypeCommunityPool:
+		if err := k.distrKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+			return err
+		}
+	case recipient.Type == types.RecipientTypeModuleAccount:
+		if k.accountKeeper.GetModuleAddress(recipient.Address) == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipient.Address)
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient.Address, coins); err != nil {
+			return err
+		}
+	case recipient.Type == types.RecipientTypeAddress:
+		addr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return err
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unknown recipient type %s", recipient.Type)
+	}
+
+	if !vesting {
+		total.Distributed = total.Distributed.Add(amount)
+	}
+	k.SetRecipientTotal(ctx, total)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMintDistribution,
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.Name()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyVesting, strconv.FormatBool(vesting)),
+	))
+	return nil
+}
+
+// ReleaseVestedCoins sends the coins kept by the module to the addresses whose vesting has ended,
+// the coins of an address removed from the recipients are released as well. The coins of an address
+// which can't receive them are sent to the fee collector.
+func (k Keeper) ReleaseVestedCoins(ctx sdk.Context, params types.Params) error {
+	height := uint64(ctx.BlockHeight())
+	vesting := make(map[string]bool)
+	for _, recipient := range params.DistributionRecipients {
+		if recipient.IsVesting(height) {
+			vesting[string(recipient.Key())] = true
+		}
+	}
+
+	for _, total := range k.GetRecipientTotals(ctx) {
+		if !total.Vesting.IsPositive() || vesting[string(types.RecipientTotalKey(total.Type, total.Address))] {
+			continue
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, total.Vesting))
+		if err := k.sendVestedCoins(ctx, total.Address, coins); err != nil {
+			// the fee collector receives the coins rather than halting the chain
+			k.Logger(ctx).Error("failed to release vested coins", "recipient", total.Address, "error", err)
+			if err := k.sendToRecipient(ctx, params.MintDenom, feeCollectorRecipient, total.Vesting, height); err != nil {
+				return err
+			}
+		} else {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeVestingReleased,
+				sdk.NewAttribute(types.AttributeKeyRecipient, total.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, total.Vesting.String()),
+			))
+			total.Distributed = total.Distributed.Add(total.Vesting)
+		}
+		total.Vesting = sdk.ZeroInt()
+		k.SetRecipientTotal(ctx, total)
+	}
+	return nil
+}
+
+// sendVestedCoins sends the vested coins to the address of a recipient
+func (k Keeper) sendVestedCoins(ctx sdk.Context, address string, coins sdk.Coins) error {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+}
@@ -2,13 +2,19 @@ package keeper
 
 import (
 	"context"
+	"math"
+	"time"
 
 	"github.com/Seele-N/Seele/x/mintx/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.QueryServer = Keeper{}
 
+// maxProjectedYears is the maximum number of years of blocks after the current height projected by ProjectedSupply
+const maxProjectedYears = 100
+
 // Params returns params of the mint module.
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -17,20 +23,119 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
-/*
-// Inflation returns minter.Inflation of the mint module.
-func (k Keeper) Inflation(c context.Context, _ *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
+// BlockReward returns the reward minted at a height of the mint module.
+func (k Keeper) BlockReward(c context.Context, req *types.QueryBlockRewardRequest) (*types.QueryBlockRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	height := req.Height
+	if height == 0 {
+		height = uint64(ctx.BlockHeight())
+	}
+
+	return &types.QueryBlockRewardResponse{
+		Height:         height,
+		RewardPerBlock: params.GetRewardByHeight(height),
+		Amount:         sdk.NewCoin(params.MintDenom, params.GetMintedAmountByHeight(height)),
+	}, nil
+}
+
+// TotalMinted returns minter.TotalMinted of the mint module.
+func (k Keeper) TotalMinted(c context.Context, _ *types.QueryTotalMintedRequest) (*types.QueryTotalMintedResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
 
-	return &types.QueryInflationResponse{Inflation: minter.Inflation}, nil
+	return &types.QueryTotalMintedResponse{TotalMinted: sdk.NewCoin(params.MintDenom, minter.GetTotalMinted())}, nil
 }
 
-// AnnualProvisions returns minter.AnnualProvisions of the mint module.
+// Inflation returns the annualized inflation of the mint module.
+func (k Keeper) Inflation(c context.Context, _ *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryInflationResponse{Inflation: k.GetInflation(ctx)}, nil
+}
+
+// AnnualProvisions returns the annualized provisions of the mint module.
 func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisionsRequest) (*types.QueryAnnualProvisionsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: k.GetAnnualProvisions(ctx)}, nil
+}
+
+// ProjectedSupply returns the supply of the mint denom expected at a future height.
+func (k Keeper) ProjectedSupply(c context.Context, req *types.QueryProjectedSupplyRequest) (*types.QueryProjectedSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
 	minter := k.GetMinter(ctx)
 
-	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
+	current := uint64(ctx.BlockHeight())
+	if req.Height < current {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "height %d is before the current height %d", req.Height, current)
+	}
+	if (req.Height-current)/params.BlocksPerYear >= maxProjectedYears {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "height %d is more than %d years after the current height %d", req.Height, maxProjectedYears, current)
+	}
+	// no reward is minted before the height adjustment
+	from := current
+	if minter.HeightAdjustment > 0 && from < minter.HeightAdjustment-1 {
+		from = minter.HeightAdjustment - 1
+	}
+
+	supply := k.GetSupply(ctx, params.MintDenom)
+	to := req.Height
+	bondedRatioProvisions := sdk.ZeroInt()
+	if params.MintMode == types.MintModeBondedRatio && to > params.BondedRatioStartHeight {
+		// the heights of the bonded ratio targeting are projected at the current inflation rate
+		start := params.BondedRatioStartHeight
+		if start < from {
+			start = from
+		}
+		inflation := k.GetTargetingInflation(ctx, params, minter)
+		blocks := sdk.NewIntFromUint64(to - start)
+		bondedRatioProvisions = params.BlockProvision(inflation, supply.Amount).Mul(blocks)
+		to = start
+	}
+	var minted sdk.Int
+	if params.IsTimeMinting() {
+		// the time of the height is projected from the expected number of blocks per year
+		blockDuration := time.Duration(types.SecondsPerYear) * time.Second / time.Duration(params.BlocksPerYear)
+		blocks := req.Height - from
+		if blockDuration > 0 && blocks > uint64(math.MaxInt64/blockDuration) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duration of the %d blocks until height %d overflows", blocks, req.Height)
+		}
+		now := ctx.BlockTime()
+		minted = params.GetMintedAmountBetweenTimes(now, now.Add(time.Duration(blocks)*blockDuration))
+	} else {
+		minted = params.GetMintedAmountBetween(from, to).Add(bondedRatioProvisions)
+	}
+	minted = params.ClipToMaxSupply(minter.GetTotalMinted(), minted)
+	return &types.QueryProjectedSupplyResponse{
+		Height:          req.Height,
+		CurrentSupply:   supply,
+		ProjectedSupply: supply.AddAmount(minted),
+	}, nil
+}
+
+// DistributionTotals returns the cumulative amounts distributed to the recipients of the minted coins.
+func (k Keeper) DistributionTotals(c context.Context, _ *types.QueryDistributionTotalsRequest) (*types.QueryDistributionTotalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryDistributionTotalsResponse{
+		MintDenom: params.MintDenom,
+		Totals:    k.GetRecipientTotals(ctx),
+	}, nil
+}
+
+// ScheduleHistory returns the changes of the mint schedule made by governance.
+func (k Keeper) ScheduleHistory(c context.Context, req *types.QueryScheduleHistoryRequest) (*types.QueryScheduleHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	changes, pageRes, err := k.GetScheduleHistory(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryScheduleHistoryResponse{Changes: changes, Pagination: pageRes}, nil
 }
-*/
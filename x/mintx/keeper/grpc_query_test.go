@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Seele-N/Seele/app"
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+func TestProjectedSupply(t *testing.T) {
+	seeleApp := app.Setup(false, "")
+	ctx := seeleApp.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Unix(1000, 0)})
+	k := seeleApp.MintxKeeper
+	c := sdk.WrapSDKContext(ctx)
+
+	params := k.GetParams(ctx)
+	params.BlocksPerYear = 100
+	params.MintPlans = []types.MintPlan{{StartHeight: 0, EndHeight: ^uint64(0), RewardPerBlock: sdk.NewDec(2)}}
+	k.SetParams(ctx, params)
+	k.SetMinter(ctx, types.NewMinter(0))
+
+	res, err := k.ProjectedSupply(c, &types.QueryProjectedSupplyRequest{Height: 15})
+	require.NoError(t, err)
+	require.Equal(t, params.GetMintedAmountByHeight(11).MulRaw(5).String(), res.ProjectedSupply.Amount.Sub(res.CurrentSupply.Amount).String())
+
+	// the heights before the current one and after 100 years are rejected
+	_, err = k.ProjectedSupply(c, &types.QueryProjectedSupplyRequest{Height: 9})
+	require.Error(t, err)
+	_, err = k.ProjectedSupply(c, &types.QueryProjectedSupplyRequest{Height: 10 + 100*100})
+	require.Error(t, err)
+	_, err = k.ProjectedSupply(c, &types.QueryProjectedSupplyRequest{Height: ^uint64(0)})
+	require.Error(t, err)
+
+	// the time plans are projected up to the limit without overflowing the durations
+	params.MintMode = types.MintModeTimePlans
+	params.TimeMintPlans = []types.TimeMintPlan{types.NewTimeMintPlan(time.Unix(0, 0), time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC), sdk.NewDec(1))}
+	k.SetParams(ctx, params)
+	res, err = k.ProjectedSupply(c, &types.QueryProjectedSupplyRequest{Height: 10 + 100*100 - 1})
+	require.NoError(t, err)
+	require.True(t, res.ProjectedSupply.Amount.GT(res.CurrentSupply.Amount))
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+// RegisterInvariants registers all mintx invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-minted", TotalMintedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "vesting-balance", VestingBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the mintx module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := TotalMintedInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return VestingBalanceInvariant(k)(ctx)
+	}
+}
+
+// TotalMintedInvariant checks that the amount minted by the module doesn't exceed the max supply.
+//
+// The total minted isn't compared to the bank supply of the mint denom: the mint denom is burned by the slashing
+// of the staking module, by the deposits of the vetoed governance proposals and by the conversions of the seele
+// module, and these burns aren't tracked, so the supply may drop below the total minted without any bug.
+func TotalMintedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		totalMinted := k.GetMinter(ctx).GetTotalMinted()
+
+		broken := !params.MaxSupply.IsZero() && totalMinted.GT(params.MaxSupply)
+		return sdk.FormatInvariant(types.ModuleName, "total minted",
+			fmt.Sprintf("\ttotal minted: %s\n\tmax supply: %s\n", totalMinted, params.MaxSupply)), broken
+	}
+}
+
+// VestingBalanceInvariant checks that the module account holds the coins of the vesting recipients
+func VestingBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		vesting := sdk.ZeroInt()
+		for _, total := range k.GetRecipientTotals(ctx) {
+			vesting = vesting.Add(total.Vesting)
+		}
+		balance := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), params.MintDenom)
+
+		broken := balance.Amount.LT(vesting)
+		return sdk.FormatInvariant(types.ModuleName, "vesting balance",
+			fmt.Sprintf("\tvesting amount: %s\n\tmodule balance: %s\n", vesting, balance)), broken
+	}
+}
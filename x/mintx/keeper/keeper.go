@@ -17,7 +17,9 @@ type Keeper struct {
 	storeKey         sdk.StoreKey
 	paramSpace       paramtypes.Subspace
 	stakingKeeper    types.StakingKeeper
+	accountKeeper    types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
 	feeCollectorName string
 }
 
@@ -25,7 +27,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string,
+	dk types.DistributionKeeper, feeCollectorName string,
 ) Keeper {
 	// ensure mintx module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -42,7 +44,9 @@ func NewKeeper(
 		storeKey:         key,
 		paramSpace:       paramSpace,
 		stakingKeeper:    sk,
+		accountKeeper:    ak,
 		bankKeeper:       bk,
+		distrKeeper:      dk,
 		feeCollectorName: feeCollectorName,
 	}
 }
@@ -111,6 +115,69 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 	return k.bankKeeper.MintCoins(ctx, types.ModuleName, newCoins)
 }
 
+// GetSupply implements an alias call to the underlying bank keeper's
+// GetSupply to be used in the queries.
+func (k Keeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
+	return k.bankKeeper.GetSupply(ctx, denom)
+}
+
+// GetAnnualProvisions returns the amount minted in a year at the reward of the current height,
+// or at the current inflation rate in the bonded ratio targeting mode, bounded by the max supply
+func (k Keeper) GetAnnualProvisions(ctx sdk.Context) sdk.Dec {
+	params := k.GetParams(ctx)
+	minter := k.GetMinter(ctx)
+
+	var provisions sdk.Int
+	// the inflation is only set once the targeting has started
+	if params.IsBondedRatioTargeting(uint64(ctx.BlockHeight())) && minter.InflationStarted {
+		provisions = minter.Inflation.MulInt(k.GetSupply(ctx, params.MintDenom).Amount).TruncateInt()
+	} else if params.IsTimeMinting() {
+		// the reward rate at the block time over a year
+		rate := params.GetRewardRateByTime(ctx.BlockTime())
+		provisions = rate.Mul(types.RewardPrecision).MulInt64(types.SecondsPerYear).TruncateInt()
+	} else {
+		reward := params.GetMintedAmountByHeight(uint64(ctx.BlockHeight()))
+		provisions = reward.Mul(sdk.NewIntFromUint64(params.BlocksPerYear))
+	}
+	return params.ClipToMaxSupply(minter.GetTotalMinted(), provisions).ToDec()
+}
+
+// GetInflation returns the annual provisions relative to the staking token supply
+func (k Keeper) GetInflation(ctx sdk.Context) sdk.Dec {
+	supply := k.StakingTokenSupply(ctx)
+	if !supply.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return k.GetAnnualProvisions(ctx).QuoInt(supply)
+}
+
+// GetPlanInflation returns the inflation rate of the reward of the mint plans at the current height
+// relative to the supply of the mint denom, bounded by the min and max inflation.
+func (k Keeper) GetPlanInflation(ctx sdk.Context, params types.Params) sdk.Dec {
+	inflation := params.InflationMin
+	supply := k.GetSupply(ctx, params.MintDenom).Amount
+	if supply.IsPositive() {
+		reward := params.GetMintedAmountByHeight(uint64(ctx.BlockHeight()))
+		inflation = reward.Mul(sdk.NewIntFromUint64(params.BlocksPerYear)).ToDec().QuoInt(supply)
+	}
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+	return inflation
+}
+
+// GetTargetingInflation returns the inflation rate the bonded ratio targeting moves from, the targeting
+// starts from the inflation of the mint plans.
+func (k Keeper) GetTargetingInflation(ctx sdk.Context, params types.Params, minter types.Minter) sdk.Dec {
+	if minter.InflationStarted {
+		return minter.Inflation
+	}
+	return k.GetPlanInflation(ctx, params)
+}
+
 // AddCollectedFees implements an alias call to the underlying supply keeper's
 // AddCollectedFees to be used in BeginBlocker.
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
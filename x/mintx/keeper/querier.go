@@ -16,13 +16,12 @@ func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 		case types.QueryParameters:
 			return queryParams(ctx, k, legacyQuerierCdc)
 
-			/*
-				case types.QueryInflation:
-					return queryInflation(ctx, k, legacyQuerierCdc)
+		case types.QueryInflation:
+			return queryInflation(ctx, k, legacyQuerierCdc)
+
+		case types.QueryAnnualProvisions:
+			return queryAnnualProvisions(ctx, k, legacyQuerierCdc)
 
-				case types.QueryAnnualProvisions:
-					return queryAnnualProvisions(ctx, k, legacyQuerierCdc)
-			*/
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -40,11 +39,8 @@ func queryParams(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino)
 	return res, nil
 }
 
-/*
 func queryInflation(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
-	minter := k.GetMinter(ctx)
-
-	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, minter.Inflation)
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, k.GetInflation(ctx))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -53,13 +49,10 @@ func queryInflation(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmi
 }
 
 func queryAnnualProvisions(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
-	minter := k.GetMinter(ctx)
-
-	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, minter.AnnualProvisions)
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, k.GetAnnualProvisions(ctx))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
 
 	return res, nil
 }
-*/
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+// AddMintPlan adds a plan to the mint schedule, the plan must not start in the past
+// and must follow the existing plans without overlap or gap.
+func (k Keeper) AddMintPlan(ctx sdk.Context, plan types.MintPlan) error {
+	if err := plan.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if plan.StartHeight < uint64(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan starts at height %d in the past", plan.StartHeight)
+	}
+
+	params := k.GetParams(ctx)
+	for _, existing := range params.MintPlans {
+		if existing.StartHeight < plan.EndHeight && plan.StartHeight < existing.EndHeight {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan overlaps the plan from height %d to %d", existing.StartHeight, existing.EndHeight)
+		}
+	}
+	params.MintPlans = append(params.MintPlans, plan)
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	k.SetParams(ctx, params)
+
+	k.appendScheduleChange(ctx, types.ScheduleChange{
+		ChangeType: types.ProposalTypeAddMintPlan,
+		Plan:       &plan,
+	})
+	return nil
+}
+
+// RemoveMintPlan removes the plan starting at the height from the mint schedule, the plan must not have started
+// and the remaining plans must follow each other without gap.
+func (k Keeper) RemoveMintPlan(ctx sdk.Context, startHeight uint64) error {
+	params := k.GetParams(ctx)
+	index := -1
+	for i, plan := range params.MintPlans {
+		if plan.StartHeight == startHeight {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no plan starts at height %d", startHeight)
+	}
+
+	plan := params.MintPlans[index]
+	// the first reward of the plan is minted at the block after its start height
+	if plan.StartHeight < uint64(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan starting at height %d is active", startHeight)
+	}
+
+	plans := make([]types.MintPlan, 0, len(params.MintPlans)-1)
+	plans = append(plans, params.MintPlans[:index]...)
+	params.MintPlans = append(plans, params.MintPlans[index+1:]...)
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	k.SetParams(ctx, params)
+
+	k.appendScheduleChange(ctx, types.ScheduleChange{
+		ChangeType: types.ProposalTypeRemoveMintPlan,
+		Plan:       &plan,
+	})
+	return nil
+}
+
+// UpdateDefaultRewardPerBlock changes the reward of the heights without plan
+func (k Keeper) UpdateDefaultRewardPerBlock(ctx sdk.Context, reward sdk.Dec) error {
+	params := k.GetParams(ctx)
+	previous := params.DefaultRewardPerBlock
+	params.DefaultRewardPerBlock = reward
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	k.SetParams(ctx, params)
+
+	k.appendScheduleChange(ctx, types.ScheduleChange{
+		ChangeType:            types.ProposalTypeUpdateDefaultReward,
+		PreviousDefaultReward: previous,
+		DefaultReward:         reward,
+	})
+	return nil
+}
+
+// appendScheduleChange records a change of the mint schedule in the history
+func (k Keeper) appendScheduleChange(ctx sdk.Context, change types.ScheduleChange) {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(types.LastScheduleChangeIDKey); len(bz) > 0 {
+		id = sdk.BigEndianToUint64(bz) + 1
+	}
+	store.Set(types.LastScheduleChangeIDKey, sdk.Uint64ToBigEndian(id))
+
+	change.Id = id
+	change.Height = ctx.BlockHeight()
+	k.SetScheduleChange(ctx, change)
+
+	attributes := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyChangeType, change.ChangeType)}
+	if change.Plan != nil {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyStartHeight, fmt.Sprint(change.Plan.StartHeight)),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprint(change.Plan.EndHeight)),
+			sdk.NewAttribute(types.AttributeKeyRewardPerBlock, change.Plan.RewardPerBlock.String()),
+		)
+	} else {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyRewardPerBlock, change.DefaultReward.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeScheduleChange, attributes...))
+}
+
+// SetScheduleChange stores a change of the mint schedule, used by the genesis import
+func (k Keeper) SetScheduleChange(ctx sdk.Context, change types.ScheduleChange) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ScheduleChangeKey(change.Id), k.cdc.MustMarshal(&change))
+
+	// keep the last id consistent with the imported history
+	if bz := store.Get(types.LastScheduleChangeIDKey); len(bz) == 0 || sdk.BigEndianToUint64(bz) < change.Id {
+		store.Set(types.LastScheduleChangeIDKey, sdk.Uint64ToBigEndian(change.Id))
+	}
+}
+
+// GetScheduleHistory returns a page of the changes of the mint schedule
+func (k Keeper) GetScheduleHistory(ctx sdk.Context, pageReq *query.PageRequest) ([]types.ScheduleChange, *query.PageResponse, error) {
+	var changes []types.ScheduleChange
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleChangeKeyPrefix)
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var change types.ScheduleChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return changes, pageRes, nil
+}
+
+// GetAllScheduleChanges returns the whole history of the mint schedule
+func (k Keeper) GetAllScheduleChanges(ctx sdk.Context) []types.ScheduleChange {
+	var changes []types.ScheduleChange
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ScheduleChangeKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var change types.ScheduleChange
+		k.cdc.MustUnmarshal(iter.Value(), &change)
+		changes = append(changes, change)
+	}
+	return changes
+}
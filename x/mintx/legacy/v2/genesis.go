@@ -0,0 +1,29 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+// MigrateGenesis migrates the genesis state from v1 to v2, the v1 state is decoded with the v2 types since
+// v2 only added fields. The migration includes:
+//
+// - Setting the params added since v1 to their default values, v1 didn't store the default reward.
+// - Rebuilding the total minted by the module from the mint plans until the last height of the exported state.
+// - Recording the minted coins as distributed to the fee collector, the only recipient of v1.
+func MigrateGenesis(oldState types.GenesisState, lastHeight int64, supply sdk.Int) *types.GenesisState {
+	params := types.DefaultParams()
+	params.MintDenom = oldState.Params.MintDenom
+	params.MintPlans = oldState.Params.MintPlans
+
+	minter := types.NewMinter(oldState.Minter.HeightAdjustment)
+	minter.TotalMinted = rebuildTotalMinted(oldState.Minter, params, lastHeight, supply)
+
+	var totals []types.RecipientTotal
+	if minter.TotalMinted.IsPositive() {
+		totals = append(totals, feeCollectorTotal(minter.TotalMinted))
+	}
+
+	return types.NewGenesisState(minter, params, totals, nil)
+}
@@ -0,0 +1,78 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The migration includes:
+//
+// - Setting the params added since v1 to their default values.
+// - Rebuilding the total minted by the module from the mint plans, v1 didn't track it.
+// - Recording the minted coins as distributed to the fee collector, the only recipient of v1.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, bk types.BankKeeper, cdc codec.BinaryCodec) error {
+	params := migrateParams(ctx, paramSpace)
+
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.MinterKey)
+	if bz == nil {
+		return nil
+	}
+	var minter types.Minter
+	cdc.MustUnmarshal(bz, &minter)
+	if !minter.GetTotalMinted().IsZero() {
+		return nil
+	}
+
+	// the upgrade runs before the mint of the current block
+	totalMinted := rebuildTotalMinted(minter, params, ctx.BlockHeight()-1, bk.GetSupply(ctx, params.MintDenom).Amount)
+
+	minter.TotalMinted = totalMinted
+	store.Set(types.MinterKey, cdc.MustMarshal(&minter))
+
+	feeCollector := types.DefaultDistributionRecipients()[0]
+	total := feeCollectorTotal(totalMinted)
+	store.Set(feeCollector.Key(), cdc.MustMarshal(&total))
+
+	return nil
+}
+
+// rebuildTotalMinted returns the amount minted by v1 until the last height included, v1 minted the reward
+// of the plans at every block from the height adjustment
+func rebuildTotalMinted(minter types.Minter, params types.Params, lastHeight int64, supply sdk.Int) sdk.Int {
+	if lastHeight <= 0 {
+		return sdk.ZeroInt()
+	}
+	from := uint64(0)
+	if minter.HeightAdjustment > 0 {
+		from = minter.HeightAdjustment - 1
+	}
+	totalMinted := params.GetMintedAmountBetween(from, uint64(lastHeight))
+	// the plans may have been changed by a param change proposal, the total can't exceed the supply
+	return sdk.MinInt(totalMinted, supply)
+}
+
+// feeCollectorTotal returns the total of the fee collector, the only recipient of v1
+func feeCollectorTotal(totalMinted sdk.Int) types.RecipientTotal {
+	feeCollector := types.DefaultDistributionRecipients()[0]
+	total := types.NewRecipientTotal(feeCollector.Type, feeCollector.Address)
+	total.Distributed = totalMinted
+	return total
+}
+
+// migrateParams sets the params missing from the store to their default values
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) types.Params {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !paramSpace.Has(ctx, pair.Key) {
+			paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	return params
+}
@@ -0,0 +1,26 @@
+package mintx
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Seele-N/Seele/x/mintx/keeper"
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+// NewMintPlanProposalHandler creates a new governance Handler for the proposals changing the mint schedule
+func NewMintPlanProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddMintPlanProposal:
+			return k.AddMintPlan(ctx, c.Plan)
+		case *types.RemoveMintPlanProposal:
+			return k.RemoveMintPlan(ctx, c.StartHeight)
+		case *types.UpdateDefaultRewardProposal:
+			return k.UpdateDefaultRewardPerBlock(ctx, c.DefaultRewardPerBlock)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized mintx proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package mintx_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Seele-N/Seele/app"
+	"github.com/Seele-N/Seele/x/mintx"
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+func TestMintPlanProposalHandler(t *testing.T) {
+	seeleApp := app.Setup(false, "")
+	ctx := seeleApp.BaseApp.NewContext(false, tmproto.Header{Height: 100})
+	k := seeleApp.MintxKeeper
+	handler := mintx.NewMintPlanProposalHandler(k)
+
+	plan := func(start, end uint64) types.MintPlan {
+		return types.MintPlan{StartHeight: start, EndHeight: end, RewardPerBlock: sdk.NewDec(10)}
+	}
+	params := k.GetParams(ctx)
+	params.MintPlans = []types.MintPlan{plan(0, 200), plan(200, 300)}
+	k.SetParams(ctx, params)
+
+	testCases := []struct {
+		name    string
+		content *types.AddMintPlanProposal
+		expPass bool
+	}{
+		{"starts in the past", types.NewAddMintPlanProposal("title", "desc", plan(50, 400)), false},
+		{"overlaps a plan", types.NewAddMintPlanProposal("title", "desc", plan(250, 400)), false},
+		{"leaves a gap", types.NewAddMintPlanProposal("title", "desc", plan(350, 400)), false},
+		{"follows the last plan", types.NewAddMintPlanProposal("title", "desc", plan(300, 400)), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := handler(ctx, tc.content)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+	require.Len(t, k.GetParams(ctx).MintPlans, 3)
+
+	// the active plan can't be removed, neither a plan in the middle of the schedule
+	require.Error(t, handler(ctx, types.NewRemoveMintPlanProposal("title", "desc", 0)))
+	require.Error(t, handler(ctx, types.NewRemoveMintPlanProposal("title", "desc", 200)))
+	require.Error(t, handler(ctx, types.NewRemoveMintPlanProposal("title", "desc", 250)))
+	require.NoError(t, handler(ctx, types.NewRemoveMintPlanProposal("title", "desc", 300)))
+	require.Len(t, k.GetParams(ctx).MintPlans, 2)
+
+	require.NoError(t, handler(ctx, types.NewUpdateDefaultRewardProposal("title", "desc", sdk.NewDec(5))))
+	require.Equal(t, sdk.NewDec(5), k.GetParams(ctx).DefaultRewardPerBlock)
+
+	history, _, err := k.GetScheduleHistory(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	require.Equal(t, types.ProposalTypeAddMintPlan, history[0].ChangeType)
+	require.Equal(t, uint64(300), history[0].Plan.StartHeight)
+	require.Equal(t, types.ProposalTypeRemoveMintPlan, history[1].ChangeType)
+	require.Equal(t, types.ProposalTypeUpdateDefaultReward, history[2].ChangeType)
+	require.Equal(t, sdk.NewDec(5), history[2].DefaultReward)
+	require.Equal(t, uint64(3), history[2].Id)
+	require.Equal(t, int64(100), history[2].Height)
+}
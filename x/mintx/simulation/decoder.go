@@ -6,6 +6,7 @@ import (
 
 	"github.com/Seele-N/Seele/x/mintx/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 )
 
@@ -19,6 +20,18 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &minterA)
 			cdc.MustUnmarshal(kvB.Value, &minterB)
 			return fmt.Sprintf("%v\n%v", minterA, minterB)
+		case bytes.HasPrefix(kvA.Key, types.RecipientTotalKeyPrefix):
+			var totalA, totalB types.RecipientTotal
+			cdc.MustUnmarshal(kvA.Value, &totalA)
+			cdc.MustUnmarshal(kvB.Value, &totalB)
+			return fmt.Sprintf("%v\n%v", totalA, totalB)
+		case bytes.HasPrefix(kvA.Key, types.ScheduleChangeKeyPrefix):
+			var changeA, changeB types.ScheduleChange
+			cdc.MustUnmarshal(kvA.Value, &changeA)
+			cdc.MustUnmarshal(kvB.Value, &changeB)
+			return fmt.Sprintf("%v\n%v", changeA, changeB)
+		case bytes.Equal(kvA.Key, types.LastScheduleChangeIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
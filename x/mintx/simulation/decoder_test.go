@@ -0,0 +1,58 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/Seele-N/Seele/x/mintx/simulation"
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	minter := types.NewMinter(10)
+	total := types.NewRecipientTotal(types.RecipientTypeCommunityPool, "")
+	total.Distributed = sdk.NewInt(15)
+	change := types.ScheduleChange{Id: 2, Height: 5, ChangeType: types.ProposalTypeUpdateDefaultReward}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.MinterKey, Value: cdc.MustMarshal(&minter)},
+			{Key: types.RecipientTotalKey(total.Type, total.Address), Value: cdc.MustMarshal(&total)},
+			{Key: types.ScheduleChangeKey(change.Id), Value: cdc.MustMarshal(&change)},
+			{Key: types.LastScheduleChangeIDKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
+		{"RecipientTotal", fmt.Sprintf("%v\n%v", total, total)},
+		{"ScheduleChange", fmt.Sprintf("%v\n%v", change, change)},
+		{"LastScheduleChangeID", "2\n2"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
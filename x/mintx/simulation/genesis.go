@@ -0,0 +1,218 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+// Simulation parameter constants
+const (
+	MintDenom              = "mint_denom"
+	DefaultRewardPerBlock  = "default_reward_per_block"
+	MintPlans              = "mint_plans"
+	MaxSupply              = "max_supply"
+	DistributionRecipients = "distribution_recipients"
+	MintMode               = "mint_mode"
+	BondedRatioStartHeight = "bonded_ratio_start_height"
+	InflationRateChange    = "inflation_rate_change"
+	GoalBonded             = "goal_bonded"
+	TimeMintPlans          = "time_mint_plans"
+	MaxCatchUpDuration     = "max_catch_up_duration"
+)
+
+// GenMintDenom randomized MintDenom, either the bond denom or a dedicated denom
+func GenMintDenom(r *rand.Rand, bondDenom string) string {
+	if r.Intn(2) == 0 {
+		return bondDenom
+	}
+	return "mint" + strings.ToLower(simtypes.RandStringOfLength(r, 5))
+}
+
+// GenRewardPerBlock randomized reward per block, in whole tokens with up to 6 decimals
+func GenRewardPerBlock(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100_000_000)), 6)
+}
+
+// GenMintPlans randomized MintPlans, contiguous plans with random curves starting at the first block
+func GenMintPlans(r *rand.Rand) []types.MintPlan {
+	var plans []types.MintPlan
+	start := uint64(0)
+	n := r.Intn(5)
+	for i := 0; i < n; i++ {
+		end := start + uint64(simtypes.RandIntBetween(r, 1, 500))
+		plan := types.NewMintPlan(start, end, GenRewardPerBlock(r))
+		plan.Curve = types.CurveType(r.Intn(len(types.CurveType_name)))
+		if plan.Curve != types.CurveTypeConstant {
+			plan.Interval = uint64(simtypes.RandIntBetween(r, 1, 50))
+		}
+		switch plan.Curve {
+		case types.CurveTypeLinearDecay:
+			plan.DecayAmount = plan.RewardPerBlock.QuoInt64(int64(simtypes.RandIntBetween(r, 1, 20)))
+		case types.CurveTypeExponentialDecay:
+			plan.DecayFactor = sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
+		}
+		plans = append(plans, plan)
+		start = end
+	}
+	return plans
+}
+
+// GenMaxSupply randomized MaxSupply, no cap half of the time
+func GenMaxSupply(r *rand.Rand) sdk.Int {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroInt()
+	}
+	return sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000))).Mul(types.RewardPrecision.TruncateInt())
+}
+
+// GenDistributionRecipients randomized DistributionRecipients between the fee collector and the community pool
+func GenDistributionRecipients(r *rand.Rand) []types.DistributionRecipient {
+	weight := sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+	recipients := []types.DistributionRecipient{
+		{Type: types.RecipientTypeFeeCollector, Weight: sdk.OneDec().Sub(weight), ReceivesDust: true},
+	}
+	if weight.IsPositive() {
+		recipients = append(recipients, types.DistributionRecipient{Type: types.RecipientTypeCommunityPool, Weight: weight})
+	}
+	return recipients
+}
+
+// GenMintMode randomized MintMode
+func GenMintMode(r *rand.Rand) types.MintMode {
+	return types.MintMode(r.Intn(len(types.MintMode_name)))
+}
+
+// GenBondedRatioStartHeight randomized BondedRatioStartHeight
+func GenBondedRatioStartHeight(r *rand.Rand) uint64 {
+	return uint64(r.Intn(200))
+}
+
+// GenInflationRateChange randomized InflationRateChange
+func GenInflationRateChange(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
+}
+
+// GenGoalBonded randomized GoalBonded
+func GenGoalBonded(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
+}
+
+// GenTimeMintPlans randomized TimeMintPlans, contiguous windows starting at the genesis time
+func GenTimeMintPlans(r *rand.Rand, genesisTime time.Time) []types.TimeMintPlan {
+	var plans []types.TimeMintPlan
+	start := genesisTime
+	n := r.Intn(4)
+	for i := 0; i < n; i++ {
+		end := start.Add(time.Duration(simtypes.RandIntBetween(r, 1, 48)) * time.Hour)
+		plans = append(plans, types.NewTimeMintPlan(start, end, GenRewardPerBlock(r)))
+		start = end
+	}
+	return plans
+}
+
+// GenMaxCatchUpDuration randomized MaxCatchUpDuration
+func GenMaxCatchUpDuration(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(3600)) * time.Second
+}
+
+// RandomizedGenState generates a random GenesisState for mintx
+func RandomizedGenState(simState *module.SimulationState) {
+	var mintDenom string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MintDenom, &mintDenom, simState.Rand,
+		func(r *rand.Rand) { mintDenom = GenMintDenom(r, sdk.DefaultBondDenom) },
+	)
+
+	var defaultRewardPerBlock sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DefaultRewardPerBlock, &defaultRewardPerBlock, simState.Rand,
+		func(r *rand.Rand) { defaultRewardPerBlock = GenRewardPerBlock(r) },
+	)
+
+	var mintPlans []types.MintPlan
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MintPlans, &mintPlans, simState.Rand,
+		func(r *rand.Rand) { mintPlans = GenMintPlans(r) },
+	)
+
+	var maxSupply sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSupply, &maxSupply, simState.Rand,
+		func(r *rand.Rand) { maxSupply = GenMaxSupply(r) },
+	)
+
+	var recipients []types.DistributionRecipient
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DistributionRecipients, &recipients, simState.Rand,
+		func(r *rand.Rand) { recipients = GenDistributionRecipients(r) },
+	)
+
+	var mintMode types.MintMode
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MintMode, &mintMode, simState.Rand,
+		func(r *rand.Rand) { mintMode = GenMintMode(r) },
+	)
+
+	var bondedRatioStartHeight uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BondedRatioStartHeight, &bondedRatioStartHeight, simState.Rand,
+		func(r *rand.Rand) { bondedRatioStartHeight = GenBondedRatioStartHeight(r) },
+	)
+
+	var inflationRateChange sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationRateChange, &inflationRateChange, simState.Rand,
+		func(r *rand.Rand) { inflationRateChange = GenInflationRateChange(r) },
+	)
+
+	var goalBonded sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GoalBonded, &goalBonded, simState.Rand,
+		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
+	)
+
+	var timeMintPlans []types.TimeMintPlan
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TimeMintPlans, &timeMintPlans, simState.Rand,
+		func(r *rand.Rand) { timeMintPlans = GenTimeMintPlans(r, simState.GenTimestamp) },
+	)
+
+	var maxCatchUpDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxCatchUpDuration, &maxCatchUpDuration, simState.Rand,
+		func(r *rand.Rand) { maxCatchUpDuration = GenMaxCatchUpDuration(r) },
+	)
+
+	params := types.DefaultParams()
+	params.MintDenom = mintDenom
+	params.DefaultRewardPerBlock = defaultRewardPerBlock
+	params.MintPlans = mintPlans
+	params.MaxSupply = maxSupply
+	params.DistributionRecipients = recipients
+	params.MintMode = mintMode
+	params.BondedRatioStartHeight = bondedRatioStartHeight
+	params.InflationRateChange = inflationRateChange
+	params.GoalBonded = goalBonded
+	params.TimeMintPlans = timeMintPlans
+	params.MaxCatchUpDuration = maxCatchUpDuration
+
+	mintGenesis := types.NewGenesisState(types.DefaultInitialMinter(), params, nil, nil)
+
+	bz, err := json.MarshalIndent(&mintGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated mintx parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(mintGenesis)
+}
@@ -0,0 +1,43 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/Seele-N/Seele/x/mintx/simulation"
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+// TestRandomizedGenState checks that the randomized genesis states are valid for many seeds.
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	for seed := int64(0); seed < 50; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: 1000,
+			GenState:     make(map[string]json.RawMessage),
+			GenTimestamp: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		simulation.RandomizedGenState(&simState)
+
+		var mintGenesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &mintGenesis)
+		require.NoError(t, types.ValidateGenesis(mintGenesis), "seed %d", seed)
+	}
+}
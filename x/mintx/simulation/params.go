@@ -3,13 +3,38 @@ package simulation
 // DONTCOVER
 
 import (
+	"fmt"
 	"math/rand"
 
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Seele-N/Seele/x/mintx/types"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
-// on the simulation
+// on the simulation. The max supply is left out as a lower cap than the minted amount breaks the invariant.
 func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
-	return []simtypes.ParamChange{}
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDefaultRewardPerBlock),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenRewardPerBlock(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyInflationRateChange),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenInflationRateChange(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyGoalBonded),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenGoalBonded(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBondedRatioStartHeight),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenBondedRatioStartHeight(r))
+			},
+		),
+	}
 }
@@ -0,0 +1,71 @@
+package simulation
+
+import (
+	"math/rand"
+
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Seele-N/Seele/x/mintx/keeper"
+	"github.com/Seele-N/Seele/x/mintx/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightSubmitAddMintPlanProposal         = "op_weight_submit_add_mint_plan_proposal"
+	OpWeightSubmitUpdateDefaultRewardProposal = "op_weight_submit_update_default_reward_proposal"
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitAddMintPlanProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateAddMintPlanProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitUpdateDefaultRewardProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateUpdateDefaultRewardProposalContent,
+		),
+	}
+}
+
+// SimulateAddMintPlanProposalContent generates random add mint plan proposal content,
+// the plan follows the last plan of the schedule.
+func SimulateAddMintPlanProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		start := uint64(ctx.BlockHeight())
+		plans := k.GetParams(ctx).MintPlans
+		if len(plans) > 0 {
+			start = plans[0].EndHeight
+			for _, plan := range plans {
+				if plan.EndHeight > start {
+					start = plan.EndHeight
+				}
+			}
+			if start < uint64(ctx.BlockHeight()) {
+				return nil
+			}
+		}
+
+		end := start + uint64(simtypes.RandIntBetween(r, 1, 500))
+		return types.NewAddMintPlanProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			types.NewMintPlan(start, end, GenRewardPerBlock(r)),
+		)
+	}
+}
+
+// SimulateUpdateDefaultRewardProposalContent generates random update default reward proposal content
+func SimulateUpdateDefaultRewardProposalContent(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) simtypes.Content {
+	return types.NewUpdateDefaultRewardProposal(
+		simtypes.RandStringOfLength(r, 10),
+		simtypes.RandStringOfLength(r, 100),
+		GenRewardPerBlock(r),
+	)
+}
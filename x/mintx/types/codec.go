@@ -2,7 +2,9 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
@@ -10,6 +12,24 @@ var (
 )
 
 func init() {
+	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
 }
+
+// RegisterLegacyAminoCodec registers the proposals of the module on the given LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&AddMintPlanProposal{}, "mintx/AddMintPlanProposal", nil)
+	cdc.RegisterConcrete(&RemoveMintPlanProposal{}, "mintx/RemoveMintPlanProposal", nil)
+	cdc.RegisterConcrete(&UpdateDefaultRewardProposal{}, "mintx/UpdateDefaultRewardProposal", nil)
+}
+
+// RegisterInterfaces registers the proposals of the module as gov contents.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&AddMintPlanProposal{},
+		&RemoveMintPlanProposal{},
+		&UpdateDefaultRewardProposal{},
+	)
+}
@@ -2,9 +2,18 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint             = ModuleName
+	EventTypeMintDistribution = "mint_distribution"
+	EventTypeVestingReleased  = "mint_vesting_released"
+	EventTypeScheduleChange   = "mint_schedule_change"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeyVesting          = "vesting"
+	AttributeKeyChangeType       = "change_type"
+	AttributeKeyStartHeight      = "start_height"
+	AttributeKeyEndHeight        = "end_height"
+	AttributeKeyRewardPerBlock   = "reward_per_block"
 )
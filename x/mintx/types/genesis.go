@@ -1,10 +1,14 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(minter Minter, params Params) *GenesisState {
+func NewGenesisState(minter Minter, params Params, recipientTotals []RecipientTotal, scheduleHistory []ScheduleChange) *GenesisState {
 	return &GenesisState{
-		Minter: minter,
-		Params: params,
+		Minter:          minter,
+		Params:          params,
+		RecipientTotals: recipientTotals,
+		ScheduleHistory: scheduleHistory,
 	}
 }
 
@@ -23,5 +27,26 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	seen := make(map[string]bool)
+	for _, total := range data.RecipientTotals {
+		key := string(RecipientTotalKey(total.Type, total.Address))
+		if seen[key] {
+			return fmt.Errorf("duplicate totals for recipient %s/%s", total.Type, total.Address)
+		}
+		seen[key] = true
+
+		if err := total.Validate(); err != nil {
+			return err
+		}
+	}
+
+	ids := make(map[uint64]bool)
+	for _, change := range data.ScheduleHistory {
+		if change.Id == 0 || ids[change.Id] {
+			return fmt.Errorf("invalid or duplicate schedule change id %d", change.Id)
+		}
+		ids[change.Id] = true
+	}
+
 	return ValidateMinter(data.Minter)
 }
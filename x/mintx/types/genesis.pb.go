@@ -29,6 +29,10 @@ type GenesisState struct {
 	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
 	// params defines all the paramaters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// recipient_totals are the cumulative amounts distributed to the recipients of the minted coins.
+	RecipientTotals []RecipientTotal `protobuf:"bytes,3,rep,name=recipient_totals,json=recipientTotals,proto3" json:"recipient_totals"`
+	// schedule_history are the changes of the mint schedule made by governance.
+	ScheduleHistory []ScheduleChange `protobuf:"bytes,4,rep,name=schedule_history,json=scheduleHistory,proto3" json:"schedule_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +82,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRecipientTotals() []RecipientTotal {
+	if m != nil {
+		return m.RecipientTotals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduleHistory() []ScheduleChange {
+	if m != nil {
+		return m.ScheduleHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.mintx.v1beta1.GenesisState")
 }
@@ -85,20 +103,25 @@ func init() {
 func init() { proto.RegisterFile("seele/mintx/v1beta1/genesis.proto", fileDescriptor_1d43cecc8e33db0a) }
 
 var fileDescriptor_1d43cecc8e33db0a = []byte{
-	// 202 bytes of a gzipped FileDescriptorProto
+	// 280 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0x4e, 0x4d, 0xcd,
 	0x49, 0xd5, 0xcf, 0xcd, 0xcc, 0x2b, 0xa9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
 	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x49,
 	0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0x03, 0xab, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f,
 	0xcf, 0x07, 0x2b, 0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0xe4, 0xb0, 0x19, 0x07, 0xe2, 0x41, 0xe4,
-	0x95, 0xda, 0x18, 0xb9, 0x78, 0xdc, 0x21, 0xa6, 0x07, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x71,
-	0xb1, 0x81, 0xa4, 0x53, 0x8b, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0xd9,
-	0xa6, 0xe7, 0x0b, 0x56, 0xe3, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x07, 0x48, 0x6f,
-	0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0x13, 0x3e, 0xbd, 0x01, 0x60, 0x35, 0x30, 0xbd, 0x10,
-	0x1d, 0x4e, 0xea, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3,
-	0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xc5, 0x5b, 0x01,
-	0x75, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xe1, 0xc6, 0x80, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xaf, 0x4e, 0x7c, 0xc6, 0x29, 0x01, 0x00, 0x00,
+	0x95, 0xb6, 0x30, 0x71, 0xf1, 0xb8, 0x43, 0x4c, 0x0f, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe2,
+	0x62, 0x03, 0x49, 0xa7, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xe8, 0x61, 0xb3,
+	0x4d, 0xcf, 0x17, 0xac, 0xc6, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x0e, 0x90, 0xde,
+	0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x26, 0x7c, 0x7a, 0x03, 0xc0, 0x6a, 0x60, 0x7a, 0x21,
+	0x3a, 0x84, 0x42, 0xb9, 0x04, 0x8a, 0x52, 0x93, 0x33, 0x0b, 0x32, 0x53, 0xf3, 0x4a, 0xe2, 0x4b,
+	0xf2, 0x4b, 0x12, 0x73, 0x8a, 0x25, 0x98, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0x54, 0xb0, 0x9b, 0x12,
+	0x04, 0x53, 0x1d, 0x02, 0x52, 0x0c, 0x35, 0x8d, 0xbf, 0x08, 0x45, 0x14, 0x6c, 0x6c, 0x71, 0x72,
+	0x46, 0x6a, 0x4a, 0x69, 0x4e, 0x6a, 0x7c, 0x46, 0x66, 0x71, 0x49, 0x7e, 0x51, 0xa5, 0x04, 0x0b,
+	0x3e, 0x63, 0x83, 0xa1, 0xaa, 0x9d, 0x33, 0x12, 0xf3, 0xd2, 0x53, 0x61, 0xc6, 0xc2, 0xcc, 0xf0,
+	0x80, 0x18, 0xe1, 0xa4, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
+	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xbc,
+	0x15, 0xd0, 0xc0, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07, 0xb3, 0x31, 0x60, 0x00,
+	0x51, 0x19, 0x7a, 0x6f, 0xd7, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +144,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduleHistory) > 0 {
+		for iNdEx := len(m.ScheduleHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduleHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RecipientTotals) > 0 {
+		for iNdEx := len(m.RecipientTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -165,6 +216,18 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RecipientTotals) > 0 {
+		for _, e := range m.RecipientTotals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduleHistory) > 0 {
+		for _, e := range m.ScheduleHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -269,6 +332,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientTotals = append(m.RecipientTotals, RecipientTotal{})
+			if err := m.RecipientTotals[len(m.RecipientTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleHistory = append(m.ScheduleHistory, ScheduleChange{})
+			if err := m.ScheduleHistory[len(m.ScheduleHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Default parameters of the bonded ratio targeting mode, the same as the sdk mint module
+var (
+	DefaultInflationRateChange = sdk.NewDecWithPrec(13, 2)
+	DefaultInflationMax        = sdk.NewDecWithPrec(20, 2)
+	DefaultInflationMin        = sdk.NewDecWithPrec(7, 2)
+	DefaultGoalBonded          = sdk.NewDecWithPrec(67, 2)
+)
+
+// IsBondedRatioTargeting returns true if the amount minted at the height follows the bonded ratio
+func (p *Params) IsBondedRatioTargeting(height uint64) bool {
+	return p.MintMode == MintModeBondedRatio && height > p.BondedRatioStartHeight
+}
+
+// NextInflationRate returns the inflation rate of the next block, it moves toward
+// the goal bonded ratio within the min and max bounds.
+func (p *Params) NextInflationRate(inflation, bondedRatio sdk.Dec) sdk.Dec {
+	// the inflation rate changes by up to InflationRateChange in a year
+	// depending on the distance between the bonded ratio and its goal
+	inflationRateChangePerYear := sdk.OneDec().
+		Sub(bondedRatio.Quo(p.GoalBonded)).
+		Mul(p.InflationRateChange)
+	inflationRateChange := inflationRateChangePerYear.Quo(sdk.NewDec(int64(p.BlocksPerYear)))
+
+	inflation = inflation.Add(inflationRateChange)
+	if inflation.GT(p.InflationMax) {
+		inflation = p.InflationMax
+	}
+	if inflation.LT(p.InflationMin) {
+		inflation = p.InflationMin
+	}
+	return inflation
+}
+
+// BlockProvision returns the amount minted at a block by the inflation rate applied to the supply
+func (p *Params) BlockProvision(inflation sdk.Dec, supply sdk.Int) sdk.Int {
+	return inflation.MulInt(supply).QuoInt64(int64(p.BlocksPerYear)).TruncateInt()
+}
+
+func validateMintMode(i interface{}) error {
+	v, ok := i.(MintMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := MintMode_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown mint mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateBondedRatioStartHeight(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateRate returns a validation function checking the named rate is between 0 and 1
+func validateRate(name string) func(i interface{}) error {
+	return func(i interface{}) error {
+		v, ok := i.(sdk.Dec)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+
+		if v.IsNil() || v.IsNegative() {
+			return fmt.Errorf("%s cannot be negative: %s", name, v)
+		}
+		if v.GT(sdk.OneDec()) {
+			return fmt.Errorf("%s too large: %s", name, v)
+		}
+
+		return nil
+	}
+}
+
+func validateGoalBonded(i interface{}) error {
+	if err := validateRate("goal bonded")(i); err != nil {
+		return err
+	}
+
+	if v := i.(sdk.Dec); v.IsZero() {
+		return fmt.Errorf("goal bonded must be positive: %s", v)
+	}
+
+	return nil
+}
@@ -1,8 +1,29 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 // MinterKey is the key to use for the keeper store.
 var MinterKey = []byte{0x00}
 
+// RecipientTotalKeyPrefix is the prefix of the cumulative amounts distributed to the recipients
+var RecipientTotalKeyPrefix = []byte{0x01}
+
+// ScheduleChangeKeyPrefix is the prefix of the history of the mint schedule
+var ScheduleChangeKeyPrefix = []byte{0x02}
+
+// LastScheduleChangeIDKey is the key of the id of the last change of the mint schedule
+var LastScheduleChangeIDKey = []byte{0x03}
+
+// ScheduleChangeKey returns the key of a change of the mint schedule
+func ScheduleChangeKey(id uint64) []byte {
+	return append(ScheduleChangeKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// RecipientTotalKey returns the key of the cumulative amounts distributed to a recipient
+func RecipientTotalKey(recipientType RecipientType, address string) []byte {
+	return append(append(RecipientTotalKeyPrefix, byte(recipientType)), address...)
+}
+
 const (
 	// module name
 	ModuleName = "mintx"
@@ -13,6 +34,9 @@ const (
 	// QuerierRoute is the querier route for the minting store.
 	QuerierRoute = StoreKey
 
+	// RouterKey is the message route for the minting proposals.
+	RouterKey = ModuleName
+
 	// Query endpoints supported by the minting querier
 	QueryParameters       = "parameters"
 	QueryInflation        = "inflation"
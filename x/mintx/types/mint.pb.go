@@ -8,15 +8,20 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,10 +29,126 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MintMode defines how the amount minted at every block is computed.
+type MintMode int32
+
+const (
+	// the reward of the mint plans
+	MintModeHeightPlans MintMode = 0
+	// the reward of the mint plans until the bonded ratio start height, then an inflation rate
+	// moving toward the goal bonded ratio applied to the supply of the mint denom
+	MintModeBondedRatio MintMode = 1
+	// the reward per second of the time mint plans applied to the time elapsed since the last block
+	MintModeTimePlans MintMode = 2
+)
+
+var MintMode_name = map[int32]string{
+	0: "MINT_MODE_HEIGHT_PLANS",
+	1: "MINT_MODE_BONDED_RATIO",
+	2: "MINT_MODE_TIME_PLANS",
+}
+
+var MintMode_value = map[string]int32{
+	"MINT_MODE_HEIGHT_PLANS": 0,
+	"MINT_MODE_BONDED_RATIO": 1,
+	"MINT_MODE_TIME_PLANS":   2,
+}
+
+func (x MintMode) String() string {
+	return proto.EnumName(MintMode_name, int32(x))
+}
+
+func (MintMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77788c626497cae8, []int{0}
+}
+
+// CurveType defines how the reward of a mint plan evolves between its start and end heights.
+type CurveType int32
+
+const (
+	// the reward is constant
+	CurveTypeConstant CurveType = 0
+	// the reward is halved every interval
+	CurveTypeHalving CurveType = 1
+	// the decay amount is removed from the reward every interval
+	CurveTypeLinearDecay CurveType = 2
+	// the reward is multiplied by the decay factor every interval
+	CurveTypeExponentialDecay CurveType = 3
+)
+
+var CurveType_name = map[int32]string{
+	0: "CURVE_TYPE_CONSTANT",
+	1: "CURVE_TYPE_HALVING",
+	2: "CURVE_TYPE_LINEAR_DECAY",
+	3: "CURVE_TYPE_EXPONENTIAL_DECAY",
+}
+
+var CurveType_value = map[string]int32{
+	"CURVE_TYPE_CONSTANT":          0,
+	"CURVE_TYPE_HALVING":           1,
+	"CURVE_TYPE_LINEAR_DECAY":      2,
+	"CURVE_TYPE_EXPONENTIAL_DECAY": 3,
+}
+
+func (x CurveType) String() string {
+	return proto.EnumName(CurveType_name, int32(x))
+}
+
+func (CurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77788c626497cae8, []int{1}
+}
+
+// RecipientType defines the kind of account receiving a share of the minted coins.
+type RecipientType int32
+
+const (
+	RecipientTypeUnspecified RecipientType = 0
+	// the fee collector, the share is distributed to the validators and delegators
+	RecipientTypeFeeCollector RecipientType = 1
+	// the community pool of the distribution module
+	RecipientTypeCommunityPool RecipientType = 2
+	// a module account designated by its name
+	RecipientTypeModuleAccount RecipientType = 3
+	// an account designated by its bech32 address
+	RecipientTypeAddress RecipientType = 4
+)
+
+var RecipientType_name = map[int32]string{
+	0: "RECIPIENT_TYPE_UNSPECIFIED",
+	1: "RECIPIENT_TYPE_FEE_COLLECTOR",
+	2: "RECIPIENT_TYPE_COMMUNITY_POOL",
+	3: "RECIPIENT_TYPE_MODULE_ACCOUNT",
+	4: "RECIPIENT_TYPE_ADDRESS",
+}
+
+var RecipientType_value = map[string]int32{
+	"RECIPIENT_TYPE_UNSPECIFIED":    0,
+	"RECIPIENT_TYPE_FEE_COLLECTOR":  1,
+	"RECIPIENT_TYPE_COMMUNITY_POOL": 2,
+	"RECIPIENT_TYPE_MODULE_ACCOUNT": 3,
+	"RECIPIENT_TYPE_ADDRESS":        4,
+}
+
+func (x RecipientType) String() string {
+	return proto.EnumName(RecipientType_name, int32(x))
+}
+
+func (RecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77788c626497cae8, []int{2}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// start height adjustment
 	HeightAdjustment uint64 `protobuf:"varint,1,opt,name=HeightAdjustment,proto3" json:"HeightAdjustment,omitempty" yaml:"height_adjustment"`
+	// cumulative amount minted by the module, in the base unit of the mint denom
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=TotalMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"TotalMinted" yaml:"total_minted"`
+	// current annual inflation rate of the bonded ratio targeting mode
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// time of the last block processed by the minter
+	LastMintTime time.Time `protobuf:"bytes,4,opt,name=last_mint_time,json=lastMintTime,proto3,stdtime" json:"last_mint_time" yaml:"last_mint_time"`
+	// true once the bonded ratio targeting has started, the inflation is then updated every block
+	InflationStarted bool `protobuf:"varint,5,opt,name=inflation_started,json=inflationStarted,proto3" json:"inflation_started,omitempty" yaml:"inflation_started"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -70,6 +191,20 @@ func (m *Minter) GetHeightAdjustment() uint64 {
 	return 0
 }
 
+func (m *Minter) GetLastMintTime() time.Time {
+	if m != nil {
+		return m.LastMintTime
+	}
+	return time.Time{}
+}
+
+func (m *Minter) GetInflationStarted() bool {
+	if m != nil {
+		return m.InflationStarted
+	}
+	return false
+}
+
 // DepositParams defines the params for deposits on governance proposals.
 type MintPlan struct {
 	// expected start height
@@ -78,6 +213,14 @@ type MintPlan struct {
 	EndHeight uint64 `protobuf:"varint,2,opt,name=EndHeight,proto3" json:"EndHeight,omitempty" yaml:"end_height"`
 	// reward by block
 	RewardPerBlock github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=RewardPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"RewardPerBlock" yaml:"reward_per_block"`
+	// emission curve applied to the reward, constant by default
+	Curve CurveType `protobuf:"varint,4,opt,name=Curve,proto3,enum=cosmos.mintx.v1beta1.CurveType" json:"Curve,omitempty" yaml:"curve"`
+	// number of blocks between two decays of the reward
+	Interval uint64 `protobuf:"varint,5,opt,name=Interval,proto3" json:"Interval,omitempty" yaml:"interval"`
+	// amount removed from the reward at every interval of a linear decay
+	DecayAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=DecayAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"DecayAmount" yaml:"decay_amount"`
+	// factor applied to the reward at every interval of an exponential decay
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=DecayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"DecayFactor" yaml:"decay_factor"`
 }
 
 func (m *MintPlan) Reset()         { *m = MintPlan{} }
@@ -127,6 +270,75 @@ func (m *MintPlan) GetEndHeight() uint64 {
 	return 0
 }
 
+func (m *MintPlan) GetCurve() CurveType {
+	if m != nil {
+		return m.Curve
+	}
+	return CurveTypeConstant
+}
+
+func (m *MintPlan) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+// TimeMintPlan defines a reward per second minted between a start time included and an end time excluded.
+type TimeMintPlan struct {
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// reward by second, in whole tokens like the reward by block of the mint plans
+	RewardPerSecond github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_per_second,json=rewardPerSecond,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_per_second" yaml:"reward_per_second"`
+}
+
+func (m *TimeMintPlan) Reset()         { *m = TimeMintPlan{} }
+func (m *TimeMintPlan) String() string { return proto.CompactTextString(m) }
+func (*TimeMintPlan) ProtoMessage()    {}
+func (*TimeMintPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77788c626497cae8, []int{2}
+}
+func (m *TimeMintPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeMintPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeMintPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeMintPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeMintPlan.Merge(m, src)
+}
+func (m *TimeMintPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeMintPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeMintPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeMintPlan proto.InternalMessageInfo
+
+func (m *TimeMintPlan) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *TimeMintPlan) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 // Params holds parameters for the mint module.
 type Params struct {
 	// type of coin to mint
@@ -135,12 +347,34 @@ type Params struct {
 	DefaultRewardPerBlock github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=DefaultRewardPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"DefaultRewardPerBlock" yaml:"default_reward_per_block"`
 	//  Minimum deposit for a proposal to enter voting period.
 	MintPlans []MintPlan `protobuf:"bytes,3,rep,name=mint_plans,json=mintPlans,proto3" json:"mint_plans" yaml:"mint_plans"`
+	// expected blocks per year, used to annualize the rewards
+	BlocksPerYear uint64 `protobuf:"varint,4,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// maximum amount minted by the module, in the base unit of the mint denom, zero means no cap
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+	// recipients of the minted coins, their weights must sum to 1
+	DistributionRecipients []DistributionRecipient `protobuf:"bytes,6,rep,name=distribution_recipients,json=distributionRecipients,proto3" json:"distribution_recipients" yaml:"distribution_recipients"`
+	// how the minted amount is computed
+	MintMode MintMode `protobuf:"varint,7,opt,name=mint_mode,json=mintMode,proto3,enum=cosmos.mintx.v1beta1.MintMode" json:"mint_mode,omitempty" yaml:"mint_mode"`
+	// the mint plans are used until this height in the bonded ratio mode
+	BondedRatioStartHeight uint64 `protobuf:"varint,8,opt,name=bonded_ratio_start_height,json=bondedRatioStartHeight,proto3" json:"bonded_ratio_start_height,omitempty" yaml:"bonded_ratio_start_height"`
+	// maximum annual change in inflation rate
+	InflationRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate_change" yaml:"inflation_rate_change"`
+	// maximum inflation rate
+	InflationMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max" yaml:"inflation_max"`
+	// minimum inflation rate
+	InflationMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min" yaml:"inflation_min"`
+	// goal of percent bonded atoms
+	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
+	// plans of the time mode, defined by UTC time windows
+	TimeMintPlans []TimeMintPlan `protobuf:"bytes,13,rep,name=time_mint_plans,json=timeMintPlans,proto3" json:"time_mint_plans" yaml:"time_mint_plans"`
+	// maximum time elapsed since the last block minted by a block of the time mode, limits the catch-up after a halt
+	MaxCatchUpDuration time.Duration `protobuf:"bytes,14,opt,name=max_catch_up_duration,json=maxCatchUpDuration,proto3,stdduration" json:"max_catch_up_duration" yaml:"max_catch_up_duration"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77788c626497cae8, []int{2}
+	return fileDescriptor_77788c626497cae8, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,239 +417,2981 @@ func (m *Params) GetMintPlans() []MintPlan {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Minter)(nil), "cosmos.mintx.v1beta1.Minter")
-	proto.RegisterType((*MintPlan)(nil), "cosmos.mintx.v1beta1.MintPlan")
-	proto.RegisterType((*Params)(nil), "cosmos.mintx.v1beta1.Params")
+func (m *Params) GetBlocksPerYear() uint64 {
+	if m != nil {
+		return m.BlocksPerYear
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("seele/mintx/v1beta1/mint.proto", fileDescriptor_77788c626497cae8) }
-
-var fileDescriptor_77788c626497cae8 = []byte{
-	// 438 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0xcf, 0x49, 0x15, 0x11, 0x57, 0x20, 0x30, 0xad, 0x1a, 0x10, 0xd8, 0x91, 0x07, 0xc8,
-	0xc2, 0x9d, 0x4a, 0x27, 0xba, 0x71, 0x0a, 0x52, 0x19, 0x90, 0xc2, 0xb1, 0x20, 0x96, 0x93, 0x93,
-	0x33, 0x49, 0xe8, 0x9d, 0x7d, 0xd8, 0x0e, 0xb4, 0x9f, 0x80, 0x15, 0x36, 0x46, 0x3e, 0x4e, 0xc7,
-	0x8e, 0x88, 0xe1, 0x84, 0x92, 0x99, 0xe5, 0x3e, 0x01, 0xb2, 0x7d, 0x34, 0xa8, 0xcd, 0xd2, 0xe9,
-	0xee, 0x7c, 0xbf, 0xff, 0xff, 0xfd, 0x9f, 0xdf, 0x83, 0x58, 0x73, 0x9e, 0xf3, 0xa8, 0x98, 0x0b,
-	0x73, 0x12, 0x7d, 0xda, 0x1f, 0x73, 0xc3, 0xf6, 0xdd, 0x57, 0x58, 0x2a, 0x69, 0x24, 0xda, 0x99,
-	0x48, 0x5d, 0x48, 0x1d, 0x3a, 0x20, 0x6c, 0x80, 0xfb, 0x3b, 0x53, 0x39, 0x95, 0x0e, 0x88, 0xec,
-	0x9b, 0x67, 0x69, 0x02, 0x3b, 0xaf, 0xe6, 0xc2, 0x70, 0x85, 0x8e, 0xe0, 0xed, 0x23, 0x3e, 0x9f,
-	0xce, 0xcc, 0xf3, 0xec, 0xc3, 0x42, 0x9b, 0x82, 0x0b, 0xd3, 0x03, 0x7d, 0x30, 0xd8, 0x8a, 0x1f,
-	0xd4, 0x15, 0xe9, 0x9d, 0xb2, 0x22, 0x3f, 0xa4, 0x33, 0x47, 0xa4, 0xec, 0x02, 0xa1, 0xc9, 0x15,
-	0x15, 0xfd, 0x03, 0xe0, 0x0d, 0x6b, 0x3a, 0xca, 0x99, 0x40, 0xcf, 0xe0, 0xf6, 0x1b, 0xc3, 0x94,
-	0xf1, 0x54, 0xe3, 0xb8, 0x57, 0x57, 0xe4, 0xae, 0x77, 0xd4, 0xf6, 0x67, 0xea, 0x7d, 0x69, 0xf2,
-	0x3f, 0x8b, 0x0e, 0x60, 0xf7, 0x85, 0xc8, 0x1a, 0x61, 0xcb, 0x09, 0x77, 0xeb, 0x8a, 0xdc, 0xf1,
-	0x42, 0x2e, 0xb2, 0x0b, 0xd9, 0x9a, 0x43, 0x1f, 0xe1, 0xad, 0x84, 0x7f, 0x66, 0x2a, 0x1b, 0x71,
-	0x15, 0xe7, 0x72, 0x72, 0xdc, 0x6b, 0xf7, 0xc1, 0xa0, 0x1b, 0xbf, 0x3c, 0xab, 0x48, 0xf0, 0xab,
-	0x22, 0x8f, 0xa6, 0x73, 0x33, 0x5b, 0x8c, 0xc3, 0x89, 0x2c, 0x22, 0x7f, 0x4f, 0xcd, 0xe3, 0x89,
-	0xce, 0x8e, 0x23, 0x73, 0x5a, 0x72, 0x1d, 0x0e, 0xf9, 0xa4, 0xae, 0xc8, 0x9e, 0xaf, 0xa3, 0x9c,
-	0x5b, 0x5a, 0x72, 0x95, 0x8e, 0xad, 0x1f, 0x4d, 0x2e, 0x15, 0xa0, 0xdf, 0x5a, 0xb0, 0x33, 0x62,
-	0x8a, 0x15, 0x1a, 0x3d, 0x84, 0xd0, 0xde, 0x7a, 0x9a, 0x71, 0x21, 0x0b, 0xd7, 0x6c, 0x37, 0xe9,
-	0xda, 0x93, 0xa1, 0x3d, 0x40, 0x5f, 0x00, 0xdc, 0x1d, 0xf2, 0xf7, 0x6c, 0x91, 0x9b, 0x4b, 0x21,
-	0x5b, 0x2e, 0xe4, 0xeb, 0x6b, 0x87, 0x24, 0x3e, 0x64, 0xe6, 0x4d, 0xd3, 0xab, 0x61, 0x37, 0xd7,
-	0x43, 0x6f, 0x9b, 0xa0, 0x65, 0xce, 0x84, 0xee, 0xb5, 0xfb, 0xed, 0xc1, 0xf6, 0x53, 0x1c, 0x6e,
-	0x5a, 0x9c, 0xf0, 0xdf, 0x28, 0xe3, 0x7b, 0x36, 0xdd, 0x7a, 0x00, 0x6b, 0x3d, 0xf5, 0x3d, 0x5a,
-	0x48, 0x1f, 0x6e, 0x7d, 0xff, 0x41, 0x82, 0xf8, 0xf1, 0xd9, 0x12, 0x83, 0xf3, 0x25, 0x06, 0xbf,
-	0x97, 0x18, 0x7c, 0x5d, 0xe1, 0xe0, 0x7c, 0x85, 0x83, 0x9f, 0x2b, 0x1c, 0xbc, 0xbb, 0x79, 0xd2,
-	0x6c, 0xae, 0x6b, 0x63, 0xdc, 0x71, 0x7b, 0x78, 0xf0, 0x37, 0x00, 0x00, 0xff, 0xff, 0x55, 0xe2,
-	0x8d, 0x37, 0xd5, 0x02, 0x00, 0x00,
+func (m *Params) GetDistributionRecipients() []DistributionRecipient {
+	if m != nil {
+		return m.DistributionRecipients
+	}
+	return nil
 }
 
-func (m *Minter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetMintMode() MintMode {
+	if m != nil {
+		return m.MintMode
 	}
-	return dAtA[:n], nil
+	return MintModeHeightPlans
 }
 
-func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetBondedRatioStartHeight() uint64 {
+	if m != nil {
+		return m.BondedRatioStartHeight
+	}
+	return 0
 }
 
-func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HeightAdjustment != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.HeightAdjustment))
-		i--
-		dAtA[i] = 0x8
+func (m *Params) GetTimeMintPlans() []TimeMintPlan {
+	if m != nil {
+		return m.TimeMintPlans
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MintPlan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetMaxCatchUpDuration() time.Duration {
+	if m != nil {
+		return m.MaxCatchUpDuration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MintPlan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DistributionRecipient defines a share of the minted coins.
+type DistributionRecipient struct {
+	Type RecipientType `protobuf:"varint,1,opt,name=type,proto3,enum=cosmos.mintx.v1beta1.RecipientType" json:"type,omitempty"`
+	// name of the module account or bech32 address of the account, empty for the other types
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// part of the minted coins sent to the recipient
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+	// the recipient also gets the rounding dust of the split, exactly one recipient must be set
+	ReceivesDust bool `protobuf:"varint,4,opt,name=receives_dust,json=receivesDust,proto3" json:"receives_dust,omitempty" yaml:"receives_dust"`
+	// the share of an address is kept by the module until this height
+	VestingEndHeight uint64 `protobuf:"varint,5,opt,name=vesting_end_height,json=vestingEndHeight,proto3" json:"vesting_end_height,omitempty" yaml:"vesting_end_height"`
 }
 
-func (m *MintPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RewardPerBlock.Size()
-		i -= size
-		if _, err := m.RewardPerBlock.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *DistributionRecipient) Reset()         { *m = DistributionRecipient{} }
+func (m *DistributionRecipient) String() string { return proto.CompactTextString(m) }
+func (*DistributionRecipient) ProtoMessage()    {}
+func (*DistributionRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77788c626497cae8, []int{4}
+}
+func (m *DistributionRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.EndHeight != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.EndHeight))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *DistributionRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionRecipient.Merge(m, src)
+}
+func (m *DistributionRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionRecipient proto.InternalMessageInfo
+
+func (m *DistributionRecipient) GetType() RecipientType {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return RecipientTypeUnspecified
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DistributionRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DistributionRecipient) GetReceivesDust() bool {
+	if m != nil {
+		return m.ReceivesDust
+	}
+	return false
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MintPlans) > 0 {
-		for iNdEx := len(m.MintPlans) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MintPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMint(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *DistributionRecipient) GetVestingEndHeight() uint64 {
+	if m != nil {
+		return m.VestingEndHeight
 	}
-	{
-		size := m.DefaultRewardPerBlock.Size()
-		i -= size
-		if _, err := m.DefaultRewardPerBlock.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// RecipientTotal holds the cumulative amounts distributed to a recipient, in the base unit of the mint denom.
+type RecipientTotal struct {
+	Type    RecipientType `protobuf:"varint,1,opt,name=type,proto3,enum=cosmos.mintx.v1beta1.RecipientType" json:"type,omitempty"`
+	Address string        `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount sent to the recipient
+	Distributed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=distributed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"distributed"`
+	// amount kept by the module until the end of the vesting of the recipient
+	Vesting github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=vesting,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"vesting"`
+}
+
+func (m *RecipientTotal) Reset()         { *m = RecipientTotal{} }
+func (m *RecipientTotal) String() string { return proto.CompactTextString(m) }
+func (*RecipientTotal) ProtoMessage()    {}
+func (*RecipientTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77788c626497cae8, []int{5}
+}
+func (m *RecipientTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecipientTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecipientTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RecipientTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipientTotal.Merge(m, src)
+}
+func (m *RecipientTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecipientTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipientTotal.DiscardUnknown(m)
 }
 
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_RecipientTotal proto.InternalMessageInfo
+
+func (m *RecipientTotal) GetType() RecipientType {
+	if m != nil {
+		return m.Type
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return RecipientTypeUnspecified
 }
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *RecipientTotal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// AddMintPlanProposal defines a proposal to add a plan to the mint schedule.
+type AddMintPlanProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Plan        MintPlan `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan"`
+}
+
+func (m *AddMintPlanProposal) Reset()      { *m = AddMintPlanProposal{} }
+func (*AddMintPlanProposal) ProtoMessage() {}
+func (*AddMintPlanProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77788c626497cae8, []int{6}
+}
+func (m *AddMintPlanProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddMintPlanProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddMintPlanProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddMintPlanProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddMintPlanProposal.Merge(m, src)
+}
+func (m *AddMintPlanProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddMintPlanProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddMintPlanProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddMintPlanProposal proto.InternalMessageInfo
+
+// RemoveMintPlanProposal defines a proposal to remove a plan which hasn't started from the mint schedule.
+type RemoveMintPlanProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+}
+
+func (m *RemoveMintPlanProposal) Reset()      { *m = RemoveMintPlanProposal{} }
+func (*RemoveMintPlanProposal) ProtoMessage() {}
+func (*RemoveMintPlanProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77788c626497cae8, []int{7}
+}
+func (m *RemoveMintPlanProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveMintPlanProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveMintPlanProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveMintPlanProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveMintPlanProposal.Merge(m, src)
+}
+func (m *RemoveMintPlanProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveMintPlanProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveMintPlanProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveMintPlanProposal proto.InternalMessageInfo
+
+// UpdateDefaultRewardProposal defines a proposal to change the reward of the heights without plan.
+type UpdateDefaultRewardProposal struct {
+	Title                 string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description           string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DefaultRewardPerBlock github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=default_reward_per_block,json=defaultRewardPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"default_reward_per_block" yaml:"default_reward_per_block"`
+}
+
+func (m *UpdateDefaultRewardProposal) Reset()      { *m = UpdateDefaultRewardProposal{} }
+func (*UpdateDefaultRewardProposal) ProtoMessage() {}
+func (*UpdateDefaultRewardProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77788c626497cae8, []int{8}
+}
+func (m *UpdateDefaultRewardProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateDefaultRewardProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDefaultRewardProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateDefaultRewardProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDefaultRewardProposal.Merge(m, src)
+}
+func (m *UpdateDefaultRewardProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDefaultRewardProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDefaultRewardProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDefaultRewardProposal proto.InternalMessageInfo
+
+// ScheduleChange records a change of the mint schedule made by governance.
+type ScheduleChange struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// height at which the change was applied
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// proposal type of the change
+	ChangeType string `protobuf:"bytes,3,opt,name=change_type,json=changeType,proto3" json:"change_type,omitempty" yaml:"change_type"`
+	// plan added or removed
+	Plan *MintPlan `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"`
+	// previous and new default reward of an update
+	PreviousDefaultReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=previous_default_reward,json=previousDefaultReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"previous_default_reward" yaml:"previous_default_reward"`
+	DefaultReward         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=default_reward,json=defaultReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"default_reward" yaml:"default_reward"`
+}
+
+func (m *ScheduleChange) Reset()         { *m = ScheduleChange{} }
+func (m *ScheduleChange) String() string { return proto.CompactTextString(m) }
+func (*ScheduleChange) ProtoMessage()    {}
+func (*ScheduleChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77788c626497cae8, []int{9}
+}
+func (m *ScheduleChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleChange.Merge(m, src)
+}
+func (m *ScheduleChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleChange proto.InternalMessageInfo
+
+func (m *ScheduleChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduleChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduleChange) GetChangeType() string {
+	if m != nil {
+		return m.ChangeType
+	}
+	return ""
+}
+
+func (m *ScheduleChange) GetPlan() *MintPlan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.mintx.v1beta1.MintMode", MintMode_name, MintMode_value)
+	proto.RegisterEnum("cosmos.mintx.v1beta1.CurveType", CurveType_name, CurveType_value)
+	proto.RegisterEnum("cosmos.mintx.v1beta1.RecipientType", RecipientType_name, RecipientType_value)
+	proto.RegisterType((*Minter)(nil), "cosmos.mintx.v1beta1.Minter")
+	proto.RegisterType((*MintPlan)(nil), "cosmos.mintx.v1beta1.MintPlan")
+	proto.RegisterType((*TimeMintPlan)(nil), "cosmos.mintx.v1beta1.TimeMintPlan")
+	proto.RegisterType((*Params)(nil), "cosmos.mintx.v1beta1.Params")
+	proto.RegisterType((*DistributionRecipient)(nil), "cosmos.mintx.v1beta1.DistributionRecipient")
+	proto.RegisterType((*RecipientTotal)(nil), "cosmos.mintx.v1beta1.RecipientTotal")
+	proto.RegisterType((*AddMintPlanProposal)(nil), "cosmos.mintx.v1beta1.AddMintPlanProposal")
+	proto.RegisterType((*RemoveMintPlanProposal)(nil), "cosmos.mintx.v1beta1.RemoveMintPlanProposal")
+	proto.RegisterType((*UpdateDefaultRewardProposal)(nil), "cosmos.mintx.v1beta1.UpdateDefaultRewardProposal")
+	proto.RegisterType((*ScheduleChange)(nil), "cosmos.mintx.v1beta1.ScheduleChange")
+}
+
+func init() { proto.RegisterFile("seele/mintx/v1beta1/mint.proto", fileDescriptor_77788c626497cae8) }
+
+var fileDescriptor_77788c626497cae8 = []byte{
+	// 1943 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x77, 0xdb, 0x9e, 0x4c, 0x52, 0x4e, 0x1c, 0xa7, 0xf2, 0xd5, 0xf1, 0x26, 0x6e, 0xd3, 0xa0,
+	0x25, 0x5a, 0xc0, 0xd1, 0x66, 0x40, 0x0b, 0x23, 0x10, 0x72, 0xdb, 0x9d, 0x89, 0xc1, 0x5f, 0x5b,
+	0x71, 0x56, 0x3b, 0x5c, 0x5a, 0x95, 0xee, 0x8a, 0xd3, 0x3b, 0xfd, 0x61, 0xba, 0xdb, 0x5e, 0xe7,
+	0xca, 0x85, 0x51, 0xc4, 0x61, 0xb9, 0xa0, 0xbd, 0x44, 0x1a, 0x09, 0x89, 0x0b, 0x57, 0x2e, 0x7b,
+	0xe1, 0xbc, 0xc7, 0x39, 0x22, 0x0e, 0x06, 0xcd, 0x1c, 0xb8, 0x70, 0xf2, 0x3f, 0x00, 0xaa, 0xaa,
+	0xb6, 0xdd, 0x76, 0x3c, 0x0c, 0x9e, 0x9d, 0x39, 0xc5, 0x55, 0xfd, 0x7e, 0xbf, 0x57, 0xf5, 0xde,
+	0xef, 0xd5, 0xab, 0x0a, 0xc8, 0xf9, 0x84, 0x58, 0xe4, 0xc8, 0x36, 0x9d, 0xa0, 0x7f, 0xd4, 0xfb,
+	0xf0, 0x82, 0x04, 0xf8, 0x43, 0x36, 0x2a, 0x74, 0x3c, 0x37, 0x70, 0xe1, 0x96, 0xee, 0xfa, 0xb6,
+	0xeb, 0x17, 0x98, 0x41, 0x21, 0x34, 0xc8, 0x6e, 0xb5, 0xdd, 0xb6, 0xcb, 0x0c, 0x8e, 0xe8, 0x2f,
+	0x6e, 0x9b, 0x95, 0xda, 0xae, 0xdb, 0xb6, 0xc8, 0x11, 0x1b, 0x5d, 0x74, 0x2f, 0x8f, 0x02, 0xd3,
+	0x26, 0x7e, 0x80, 0xed, 0x4e, 0x68, 0x90, 0x9b, 0x35, 0x30, 0xba, 0x1e, 0x0e, 0x4c, 0xd7, 0xe1,
+	0xdf, 0xe5, 0xaf, 0x12, 0x60, 0xa9, 0x66, 0x3a, 0x01, 0xf1, 0xe0, 0x29, 0xc8, 0x9c, 0x12, 0xb3,
+	0x7d, 0x15, 0x14, 0x8d, 0xcf, 0xba, 0x7e, 0x60, 0x13, 0x27, 0x10, 0x85, 0xbc, 0x70, 0x98, 0x54,
+	0xf6, 0x87, 0x03, 0x49, 0xbc, 0xc6, 0xb6, 0xf5, 0x50, 0xbe, 0x62, 0x16, 0x1a, 0x1e, 0x9b, 0xc8,
+	0xe8, 0x0e, 0x0a, 0xb6, 0x41, 0xaa, 0xe5, 0x06, 0xd8, 0x62, 0xc4, 0x86, 0x18, 0xcf, 0x0b, 0x87,
+	0x2b, 0x8a, 0xfa, 0xf5, 0x40, 0x8a, 0xfd, 0x7d, 0x20, 0xbd, 0xdf, 0x36, 0x83, 0xab, 0xee, 0x45,
+	0x41, 0x77, 0xed, 0x23, 0xbe, 0xd3, 0xf0, 0xcf, 0x0f, 0x7c, 0xe3, 0xc9, 0x51, 0x70, 0xdd, 0x21,
+	0x7e, 0xa1, 0xe2, 0x04, 0xc3, 0x81, 0xb4, 0xc9, 0x5d, 0x06, 0x94, 0x4a, 0xb3, 0x19, 0x97, 0x8c,
+	0xa2, 0xcc, 0xb0, 0x0a, 0x56, 0x4c, 0xe7, 0xd2, 0x62, 0x1b, 0x12, 0x13, 0xcc, 0x4d, 0x61, 0x01,
+	0x37, 0x65, 0xa2, 0xa3, 0x09, 0x01, 0xd4, 0x41, 0xda, 0xc2, 0x7e, 0xc0, 0x5c, 0x69, 0x34, 0x90,
+	0x62, 0x32, 0x2f, 0x1c, 0xa6, 0x8e, 0xb3, 0x05, 0x1e, 0xc4, 0xc2, 0x28, 0x88, 0x85, 0xd6, 0x28,
+	0xca, 0xca, 0xb7, 0xa8, 0xbb, 0xe1, 0x40, 0xda, 0xe6, 0x6b, 0x9d, 0xc6, 0xcb, 0x5f, 0xfc, 0x43,
+	0x12, 0xd0, 0x2a, 0x9d, 0xa4, 0x0b, 0xa6, 0x28, 0x58, 0x01, 0x1b, 0x63, 0x8f, 0x9a, 0x1f, 0x60,
+	0x8f, 0x46, 0xe8, 0x5e, 0x5e, 0x38, 0x5c, 0x8e, 0x86, 0xf9, 0x8e, 0x89, 0x8c, 0x32, 0xe3, 0xb9,
+	0xb3, 0x70, 0xea, 0xab, 0x24, 0x58, 0xa6, 0xbc, 0x4d, 0x0b, 0x3b, 0xf0, 0x27, 0x20, 0xc5, 0xe6,
+	0x79, 0x32, 0xc2, 0xc4, 0xed, 0x4e, 0xa2, 0xc8, 0x78, 0x34, 0x9e, 0x3e, 0x19, 0x45, 0x6d, 0xe1,
+	0x03, 0xb0, 0xa2, 0x3a, 0x46, 0x08, 0x8c, 0x33, 0xe0, 0xf6, 0x70, 0x20, 0x6d, 0x70, 0x20, 0x71,
+	0x8c, 0x31, 0x6c, 0x62, 0x07, 0x7f, 0x0d, 0xd2, 0x88, 0x7c, 0x8e, 0x3d, 0xa3, 0x49, 0x3c, 0xc5,
+	0x72, 0xf5, 0x27, 0x61, 0xfc, 0x2b, 0x8b, 0xc5, 0x7f, 0x38, 0x90, 0x76, 0xb9, 0x1f, 0x8f, 0xb1,
+	0x69, 0x1d, 0xe2, 0x69, 0x17, 0x94, 0x4f, 0x46, 0x33, 0x0e, 0xe0, 0x23, 0x70, 0xaf, 0xd4, 0xf5,
+	0x7a, 0x3c, 0x2d, 0xe9, 0x63, 0xa9, 0x30, 0xaf, 0x50, 0x0a, 0xcc, 0xa4, 0x75, 0xdd, 0x21, 0x4a,
+	0x66, 0x38, 0x90, 0x56, 0x39, 0xb9, 0x4e, 0x27, 0x65, 0xc4, 0xf1, 0xf0, 0x08, 0x2c, 0x57, 0xa8,
+	0xe4, 0x7b, 0xd8, 0x62, 0xa1, 0x4f, 0x2a, 0x9b, 0xc3, 0x81, 0xb4, 0x3e, 0x0a, 0x3d, 0xff, 0x22,
+	0xa3, 0xb1, 0x11, 0x15, 0x74, 0x99, 0xe8, 0xf8, 0xba, 0x68, 0xbb, 0x5d, 0x27, 0x10, 0x97, 0x16,
+	0x16, 0x34, 0xdf, 0x69, 0x98, 0x0a, 0x83, 0x52, 0x69, 0x98, 0x71, 0xc9, 0x28, 0xca, 0x3c, 0x76,
+	0x74, 0x82, 0xf5, 0xc0, 0xf5, 0xc4, 0xfb, 0x6f, 0xc3, 0xd1, 0x25, 0xe3, 0x92, 0x51, 0x94, 0x59,
+	0xfe, 0x4b, 0x1c, 0xac, 0x52, 0x3d, 0x8e, 0xf5, 0xf3, 0x29, 0x00, 0x5c, 0x22, 0x4c, 0xf8, 0xc2,
+	0x6b, 0x85, 0x7f, 0x10, 0x0a, 0x7f, 0x23, 0x2a, 0xaf, 0x89, 0xe8, 0x57, 0xd8, 0x04, 0x53, 0x3c,
+	0x02, 0xcb, 0x54, 0x43, 0x8c, 0x37, 0xfe, 0x5a, 0xde, 0xf7, 0x42, 0xde, 0xf5, 0x89, 0xfa, 0x26,
+	0xac, 0xf7, 0x89, 0x63, 0x30, 0xce, 0x1e, 0xd8, 0x88, 0xe8, 0xc5, 0x27, 0xba, 0xeb, 0x18, 0xa1,
+	0x00, 0x7f, 0xb1, 0x70, 0xb4, 0xc4, 0x3b, 0x02, 0xe4, 0x84, 0x32, 0x5a, 0xf7, 0x46, 0x0a, 0x3c,
+	0xe3, 0x33, 0x7f, 0x4a, 0x81, 0xa5, 0x26, 0xf6, 0xb0, 0xed, 0xc3, 0x03, 0x00, 0x58, 0xa1, 0x1b,
+	0xc4, 0x71, 0x6d, 0x16, 0xb0, 0x15, 0xb4, 0x42, 0x67, 0xca, 0x74, 0x02, 0xfe, 0x56, 0x00, 0xdb,
+	0x65, 0x72, 0x89, 0xbb, 0x56, 0x30, 0x53, 0x27, 0xfc, 0x38, 0xfc, 0x78, 0xe1, 0x65, 0x4a, 0xa3,
+	0xa4, 0x32, 0x52, 0xed, 0x6e, 0xbd, 0xcc, 0xf7, 0x47, 0x33, 0xcb, 0x16, 0xda, 0xb1, 0xb0, 0xe3,
+	0x8b, 0x89, 0x7c, 0xe2, 0x30, 0x75, 0x9c, 0x9b, 0x5f, 0x3b, 0x23, 0x35, 0x28, 0x7b, 0xd3, 0xd9,
+	0x9d, 0xe0, 0x65, 0xbe, 0x47, 0x6a, 0xe4, 0x43, 0x05, 0xac, 0x33, 0xd7, 0x3e, 0x5b, 0xc5, 0x35,
+	0xc1, 0x1e, 0x2b, 0xcd, 0xa4, 0x92, 0x1d, 0x0e, 0xa4, 0x1d, 0x0e, 0x9d, 0x31, 0x90, 0xd1, 0x1a,
+	0x9f, 0x69, 0x12, 0xef, 0x31, 0xc1, 0x1e, 0xbc, 0x00, 0xc0, 0xc6, 0x7d, 0xcd, 0xef, 0x76, 0x3a,
+	0xd6, 0x35, 0xab, 0xc6, 0x15, 0xa5, 0xb4, 0x70, 0xab, 0x18, 0xad, 0x73, 0xcc, 0x44, 0xd7, 0x89,
+	0xfb, 0x67, 0xec, 0x37, 0xfc, 0x9d, 0x00, 0x76, 0x0d, 0xd3, 0x0f, 0x3c, 0xf3, 0xa2, 0xcb, 0x0e,
+	0x55, 0x8f, 0xe8, 0x66, 0xc7, 0x24, 0x4e, 0xe0, 0x8b, 0x4b, 0x2c, 0x1e, 0xdf, 0x9b, 0x1f, 0x8f,
+	0x72, 0x04, 0x84, 0x46, 0x18, 0xe5, 0xfd, 0x30, 0x38, 0xb9, 0x30, 0x21, 0xf3, 0x99, 0x65, 0xb4,
+	0x63, 0xcc, 0x83, 0xfb, 0xf0, 0x63, 0xc0, 0x62, 0xa8, 0xd9, 0xae, 0x41, 0x58, 0x89, 0xa7, 0xff,
+	0x57, 0x3e, 0x6a, 0xae, 0x41, 0x94, 0xad, 0xe1, 0x40, 0xca, 0x44, 0x72, 0x41, 0xa1, 0x32, 0x5a,
+	0xb6, 0xc3, 0xef, 0x50, 0x03, 0x7b, 0x17, 0xae, 0x63, 0x10, 0x43, 0x63, 0xdd, 0x5d, 0x8b, 0x9e,
+	0xf6, 0xe2, 0x32, 0xcb, 0xc9, 0x77, 0x86, 0x03, 0x29, 0x1f, 0xe6, 0xe4, 0x55, 0xa6, 0x32, 0xda,
+	0xe1, 0xdf, 0x10, 0xfd, 0x14, 0xed, 0x11, 0xbf, 0x11, 0xc0, 0xf6, 0xa4, 0x29, 0x79, 0x38, 0x20,
+	0x9a, 0x7e, 0x85, 0x9d, 0x36, 0x11, 0x57, 0x58, 0xca, 0xea, 0x0b, 0xcb, 0x79, 0x7f, 0xb6, 0xd3,
+	0x45, 0x48, 0x65, 0xb4, 0x39, 0x9e, 0x47, 0x38, 0x20, 0x25, 0x36, 0x0b, 0x9f, 0x80, 0xb5, 0x89,
+	0xb9, 0x8d, 0xfb, 0x22, 0x60, 0xbe, 0x4f, 0x16, 0xf6, 0xbd, 0x35, 0xeb, 0xdb, 0xc6, 0x7d, 0x19,
+	0xad, 0x8e, 0xc7, 0x35, 0xdc, 0x9f, 0x71, 0x66, 0x3a, 0x62, 0xea, 0xad, 0x39, 0x33, 0x9d, 0x29,
+	0x67, 0xa6, 0x03, 0x09, 0x48, 0xb5, 0x5d, 0x6c, 0x69, 0x3c, 0xfa, 0xe2, 0x2a, 0x73, 0x55, 0x5e,
+	0xd8, 0x15, 0xe4, 0xae, 0x22, 0x54, 0x32, 0x02, 0x74, 0xa4, 0xb0, 0x01, 0xfc, 0x0c, 0xac, 0xd3,
+	0xc3, 0x54, 0x8b, 0x9c, 0x07, 0x6b, 0x4c, 0xff, 0xf2, 0x7c, 0xfd, 0x45, 0x3b, 0x84, 0x92, 0x0b,
+	0x65, 0x1f, 0x16, 0xf6, 0x0c, 0x91, 0x8c, 0xd6, 0x82, 0x88, 0xb5, 0x0f, 0x7b, 0x60, 0x9b, 0x96,
+	0xa3, 0x8e, 0x03, 0xfd, 0x4a, 0xeb, 0x76, 0xb4, 0xd1, 0xc5, 0x53, 0x4c, 0xb3, 0x1e, 0xb0, 0x77,
+	0xa7, 0x07, 0x94, 0x43, 0x03, 0xe5, 0x30, 0x74, 0xb4, 0x3f, 0x29, 0xea, 0x3b, 0x2c, 0xf2, 0x97,
+	0xb4, 0x1f, 0x40, 0x1b, 0xf7, 0x4b, 0xf4, 0xd3, 0x79, 0x67, 0x84, 0x7e, 0x98, 0xfc, 0xf2, 0x99,
+	0x14, 0x93, 0xff, 0x1a, 0x07, 0xdb, 0x73, 0xab, 0x17, 0x7e, 0x04, 0x92, 0x34, 0x64, 0xec, 0xc4,
+	0x4e, 0x1f, 0x7f, 0x7b, 0xfe, 0xc6, 0xc7, 0xe6, 0xf4, 0x22, 0x81, 0x18, 0x00, 0x8a, 0xe0, 0x3e,
+	0x36, 0x0c, 0x8f, 0xf8, 0x3e, 0x3f, 0xc2, 0xd1, 0x68, 0x08, 0x4f, 0xc0, 0xd2, 0xe7, 0xbc, 0xd4,
+	0xde, 0xec, 0x0e, 0x1a, 0xa2, 0xe1, 0xcf, 0xc0, 0x9a, 0x47, 0x74, 0x62, 0xf6, 0x88, 0xaf, 0x19,
+	0x5d, 0x3f, 0x60, 0xa7, 0xe9, 0xb2, 0x22, 0x4e, 0x44, 0x34, 0xf5, 0x59, 0x46, 0xab, 0xa3, 0x71,
+	0xb9, 0xeb, 0x07, 0xf0, 0x97, 0x00, 0xf6, 0x88, 0x1f, 0x98, 0x4e, 0x5b, 0x9b, 0x5c, 0xda, 0xc2,
+	0x0b, 0xce, 0xc1, 0x70, 0x20, 0xed, 0x71, 0x8e, 0xbb, 0x36, 0x32, 0xca, 0x84, 0x93, 0xe3, 0xfb,
+	0x9d, 0xfc, 0x1f, 0x01, 0xa4, 0x27, 0x51, 0xa0, 0x77, 0xee, 0x77, 0x11, 0xb9, 0x26, 0x48, 0x8d,
+	0x0f, 0x49, 0x62, 0xbc, 0x41, 0xf8, 0x2a, 0x4e, 0x80, 0xa2, 0x14, 0xf0, 0x14, 0xdc, 0x0f, 0xf7,
+	0x22, 0x26, 0xdf, 0x88, 0x6d, 0x04, 0x97, 0x7f, 0x2f, 0x80, 0xcd, 0xa2, 0x61, 0x8c, 0x14, 0xdd,
+	0xf4, 0xdc, 0x8e, 0xeb, 0x63, 0x0b, 0x6e, 0x81, 0x7b, 0x81, 0x19, 0x58, 0x24, 0xec, 0xf9, 0x7c,
+	0x00, 0xf3, 0x20, 0x65, 0x10, 0x5f, 0xf7, 0xcc, 0x0e, 0x13, 0x39, 0xdf, 0x67, 0x74, 0x0a, 0xfe,
+	0x18, 0x24, 0x69, 0xa5, 0xb0, 0x4d, 0xbe, 0xbe, 0x03, 0x27, 0xe9, 0xb2, 0x11, 0x43, 0x3c, 0x5c,
+	0x7e, 0xfa, 0x4c, 0x8a, 0x31, 0x59, 0xff, 0x41, 0x00, 0x3b, 0x88, 0xd8, 0x6e, 0x8f, 0xbc, 0xb5,
+	0x65, 0x3d, 0x04, 0xab, 0x53, 0xdd, 0x22, 0xf1, 0x9a, 0x97, 0x83, 0x3f, 0xe9, 0x0a, 0x91, 0x85,
+	0xfd, 0x5b, 0x00, 0xef, 0x9d, 0x77, 0x0c, 0x1c, 0x90, 0xe9, 0x4b, 0xc8, 0x37, 0x5d, 0xdd, 0x8d,
+	0x00, 0xc4, 0x57, 0xdd, 0x78, 0xc4, 0xc4, 0x3b, 0xbb, 0x49, 0x19, 0xf3, 0x6e, 0x52, 0x91, 0xed,
+	0x3e, 0x4b, 0x80, 0xf4, 0x99, 0x7e, 0x45, 0x8c, 0xae, 0x35, 0x6a, 0x4e, 0x69, 0x10, 0x37, 0x0d,
+	0xfe, 0xee, 0x42, 0x71, 0xd3, 0x80, 0x3b, 0x60, 0xe9, 0x6a, 0xf2, 0xa4, 0x4a, 0xa0, 0x70, 0x04,
+	0x3f, 0x02, 0x29, 0xde, 0xe4, 0x34, 0x56, 0x4c, 0x7c, 0x0f, 0x3b, 0x93, 0xc3, 0x3b, 0xf2, 0x51,
+	0x46, 0x80, 0x8f, 0x68, 0x2d, 0xc1, 0xe3, 0x50, 0x3f, 0xc9, 0xff, 0x47, 0x3f, 0x5c, 0x39, 0xf0,
+	0xa9, 0x00, 0x76, 0x3b, 0x1e, 0xe9, 0x99, 0x6e, 0xd7, 0xd7, 0xa6, 0xf7, 0x1b, 0xde, 0xb5, 0x9a,
+	0x0b, 0x47, 0x2f, 0xbc, 0xf6, 0xbc, 0x82, 0x56, 0x46, 0xdb, 0xa3, 0x2f, 0x53, 0x4a, 0x80, 0x0e,
+	0x48, 0xcf, 0x2c, 0x80, 0x3f, 0xa3, 0x1e, 0x2d, 0xbc, 0x80, 0xed, 0x79, 0xe9, 0x93, 0xd1, 0xda,
+	0x54, 0xd2, 0x3e, 0xf8, 0xb3, 0xc0, 0x5f, 0xc7, 0xec, 0x7e, 0xf4, 0x00, 0xec, 0xd4, 0x2a, 0xf5,
+	0x96, 0x56, 0x6b, 0x94, 0x55, 0xed, 0x54, 0xad, 0x3c, 0x3a, 0x6d, 0x69, 0xcd, 0x6a, 0xb1, 0x7e,
+	0x96, 0x89, 0x65, 0x77, 0x6f, 0x6e, 0xf3, 0x9b, 0x23, 0x4b, 0x2e, 0x6c, 0xde, 0xc1, 0xa6, 0x40,
+	0x4a, 0xa3, 0x5e, 0x56, 0xcb, 0x1a, 0x2a, 0xb6, 0x2a, 0x8d, 0x8c, 0x30, 0x0d, 0x52, 0x26, 0x77,
+	0x26, 0x78, 0x04, 0xb6, 0x26, 0xa0, 0x56, 0xa5, 0xa6, 0x86, 0x7e, 0xe2, 0xd9, 0xed, 0x9b, 0xdb,
+	0xfc, 0xc6, 0x08, 0x42, 0x3b, 0x2b, 0xf3, 0x92, 0x4d, 0x3e, 0xfd, 0x63, 0x2e, 0xf6, 0xc1, 0xbf,
+	0x04, 0xb0, 0x32, 0x7e, 0xb9, 0xc2, 0x02, 0xd8, 0x2c, 0x9d, 0xa3, 0x4f, 0x54, 0xad, 0xf5, 0xb8,
+	0xa9, 0x6a, 0xa5, 0x46, 0xfd, 0xac, 0x55, 0xac, 0xb7, 0x32, 0x31, 0xce, 0x31, 0xb6, 0x2b, 0xb9,
+	0x8e, 0x1f, 0x60, 0x27, 0x80, 0xdf, 0x07, 0x30, 0x62, 0x7f, 0x5a, 0xac, 0x7e, 0x52, 0xa9, 0x3f,
+	0xca, 0x08, 0xd9, 0xad, 0x9b, 0xdb, 0x7c, 0x66, 0x6c, 0x7e, 0x8a, 0xad, 0x9e, 0xe9, 0xb4, 0xe1,
+	0x8f, 0xc0, 0x6e, 0xc4, 0xba, 0x5a, 0xa9, 0xab, 0x45, 0xa4, 0x95, 0xd5, 0x52, 0xf1, 0x71, 0x26,
+	0x9e, 0x15, 0x6f, 0x6e, 0xf3, 0x5b, 0x63, 0x48, 0xd5, 0x74, 0x08, 0xf6, 0xd8, 0xc3, 0x11, 0xfe,
+	0x1c, 0xec, 0x47, 0x60, 0xea, 0xa7, 0xcd, 0x46, 0x5d, 0xad, 0xb7, 0x2a, 0xc5, 0x6a, 0x88, 0x4d,
+	0x64, 0x0f, 0x6e, 0x6e, 0xf3, 0x7b, 0x63, 0xac, 0xda, 0xef, 0xb8, 0x0e, 0x71, 0x02, 0x13, 0x5b,
+	0x8c, 0x20, 0xdc, 0xe9, 0xf3, 0x38, 0x58, 0x9b, 0x6a, 0x12, 0xf0, 0xa7, 0x20, 0x8b, 0xd4, 0x52,
+	0xa5, 0x59, 0x51, 0xeb, 0x2d, 0x4e, 0x7e, 0x5e, 0x3f, 0x6b, 0xaa, 0xa5, 0xca, 0x49, 0x45, 0x2d,
+	0x67, 0x62, 0xd9, 0xfd, 0x9b, 0xdb, 0xbc, 0x38, 0x05, 0x39, 0x77, 0xfc, 0x0e, 0xd1, 0xcd, 0x4b,
+	0x93, 0x18, 0x74, 0x59, 0x33, 0xe8, 0x13, 0x95, 0xc6, 0xac, 0x5a, 0x55, 0x4b, 0xad, 0x06, 0xca,
+	0x08, 0x7c, 0x59, 0x53, 0xf8, 0x13, 0x42, 0x4a, 0xae, 0x65, 0x11, 0xfa, 0x14, 0x86, 0x45, 0x70,
+	0x30, 0x43, 0x50, 0x6a, 0xd4, 0x6a, 0xe7, 0xf5, 0x4a, 0xeb, 0xb1, 0xd6, 0x6c, 0x34, 0xaa, 0x99,
+	0x78, 0x36, 0x77, 0x73, 0x9b, 0xcf, 0x4e, 0x31, 0x94, 0x5c, 0xdb, 0xee, 0x3a, 0x66, 0x70, 0xdd,
+	0x74, 0x5d, 0x6b, 0x0e, 0x45, 0xad, 0x51, 0x3e, 0xaf, 0xaa, 0x5a, 0xb1, 0x54, 0x6a, 0x9c, 0xd7,
+	0x5b, 0x99, 0xc4, 0x1c, 0x8a, 0x9a, 0x4b, 0x4f, 0x8f, 0xa2, 0xae, 0xb3, 0x97, 0xff, 0x0f, 0xc1,
+	0xce, 0x0c, 0x45, 0xb1, 0x5c, 0x46, 0xea, 0xd9, 0x59, 0x26, 0xc9, 0x73, 0x32, 0x85, 0x2d, 0xf2,
+	0xfe, 0xc9, 0x43, 0xaa, 0x7c, 0xf7, 0xeb, 0x17, 0x39, 0xe1, 0xf9, 0x8b, 0x9c, 0xf0, 0xcf, 0x17,
+	0x39, 0xe1, 0x8b, 0x97, 0xb9, 0xd8, 0xf3, 0x97, 0xb9, 0xd8, 0xdf, 0x5e, 0xe6, 0x62, 0xbf, 0x5a,
+	0xeb, 0x87, 0xff, 0x67, 0x64, 0xf5, 0x73, 0xb1, 0xc4, 0x2e, 0x5b, 0x0f, 0xfe, 0x3b, 0x00, 0x77,
+	0x23, 0x60, 0x9a, 0x83, 0x14, 0x00, 0x00,
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.InflationStarted {
+		i--
+		if m.InflationStarted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastMintTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastMintTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.HeightAdjustment != 0 {
-		n += 1 + sovMint(uint64(m.HeightAdjustment))
+		i = encodeVarintMint(dAtA, i, uint64(m.HeightAdjustment))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MintPlan) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MintPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MintPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StartHeight != 0 {
-		n += 1 + sovMint(uint64(m.StartHeight))
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DecayAmount.Size()
+		i -= size
+		if _, err := m.DecayAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Interval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Curve != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.RewardPerBlock.Size()
+		i -= size
+		if _, err := m.RewardPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.EndHeight != 0 {
-		n += 1 + sovMint(uint64(m.EndHeight))
+		i = encodeVarintMint(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.RewardPerBlock.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TimeMintPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeMintPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeMintPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	{
+		size := m.RewardPerSecond.Size()
+		i -= size
+		if _, err := m.RewardPerSecond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMint(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMint(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxCatchUpDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxCatchUpDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMint(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x72
+	if len(m.TimeMintPlans) > 0 {
+		for iNdEx := len(m.TimeMintPlans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeMintPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.BondedRatioStartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.BondedRatioStartHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MintMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MintMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DistributionRecipients) > 0 {
+		for iNdEx := len(m.DistributionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.DefaultRewardPerBlock.Size()
-	n += 1 + l + sovMint(uint64(l))
 	if len(m.MintPlans) > 0 {
-		for _, e := range m.MintPlans {
-			l = e.Size()
-			n += 1 + l + sovMint(uint64(l))
+		for iNdEx := len(m.MintPlans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.DefaultRewardPerBlock.Size()
+		i -= size
+		if _, err := m.DefaultRewardPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VestingEndHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.VestingEndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReceivesDust {
+		i--
+		if m.ReceivesDust {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecipientTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipientTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipientTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Vesting.Size()
+		i -= size
+		if _, err := m.Vesting.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Distributed.Size()
+		i -= size
+		if _, err := m.Distributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddMintPlanProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddMintPlanProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddMintPlanProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveMintPlanProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveMintPlanProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveMintPlanProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDefaultRewardProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDefaultRewardProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDefaultRewardProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DefaultRewardPerBlock.Size()
+		i -= size
+		if _, err := m.DefaultRewardPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DefaultReward.Size()
+		i -= size
+		if _, err := m.DefaultReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PreviousDefaultReward.Size()
+		i -= size
+		if _, err := m.PreviousDefaultReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Plan != nil {
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChangeType) > 0 {
+		i -= len(m.ChangeType)
+		copy(dAtA[i:], m.ChangeType)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.ChangeType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HeightAdjustment != 0 {
+		n += 1 + sovMint(uint64(m.HeightAdjustment))
+	}
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastMintTime)
+	n += 1 + l + sovMint(uint64(l))
+	if m.InflationStarted {
+		n += 2
+	}
+	return n
+}
+
+func (m *MintPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovMint(uint64(m.EndHeight))
+	}
+	l = m.RewardPerBlock.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.Curve != 0 {
+		n += 1 + sovMint(uint64(m.Curve))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovMint(uint64(m.Interval))
+	}
+	l = m.DecayAmount.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *TimeMintPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.RewardPerSecond.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.DefaultRewardPerBlock.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.MintPlans) > 0 {
+		for _, e := range m.MintPlans {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.DistributionRecipients) > 0 {
+		for _, e := range m.DistributionRecipients {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.MintMode != 0 {
+		n += 1 + sovMint(uint64(m.MintMode))
+	}
+	if m.BondedRatioStartHeight != 0 {
+		n += 1 + sovMint(uint64(m.BondedRatioStartHeight))
+	}
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.TimeMintPlans) > 0 {
+		for _, e := range m.TimeMintPlans {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxCatchUpDuration)
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DistributionRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovMint(uint64(m.Type))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.ReceivesDust {
+		n += 2
+	}
+	if m.VestingEndHeight != 0 {
+		n += 1 + sovMint(uint64(m.VestingEndHeight))
+	}
+	return n
+}
+
+func (m *RecipientTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovMint(uint64(m.Type))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Distributed.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Vesting.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *AddMintPlanProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Plan.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *RemoveMintPlanProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *UpdateDefaultRewardProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.DefaultRewardPerBlock.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *ScheduleChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMint(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = len(m.ChangeType)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.PreviousDefaultReward.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DefaultReward.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightAdjustment", wireType)
+			}
+			m.HeightAdjustment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeightAdjustment |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMintTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastMintTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationStarted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InflationStarted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= CurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeMintPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeMintPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeMintPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerSecond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRewardPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultRewardPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPlans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintPlans = append(m.MintPlans, MintPlan{})
+			if err := m.MintPlans[len(m.MintPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionRecipients = append(m.DistributionRecipients, DistributionRecipient{})
+			if err := m.DistributionRecipients[len(m.DistributionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintMode", wireType)
+			}
+			m.MintMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintMode |= MintMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatioStartHeight", wireType)
+			}
+			m.BondedRatioStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BondedRatioStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeMintPlans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeMintPlans = append(m.TimeMintPlans, TimeMintPlan{})
+			if err := m.TimeMintPlans[len(m.TimeMintPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCatchUpDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxCatchUpDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivesDust", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceivesDust = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingEndHeight", wireType)
+			}
+			m.VestingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecipientTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecipientTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecipientTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddMintPlanProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddMintPlanProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddMintPlanProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *RemoveMintPlanProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -438,17 +3414,81 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveMintPlanProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveMintPlanProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeightAdjustment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.HeightAdjustment = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -458,7 +3498,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HeightAdjustment |= uint64(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -484,7 +3524,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MintPlan) Unmarshal(dAtA []byte) error {
+func (m *UpdateDefaultRewardProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -507,17 +3547,17 @@ func (m *MintPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MintPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateDefaultRewardProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MintPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateDefaultRewardProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.StartHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -527,16 +3567,29 @@ func (m *MintPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.EndHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -546,14 +3599,27 @@ func (m *MintPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRewardPerBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -581,7 +3647,7 @@ func (m *MintPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DefaultRewardPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -606,7 +3672,7 @@ func (m *MintPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ScheduleChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -629,15 +3695,53 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -665,11 +3769,47 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			m.ChangeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRewardPerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &MintPlan{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousDefaultReward", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -697,15 +3837,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultRewardPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousDefaultReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintPlans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultReward", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -715,23 +3855,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintPlans = append(m.MintPlans, MintPlan{})
-			if err := m.MintPlans[len(m.MintPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DefaultReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxHalvings is the number of halvings after which the reward of a plan is exhausted
+const maxHalvings = 255
+
+// Contains returns true if the reward of the block height is defined by the plan
+func (plan MintPlan) Contains(height uint64) bool {
+	return plan.StartHeight < height && plan.EndHeight >= height
+}
+
+// GetRewardByHeight return the reward of a block height contained by the plan
+func (plan MintPlan) GetRewardByHeight(height uint64) sdk.Dec {
+	if plan.Curve == CurveTypeConstant {
+		return plan.RewardPerBlock
+	}
+
+	steps := (height - plan.StartHeight - 1) / plan.Interval
+	switch plan.Curve {
+	case CurveTypeHalving:
+		if steps >= maxHalvings {
+			return sdk.ZeroDec()
+		}
+		return plan.RewardPerBlock.QuoInt(sdk.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), uint(steps))))
+	case CurveTypeLinearDecay:
+		reward := plan.RewardPerBlock.Sub(plan.DecayAmount.MulInt(sdk.NewIntFromUint64(steps)))
+		if reward.IsNegative() {
+			return sdk.ZeroDec()
+		}
+		return reward
+	case CurveTypeExponentialDecay:
+		return plan.RewardPerBlock.Mul(plan.DecayFactor.Power(steps))
+	default:
+		panic(fmt.Sprintf("unknown curve type %s", plan.Curve))
+	}
+}
+
+// GetMintedAmountBetween returns the amount minted by the blocks of the plan after from until to included,
+// in the base unit of the mint denom. The reward is constant during a step of the curve, so the partial steps
+// at both ends are computed from their reward and the full steps in between in closed form: the halvings are
+// summed step by step as the reward is exhausted after maxHalvings steps, the linear decay is an arithmetic
+// series and the exponential decay is a geometric series. The truncation of the geometric series to the base
+// unit is applied to its sum, which may exceed the sum of the block amounts by less than a base unit per block.
+func (plan MintPlan) GetMintedAmountBetween(from, to uint64) sdk.Int {
+	if from < plan.StartHeight {
+		from = plan.StartHeight
+	}
+	if to > plan.EndHeight {
+		to = plan.EndHeight
+	}
+	if to <= from {
+		return sdk.ZeroInt()
+	}
+	amountByHeight := func(height uint64) sdk.Int {
+		return plan.GetRewardByHeight(height).Mul(RewardPrecision).TruncateInt()
+	}
+	if plan.Curve == CurveTypeConstant {
+		return amountByHeight(to).Mul(sdk.NewIntFromUint64(to - from))
+	}
+
+	// the steps of the first and the last blocks
+	first := (from - plan.StartHeight) / plan.Interval
+	last := (to - plan.StartHeight - 1) / plan.Interval
+	if last == first {
+		return amountByHeight(to).Mul(sdk.NewIntFromUint64(to - from))
+	}
+	// the steps after the first one start before to, so their boundaries can't overflow
+	firstEnd := plan.StartHeight + (first+1)*plan.Interval
+	lastStart := plan.StartHeight + last*plan.Interval
+	total := amountByHeight(from + 1).Mul(sdk.NewIntFromUint64(firstEnd - from))
+	total = total.Add(amountByHeight(to).Mul(sdk.NewIntFromUint64(to - lastStart)))
+	if last == first+1 {
+		return total
+	}
+
+	// the full steps from first+1 to last-1
+	start, count := first+1, last-first-1
+	interval := sdk.NewIntFromUint64(plan.Interval)
+	switch plan.Curve {
+	case CurveTypeHalving:
+		for step := start; step < start+count && step < maxHalvings; step++ {
+			amount := plan.RewardPerBlock.QuoInt(sdk.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), uint(step))))
+			total = total.Add(amount.Mul(RewardPrecision).TruncateInt().Mul(interval))
+		}
+	case CurveTypeLinearDecay:
+		// the rewards have 18 decimals, so the amounts of the steps are integers decreasing by the same decay
+		initial := plan.RewardPerBlock.Mul(RewardPrecision).TruncateInt()
+		decay := plan.DecayAmount.Mul(RewardPrecision).TruncateInt()
+		// the reward is zero after the last positive step
+		if lastPositive := initial.Quo(decay); lastPositive.LT(sdk.NewIntFromUint64(start)) {
+			count = 0
+		} else if n := lastPositive.Sub(sdk.NewIntFromUint64(start)).AddRaw(1); n.LT(sdk.NewIntFromUint64(count)) {
+			count = n.Uint64()
+		}
+		if count > 0 {
+			n := sdk.NewIntFromUint64(count)
+			// sum of initial - decay*step for the steps start to start+count-1
+			steps := sdk.NewIntFromUint64(start).MulRaw(2).Add(n).SubRaw(1).Mul(n).QuoRaw(2)
+			total = total.Add(initial.Mul(n).Sub(decay.Mul(steps)).Mul(interval))
+		}
+	case CurveTypeExponentialDecay:
+		// reward * factor^start * (1 - factor^count) / (1 - factor)
+		sum := plan.RewardPerBlock.Mul(plan.DecayFactor.Power(start)).
+			Mul(sdk.OneDec().Sub(plan.DecayFactor.Power(count))).
+			Quo(sdk.OneDec().Sub(plan.DecayFactor))
+		total = total.Add(sum.Mul(RewardPrecision).TruncateInt().Mul(interval))
+	default:
+		panic(fmt.Sprintf("unknown curve type %s", plan.Curve))
+	}
+	return total
+}
+
+// Validate returns an error if the plan is invalid
+func (plan MintPlan) Validate() error {
+	if plan.EndHeight <= plan.StartHeight {
+		return fmt.Errorf("end height:%d must great start height: %d", plan.EndHeight, plan.StartHeight)
+	}
+	if plan.RewardPerBlock.IsNil() || !plan.RewardPerBlock.IsPositive() {
+		return fmt.Errorf("reward per block must be positive: %s", plan.RewardPerBlock)
+	}
+
+	switch plan.Curve {
+	case CurveTypeConstant:
+		return nil
+	case CurveTypeHalving:
+	case CurveTypeLinearDecay:
+		if plan.DecayAmount.IsNil() || !plan.DecayAmount.IsPositive() {
+			return fmt.Errorf("decay amount must be positive: %s", plan.DecayAmount)
+		}
+	case CurveTypeExponentialDecay:
+		if plan.DecayFactor.IsNil() || !plan.DecayFactor.IsPositive() || plan.DecayFactor.GTE(sdk.OneDec()) {
+			return fmt.Errorf("decay factor must be between 0 and 1: %s", plan.DecayFactor)
+		}
+	default:
+		return fmt.Errorf("unknown curve type: %s", plan.Curve)
+	}
+
+	if plan.Interval == 0 {
+		return fmt.Errorf("interval of a %s plan must be positive", plan.Curve)
+	}
+	return nil
+}
@@ -1,10 +1,17 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewMinter returns a new Minter object with the given inflation and annual
 // provisions values.
 func NewMinter(heightAdjustment uint64) Minter {
 	return Minter{
 		HeightAdjustment: heightAdjustment,
+		TotalMinted:      sdk.ZeroInt(),
 	}
 }
 
@@ -21,5 +28,24 @@ func DefaultInitialMinter() Minter {
 
 // validate minter
 func ValidateMinter(minter Minter) error {
+	// a genesis exported before the counter was introduced has no total minted
+	if !minter.TotalMinted.IsNil() && minter.TotalMinted.IsNegative() {
+		return fmt.Errorf("total minted must not be negative: %s", minter.TotalMinted)
+	}
+	// the inflation is only set once the bonded ratio targeting has started
+	if minter.InflationStarted && minter.Inflation.IsNil() {
+		return fmt.Errorf("inflation must be set once the bonded ratio targeting has started")
+	}
+	if !minter.Inflation.IsNil() && minter.Inflation.IsNegative() {
+		return fmt.Errorf("inflation must not be negative: %s", minter.Inflation)
+	}
 	return nil
 }
+
+// GetTotalMinted returns the cumulative amount minted by the module
+func (m Minter) GetTotalMinted() sdk.Int {
+	if m.TotalMinted.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return m.TotalMinted
+}
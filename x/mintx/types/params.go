@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	yaml "gopkg.in/yaml.v2"
@@ -13,10 +14,28 @@ import (
 
 // Parameter store keys
 var (
-	KeyMintDenom = []byte("MintDenom")
-	KeyMintPlans = []byte("MintPlans")
+	KeyMintDenom              = []byte("MintDenom")
+	KeyDefaultRewardPerBlock  = []byte("DefaultRewardPerBlock")
+	KeyMintPlans              = []byte("MintPlans")
+	KeyBlocksPerYear          = []byte("BlocksPerYear")
+	KeyMaxSupply              = []byte("MaxSupply")
+	KeyDistributionRecipients = []byte("DistributionRecipients")
+	KeyMintMode               = []byte("MintMode")
+	KeyBondedRatioStartHeight = []byte("BondedRatioStartHeight")
+	KeyInflationRateChange    = []byte("InflationRateChange")
+	KeyInflationMax           = []byte("InflationMax")
+	KeyInflationMin           = []byte("InflationMin")
+	KeyGoalBonded             = []byte("GoalBonded")
+	KeyTimeMintPlans          = []byte("TimeMintPlans")
+	KeyMaxCatchUpDuration     = []byte("MaxCatchUpDuration")
 )
 
+// RewardPrecision converts the rewards of the mint plans, in whole tokens, to the base unit of the mint denom
+var RewardPrecision = sdk.NewDec(10).Power(18)
+
+// DefaultBlocksPerYear assumes 5 seconds blocks
+const DefaultBlocksPerYear = uint64(60 * 60 * 8766 / 5)
+
 // ParamTable for minting module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -43,9 +62,19 @@ func NewParams(
 // DefaultParams default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:             sdk.DefaultBondDenom,
-		DefaultRewardPerBlock: sdk.OneDec(),
-		MintPlans:             []MintPlan{},
+		MintDenom:              sdk.DefaultBondDenom,
+		DefaultRewardPerBlock:  sdk.OneDec(),
+		MintPlans:              []MintPlan{},
+		BlocksPerYear:          DefaultBlocksPerYear,
+		MaxSupply:              sdk.ZeroInt(),
+		DistributionRecipients: DefaultDistributionRecipients(),
+		MintMode:               MintModeHeightPlans,
+		InflationRateChange:    DefaultInflationRateChange,
+		InflationMax:           DefaultInflationMax,
+		InflationMin:           DefaultInflationMin,
+		GoalBonded:             DefaultGoalBonded,
+		TimeMintPlans:          []TimeMintPlan{},
+		MaxCatchUpDuration:     DefaultMaxCatchUpDuration,
 	}
 }
 
@@ -59,8 +88,49 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	if p.DefaultRewardPerBlock.LTE(sdk.ZeroDec()) {
-		return fmt.Errorf("reward per block must be positive: %d", p.DefaultRewardPerBlock)
+	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
+		return err
+	}
+
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+
+	if err := validateDistributionRecipients(p.DistributionRecipients); err != nil {
+		return err
+	}
+
+	if err := validateMintMode(p.MintMode); err != nil {
+		return err
+	}
+	if err := validateRate("inflation rate change")(p.InflationRateChange); err != nil {
+		return err
+	}
+	if err := validateRate("max inflation")(p.InflationMax); err != nil {
+		return err
+	}
+	if err := validateRate("min inflation")(p.InflationMin); err != nil {
+		return err
+	}
+	if err := validateGoalBonded(p.GoalBonded); err != nil {
+		return err
+	}
+	if p.InflationMax.LT(p.InflationMin) {
+		return fmt.Errorf(
+			"max inflation (%s) must be greater than or equal to min inflation (%s)",
+			p.InflationMax, p.InflationMin,
+		)
+	}
+
+	if err := validateDefaultRewardPerBlock(p.DefaultRewardPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateTimeMintPlans(p.TimeMintPlans); err != nil {
+		return err
+	}
+	if err := validateMaxCatchUpDuration(p.MaxCatchUpDuration); err != nil {
+		return err
 	}
 
 	return nil
@@ -77,21 +147,84 @@ func (p Params) String() string {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
+		paramtypes.NewParamSetPair(KeyDefaultRewardPerBlock, &p.DefaultRewardPerBlock, validateDefaultRewardPerBlock),
 		paramtypes.NewParamSetPair(KeyMintPlans, &p.MintPlans, validateMintPlan),
+		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
+		paramtypes.NewParamSetPair(KeyDistributionRecipients, &p.DistributionRecipients, validateDistributionRecipients),
+		paramtypes.NewParamSetPair(KeyMintMode, &p.MintMode, validateMintMode),
+		paramtypes.NewParamSetPair(KeyBondedRatioStartHeight, &p.BondedRatioStartHeight, validateBondedRatioStartHeight),
+		paramtypes.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateRate("inflation rate change")),
+		paramtypes.NewParamSetPair(KeyInflationMax, &p.InflationMax, validateRate("max inflation")),
+		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateRate("min inflation")),
+		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
+		paramtypes.NewParamSetPair(KeyTimeMintPlans, &p.TimeMintPlans, validateTimeMintPlans),
+		paramtypes.NewParamSetPair(KeyMaxCatchUpDuration, &p.MaxCatchUpDuration, validateMaxCatchUpDuration),
 	}
 }
 
 // GetRewardByHeight return reward by block height
 func (p *Params) GetRewardByHeight(height uint64) sdk.Dec {
 	for _, value := range p.MintPlans {
-		if value.StartHeight < height && value.EndHeight >= height {
-			return value.RewardPerBlock
+		if value.Contains(height) {
+			return value.GetRewardByHeight(height)
 		}
 	}
 	return p.DefaultRewardPerBlock
 	//return sdk.OneDec()
 }
 
+// GetMintedAmountByHeight return the amount minted at the block height, in the base unit of the mint denom
+func (p *Params) GetMintedAmountByHeight(height uint64) sdk.Int {
+	return p.GetRewardByHeight(height).Mul(RewardPrecision).TruncateInt()
+}
+
+// GetMintedAmountBetween return the amount minted by the blocks after from until to included,
+// the amount of each plan and of the ranges outside of the plans is computed in closed form.
+func (p *Params) GetMintedAmountBetween(from, to uint64) sdk.Int {
+	total := sdk.ZeroInt()
+	if to <= from {
+		return total
+	}
+	for height := from + 1; ; {
+		// the default reward applies until the start of the next plan
+		next, plan := to, (*MintPlan)(nil)
+		for i := range p.MintPlans {
+			if p.MintPlans[i].Contains(height) {
+				next, plan = p.MintPlans[i].EndHeight, &p.MintPlans[i]
+				break
+			}
+			if start := p.MintPlans[i].StartHeight; start >= height && start < next {
+				next = start
+			}
+		}
+		if next > to {
+			next = to
+		}
+		if plan != nil {
+			total = total.Add(plan.GetMintedAmountBetween(height-1, next))
+		} else {
+			total = total.Add(p.GetMintedAmountByHeight(height).Mul(sdk.NewIntFromUint64(next - height + 1)))
+		}
+		if next == to {
+			return total
+		}
+		height = next + 1
+	}
+}
+
+// ClipToMaxSupply returns the part of the amount which can be minted without exceeding the max supply
+func (p *Params) ClipToMaxSupply(totalMinted, amount sdk.Int) sdk.Int {
+	if p.MaxSupply.IsNil() || p.MaxSupply.IsZero() {
+		return amount
+	}
+	remaining := p.MaxSupply.Sub(totalMinted)
+	if !remaining.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return sdk.MinInt(amount, remaining)
+}
+
 func validateMintDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -108,6 +241,19 @@ func validateMintDenom(i interface{}) error {
 	return nil
 }
 
+func validateDefaultRewardPerBlock(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("reward per block must be positive: %s", v)
+	}
+
+	return nil
+}
+
 func validateMintPlan(i interface{}) error {
 	v, ok := i.([]MintPlan)
 	if !ok {
@@ -115,13 +261,52 @@ func validateMintPlan(i interface{}) error {
 	}
 
 	for _, value := range v {
-		if value.EndHeight < value.StartHeight {
-			return fmt.Errorf("end height:%d must great start height: %d", value.EndHeight, value.StartHeight)
+		if err := value.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// the plans must follow each other without overlap or gap
+	plans := make([]MintPlan, len(v))
+	copy(plans, v)
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].StartHeight < plans[j].StartHeight
+	})
+	for i := 1; i < len(plans); i++ {
+		prev, curr := plans[i-1], plans[i]
+		if curr.StartHeight < prev.EndHeight {
+			return fmt.Errorf("mint plan starting at height %d overlaps the plan ending at height %d", curr.StartHeight, prev.EndHeight)
 		}
-		if value.RewardPerBlock.LTE(sdk.ZeroDec()) {
-			return fmt.Errorf("reward per block must be positive: %d", value.RewardPerBlock)
+		if curr.StartHeight > prev.EndHeight {
+			return fmt.Errorf("gap between the mint plan ending at height %d and the plan starting at height %d", prev.EndHeight, curr.StartHeight)
 		}
 	}
 
 	return nil
 }
+
+func validateBlocksPerYear(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("blocks per year must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max supply must not be negative: %s", v)
+	}
+
+	return nil
+}
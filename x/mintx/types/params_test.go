@@ -0,0 +1,394 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_GetMintedAmountBetween(t *testing.T) {
+	params := DefaultParams()
+	params.DefaultRewardPerBlock = sdk.NewDec(1)
+	params.MintPlans = []MintPlan{
+		{StartHeight: 10, EndHeight: 20, RewardPerBlock: sdk.NewDec(4)},
+		{StartHeight: 20, EndHeight: 30, RewardPerBlock: sdk.NewDec(2)},
+	}
+	unit := RewardPrecision.TruncateInt()
+
+	testCases := []struct {
+		name     string
+		from, to uint64
+		expected int64
+	}{
+		{"empty range", 15, 15, 0},
+		{"reversed range", 15, 5, 0},
+		{"before the plans", 0, 10, 10},
+		{"single block", 10, 11, 4},
+		{"inside a plan", 12, 18, 6 * 4},
+		{"across the plans", 5, 35, 5 + 10*4 + 10*2 + 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			amount := params.GetMintedAmountBetween(tc.from, tc.to)
+			require.Equal(t, unit.MulRaw(tc.expected).String(), amount.String())
+
+			// the ranges match the sum of the block rewards
+			sum := sdk.ZeroInt()
+			for height := tc.from + 1; height <= tc.to; height++ {
+				sum = sum.Add(params.GetMintedAmountByHeight(height))
+			}
+			require.Equal(t, sum.String(), amount.String())
+		})
+	}
+}
+
+func TestParams_EmissionCurves(t *testing.T) {
+	testCases := []struct {
+		name string
+		plan MintPlan
+		// total emission of the plan in the base unit of the mint denom
+		golden string
+	}{
+		{
+			"constant",
+			MintPlan{StartHeight: 0, EndHeight: 1000, RewardPerBlock: sdk.NewDec(10)},
+			"10000000000000000000000",
+		},
+		{
+			"halving",
+			MintPlan{StartHeight: 0, EndHeight: 1000, RewardPerBlock: sdk.NewDec(64), Curve: CurveTypeHalving, Interval: 100},
+			"12787500000000000000000",
+		},
+		{
+			"linear decay",
+			MintPlan{StartHeight: 0, EndHeight: 1500, RewardPerBlock: sdk.NewDec(10), Curve: CurveTypeLinearDecay, Interval: 100, DecayAmount: sdk.OneDec()},
+			"5500000000000000000000",
+		},
+		{
+			"exponential decay",
+			MintPlan{StartHeight: 0, EndHeight: 1000, RewardPerBlock: sdk.NewDec(100), Curve: CurveTypeExponentialDecay, Interval: 100, DecayFactor: sdk.NewDecWithPrec(9, 1)},
+			"65132155990000000000000",
+		},
+		{
+			"halving exhausted",
+			MintPlan{StartHeight: 100, EndHeight: ^uint64(0), RewardPerBlock: sdk.NewDec(1000), Curve: CurveTypeHalving, Interval: 1},
+			"1999999999999999999971",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.plan.Validate())
+			params := DefaultParams()
+			params.MintPlans = []MintPlan{tc.plan}
+
+			amount := params.GetMintedAmountBetween(tc.plan.StartHeight, tc.plan.EndHeight)
+			require.Equal(t, tc.golden, amount.String())
+
+			if tc.plan.EndHeight-tc.plan.StartHeight > 10000 {
+				return
+			}
+			sum := sdk.ZeroInt()
+			for height := tc.plan.StartHeight + 1; height <= tc.plan.EndHeight; height++ {
+				sum = sum.Add(params.GetMintedAmountByHeight(height))
+			}
+			require.Equal(t, sum.String(), amount.String())
+		})
+	}
+}
+
+func TestMintPlan_GetMintedAmountBetween(t *testing.T) {
+	plans := []MintPlan{
+		{StartHeight: 3, EndHeight: 40, RewardPerBlock: sdk.NewDec(64), Curve: CurveTypeHalving, Interval: 4},
+		{StartHeight: 3, EndHeight: 40, RewardPerBlock: sdk.NewDec(10), Curve: CurveTypeLinearDecay, Interval: 3, DecayAmount: sdk.NewDecWithPrec(15, 1)},
+		{StartHeight: 3, EndHeight: 40, RewardPerBlock: sdk.NewDec(100), Curve: CurveTypeExponentialDecay, Interval: 5, DecayFactor: sdk.NewDecWithPrec(5, 1)},
+	}
+	for _, plan := range plans {
+		require.NoError(t, plan.Validate())
+		// every range matches the sum of the block amounts
+		for from := uint64(0); from <= plan.EndHeight; from++ {
+			sum := sdk.ZeroInt()
+			for to := from; to <= plan.EndHeight+2; to++ {
+				if to > from && plan.Contains(to) {
+					sum = sum.Add(plan.GetRewardByHeight(to).Mul(RewardPrecision).TruncateInt())
+				}
+				require.Equal(t, sum.String(), plan.GetMintedAmountBetween(from, to).String(), "%s from %d to %d", plan.Curve, from, to)
+			}
+		}
+	}
+
+	// the longest plans are computed without iterating over their steps
+	linear := MintPlan{StartHeight: 0, EndHeight: ^uint64(0), RewardPerBlock: sdk.NewDec(1), Curve: CurveTypeLinearDecay, Interval: 1, DecayAmount: sdk.NewDecWithPrec(1, 18)}
+	// (10^18 + 1) * 10^18 / 2 base units over the 10^18 + 1 positive steps
+	expected, _ := sdk.NewIntFromString("500000000000000000500000000000000000")
+	require.Equal(t, expected.String(), linear.GetMintedAmountBetween(0, ^uint64(0)).String())
+	exponential := MintPlan{StartHeight: 0, EndHeight: ^uint64(0), RewardPerBlock: sdk.NewDec(1), Curve: CurveTypeExponentialDecay, Interval: 1, DecayFactor: sdk.NewDecWithPrec(999999999, 9)}
+	require.True(t, exponential.GetMintedAmountBetween(0, ^uint64(0)).GT(RewardPrecision.TruncateInt().MulRaw(999999999)))
+}
+
+func Test_validateMintPlan(t *testing.T) {
+	constant := func(start, end uint64) MintPlan {
+		return MintPlan{StartHeight: start, EndHeight: end, RewardPerBlock: sdk.OneDec()}
+	}
+	halving := constant(0, 100)
+	halving.Curve = CurveTypeHalving
+	linear := constant(0, 100)
+	linear.Curve, linear.Interval = CurveTypeLinearDecay, 10
+	exponential := constant(0, 100)
+	exponential.Curve, exponential.Interval, exponential.DecayFactor = CurveTypeExponentialDecay, 10, sdk.OneDec()
+
+	testCases := []struct {
+		name    string
+		plans   []MintPlan
+		expPass bool
+	}{
+		{"no plan", nil, true},
+		{"contiguous plans", []MintPlan{constant(0, 10), constant(10, 20), constant(20, ^uint64(0))}, true},
+		{"unordered contiguous plans", []MintPlan{constant(10, 20), constant(0, 10)}, true},
+		{"empty plan", []MintPlan{constant(10, 10)}, false},
+		{"overlapping plans", []MintPlan{constant(0, 10), constant(5, 20)}, false},
+		{"gapped plans", []MintPlan{constant(0, 10), constant(15, 20)}, false},
+		{"halving without interval", []MintPlan{halving}, false},
+		{"linear decay without amount", []MintPlan{linear}, false},
+		{"exponential decay without decay", []MintPlan{exponential}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateMintPlan(tc.plans)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_ClipToMaxSupply(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, sdk.NewInt(10), params.ClipToMaxSupply(sdk.NewInt(1000), sdk.NewInt(10)))
+
+	params.MaxSupply = sdk.NewInt(100)
+	require.Equal(t, sdk.NewInt(10), params.ClipToMaxSupply(sdk.NewInt(50), sdk.NewInt(10)))
+	require.Equal(t, sdk.NewInt(5), params.ClipToMaxSupply(sdk.NewInt(95), sdk.NewInt(10)))
+	require.Equal(t, sdk.ZeroInt(), params.ClipToMaxSupply(sdk.NewInt(100), sdk.NewInt(10)))
+	require.Equal(t, sdk.ZeroInt(), params.ClipToMaxSupply(sdk.NewInt(120), sdk.NewInt(10)))
+
+	require.Error(t, validateMaxSupply(sdk.NewInt(-1)))
+	require.NoError(t, validateMaxSupply(sdk.ZeroInt()))
+}
+
+func Test_validateDistributionRecipients(t *testing.T) {
+	addr := sdk.AccAddress("addr1_______________").String()
+	recipient := func(recipientType RecipientType, address string, weight int64, dust bool) DistributionRecipient {
+		return DistributionRecipient{Type: recipientType, Address: address, Weight: sdk.NewDecWithPrec(weight, 2), ReceivesDust: dust}
+	}
+	vesting := recipient(RecipientTypeAddress, addr, 20, false)
+	vesting.VestingEndHeight = 100
+	vestingModule := recipient(RecipientTypeModuleAccount, "gravity", 20, false)
+	vestingModule.VestingEndHeight = 100
+
+	testCases := []struct {
+		name       string
+		recipients []DistributionRecipient
+		expPass    bool
+	}{
+		{"default", DefaultDistributionRecipients(), true},
+		{"empty", nil, false},
+		{
+			"all the recipient types",
+			[]DistributionRecipient{
+				recipient(RecipientTypeFeeCollector, "", 50, true),
+				recipient(RecipientTypeCommunityPool, "", 20, false),
+				recipient(RecipientTypeModuleAccount, "gravity", 10, false),
+				vesting,
+			},
+			true,
+		},
+		{
+			"weights below 1",
+			[]DistributionRecipient{recipient(RecipientTypeFeeCollector, "", 50, true), recipient(RecipientTypeCommunityPool, "", 20, false)},
+			false,
+		},
+		{
+			"weights above 1",
+			[]DistributionRecipient{recipient(RecipientTypeFeeCollector, "", 90, true), recipient(RecipientTypeCommunityPool, "", 20, false)},
+			false,
+		},
+		{
+			"no dust recipient",
+			[]DistributionRecipient{recipient(RecipientTypeFeeCollector, "", 80, false), recipient(RecipientTypeCommunityPool, "", 20, false)},
+			false,
+		},
+		{
+			"two dust recipients",
+			[]DistributionRecipient{recipient(RecipientTypeFeeCollector, "", 80, true), recipient(RecipientTypeCommunityPool, "", 20, true)},
+			false,
+		},
+		{
+			"duplicate recipient",
+			[]DistributionRecipient{recipient(RecipientTypeFeeCollector, "", 80, true), recipient(RecipientTypeFeeCollector, "", 20, false)},
+			false,
+		},
+		{
+			"invalid address",
+			[]DistributionRecipient{recipient(RecipientTypeFeeCollector, "", 80, true), recipient(RecipientTypeAddress, "invalid", 20, false)},
+			false,
+		},
+		{
+			"module account without name",
+			[]DistributionRecipient{recipient(RecipientTypeFeeCollector, "", 80, true), recipient(RecipientTypeModuleAccount, "", 20, false)},
+			false,
+		},
+		{
+			"vesting module account",
+			[]DistributionRecipient{recipient(RecipientTypeFeeCollector, "", 80, true), vestingModule},
+			false,
+		},
+		{
+			"unspecified type",
+			[]DistributionRecipient{recipient(RecipientTypeUnspecified, "", 100, true)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDistributionRecipients(tc.recipients)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDistributionRecipient_ValidateAccounts(t *testing.T) {
+	blocked := sdk.AccAddress("blocked_____________")
+	SetRecipientAccounts([]string{"gravity"}, map[string]bool{blocked.String(): true})
+	defer func() { recipientAccounts.modules, recipientAccounts.blocked = nil, nil }()
+
+	recipient := func(recipientType RecipientType, address string) DistributionRecipient {
+		return DistributionRecipient{Type: recipientType, Address: address, Weight: sdk.OneDec()}
+	}
+	require.NoError(t, recipient(RecipientTypeModuleAccount, "gravity").Validate())
+	require.Error(t, recipient(RecipientTypeModuleAccount, "unknown").Validate())
+	require.NoError(t, recipient(RecipientTypeAddress, sdk.AccAddress("addr1_______________").String()).Validate())
+	require.Error(t, recipient(RecipientTypeAddress, blocked.String()).Validate())
+}
+
+func TestProposals_ValidateBasic(t *testing.T) {
+	plan := MintPlan{StartHeight: 10, EndHeight: 20, RewardPerBlock: sdk.OneDec()}
+	require.NoError(t, NewAddMintPlanProposal("title", "desc", plan).ValidateBasic())
+	require.Error(t, NewAddMintPlanProposal("", "desc", plan).ValidateBasic())
+	plan.EndHeight = 5
+	require.Error(t, NewAddMintPlanProposal("title", "desc", plan).ValidateBasic())
+
+	require.NoError(t, NewRemoveMintPlanProposal("title", "desc", 10).ValidateBasic())
+	require.Error(t, NewRemoveMintPlanProposal("title", "", 10).ValidateBasic())
+
+	require.NoError(t, NewUpdateDefaultRewardProposal("title", "desc", sdk.OneDec()).ValidateBasic())
+	require.Error(t, NewUpdateDefaultRewardProposal("title", "desc", sdk.ZeroDec()).ValidateBasic())
+	require.Error(t, NewUpdateDefaultRewardProposal("title", "desc", sdk.Dec{}).ValidateBasic())
+}
+
+func TestParams_NextInflationRate(t *testing.T) {
+	params := DefaultParams()
+	params.MintMode = MintModeBondedRatio
+	params.BondedRatioStartHeight = 100
+	require.False(t, params.IsBondedRatioTargeting(100))
+	require.True(t, params.IsBondedRatioTargeting(101))
+
+	// the same cases as the sdk mint module
+	blocksPerYr := sdk.NewDec(int64(params.BlocksPerYear))
+	testCases := []struct {
+		bondedRatio, inflation, expChange sdk.Dec
+	}{
+		// with 0% bonded atom supply the inflation should increase by InflationRateChange
+		{sdk.ZeroDec(), sdk.NewDecWithPrec(7, 2), params.InflationRateChange.Quo(blocksPerYr)},
+		// 100% bonded, starting at 20% inflation and being reduced
+		{
+			sdk.OneDec(), sdk.NewDecWithPrec(20, 2),
+			sdk.OneDec().Sub(sdk.OneDec().Quo(params.GoalBonded)).Mul(params.InflationRateChange).Quo(blocksPerYr),
+		},
+		// 50% bonded, starting at 10% inflation and being increased
+		{
+			sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(10, 2),
+			sdk.OneDec().Sub(sdk.NewDecWithPrec(5, 1).Quo(params.GoalBonded)).Mul(params.InflationRateChange).Quo(blocksPerYr),
+		},
+		// test 7% minimum stop (testing with 100% bonded)
+		{sdk.OneDec(), sdk.NewDecWithPrec(7, 2), sdk.ZeroDec()},
+		{sdk.OneDec(), sdk.NewDecWithPrec(700000001, 10), sdk.NewDecWithPrec(-1, 10)},
+		// test 20% maximum stop (testing with 0% bonded)
+		{sdk.ZeroDec(), sdk.NewDecWithPrec(20, 2), sdk.ZeroDec()},
+		{sdk.ZeroDec(), sdk.NewDecWithPrec(1999999999, 10), sdk.NewDecWithPrec(1, 10)},
+		// perfect balance shouldn't change inflation
+		{sdk.NewDecWithPrec(67, 2), sdk.NewDecWithPrec(15, 2), sdk.ZeroDec()},
+	}
+	for i, tc := range testCases {
+		inflation := params.NextInflationRate(tc.inflation, tc.bondedRatio)
+		diffInflation := inflation.Sub(tc.inflation)
+		require.True(t, diffInflation.Equal(tc.expChange),
+			"Test Index: %v\nDiff:  %v\nExpected: %v\n", i, diffInflation, tc.expChange)
+	}
+
+	require.Equal(t, sdk.NewInt(10), params.BlockProvision(sdk.NewDecWithPrec(10, 2), sdk.NewIntFromUint64(params.BlocksPerYear*100)))
+
+	params.InflationMin = sdk.NewDecWithPrec(30, 2)
+	require.Error(t, params.Validate())
+	params.InflationMin = DefaultInflationMin
+	params.GoalBonded = sdk.ZeroDec()
+	require.Error(t, params.Validate())
+	params.GoalBonded = DefaultGoalBonded
+	params.MintMode = MintMode(5)
+	require.Error(t, params.Validate())
+}
+
+func TestParams_TimeMintPlans(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	params := DefaultParams()
+	params.MintMode = MintModeTimePlans
+	params.TimeMintPlans = []TimeMintPlan{
+		NewTimeMintPlan(start, start.Add(time.Hour), sdk.NewDec(2)),
+		NewTimeMintPlan(start.Add(time.Hour), start.Add(2*time.Hour), sdk.NewDecWithPrec(5, 1)),
+	}
+	require.NoError(t, params.Validate())
+	require.True(t, params.IsTimeMinting())
+	require.Equal(t, sdk.NewDec(2), params.GetRewardRateByTime(start))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), params.GetRewardRateByTime(start.Add(time.Hour)))
+	require.Equal(t, sdk.ZeroDec(), params.GetRewardRateByTime(start.Add(2*time.Hour)))
+
+	// the amounts are split at the boundary of the plans and nothing is minted outside of them
+	amount := func(from, to time.Duration) sdk.Int {
+		return params.GetMintedAmountBetweenTimes(start.Add(from), start.Add(to))
+	}
+	require.Equal(t, sdk.NewDec(10).Mul(RewardPrecision).TruncateInt(), amount(0, 5*time.Second))
+	require.Equal(t, sdk.NewDecWithPrec(25, 1).Mul(RewardPrecision).TruncateInt(), amount(time.Hour-time.Second, time.Hour+time.Second))
+	require.Equal(t, sdk.NewDec(3600*2+1800).Mul(RewardPrecision).TruncateInt(), amount(-time.Hour, 3*time.Hour))
+	require.Equal(t, sdk.NewDecWithPrec(1, 9).Mul(sdk.NewDec(2)).Mul(RewardPrecision).TruncateInt(), amount(0, time.Nanosecond))
+	require.True(t, amount(5*time.Second, 0).IsZero())
+
+	// the first block and the blocks after a halt
+	now := start.Add(time.Hour)
+	require.Equal(t, now, params.CatchUpStartTime(time.Time{}, now))
+	require.Equal(t, now.Add(-5*time.Second), params.CatchUpStartTime(now.Add(-5*time.Second), now))
+	require.Equal(t, now.Add(-DefaultMaxCatchUpDuration), params.CatchUpStartTime(start, now))
+	params.MaxCatchUpDuration = 0
+	require.Equal(t, start, params.CatchUpStartTime(start, now))
+	params.MaxCatchUpDuration = -time.Second
+	require.Error(t, params.Validate())
+	params.MaxCatchUpDuration = DefaultMaxCatchUpDuration
+
+	// the windows must follow each other
+	params.TimeMintPlans[1].StartTime = start.Add(time.Hour + time.Second)
+	require.Error(t, params.Validate())
+	params.TimeMintPlans[1].StartTime = start.Add(time.Hour - time.Second)
+	require.Error(t, params.Validate())
+	params.TimeMintPlans[1].StartTime = start.Add(2 * time.Hour)
+	require.Error(t, params.Validate())
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeAddMintPlan defines the type for an AddMintPlanProposal
+	ProposalTypeAddMintPlan = "AddMintPlan"
+	// ProposalTypeRemoveMintPlan defines the type for a RemoveMintPlanProposal
+	ProposalTypeRemoveMintPlan = "RemoveMintPlan"
+	// ProposalTypeUpdateDefaultReward defines the type for an UpdateDefaultRewardProposal
+	ProposalTypeUpdateDefaultReward = "UpdateDefaultReward"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &AddMintPlanProposal{}
+	_ govtypes.Content = &RemoveMintPlanProposal{}
+	_ govtypes.Content = &UpdateDefaultRewardProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddMintPlan)
+	govtypes.RegisterProposalTypeCodec(&AddMintPlanProposal{}, "mintx/AddMintPlanProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveMintPlan)
+	govtypes.RegisterProposalTypeCodec(&RemoveMintPlanProposal{}, "mintx/RemoveMintPlanProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateDefaultReward)
+	govtypes.RegisterProposalTypeCodec(&UpdateDefaultRewardProposal{}, "mintx/UpdateDefaultRewardProposal")
+}
+
+func NewAddMintPlanProposal(title, description string, plan MintPlan) *AddMintPlanProposal {
+	return &AddMintPlanProposal{title, description, plan}
+}
+
+// GetTitle returns the title of an add mint plan proposal.
+func (p *AddMintPlanProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add mint plan proposal.
+func (p *AddMintPlanProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add mint plan proposal.
+func (p *AddMintPlanProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add mint plan proposal.
+func (p *AddMintPlanProposal) ProposalType() string { return ProposalTypeAddMintPlan }
+
+// ValidateBasic validates the add mint plan proposal
+func (p *AddMintPlanProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return p.Plan.Validate()
+}
+
+// String implements the Stringer interface.
+func (p AddMintPlanProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Add Mint Plan Proposal:
+  Title:            %s
+  Description:      %s
+  Start Height:     %d
+  End Height:       %d
+  Reward Per Block: %s
+  Curve:            %s
+`, p.Title, p.Description, p.Plan.StartHeight, p.Plan.EndHeight, p.Plan.RewardPerBlock, p.Plan.Curve))
+
+	return b.String()
+}
+
+func NewRemoveMintPlanProposal(title, description string, startHeight uint64) *RemoveMintPlanProposal {
+	return &RemoveMintPlanProposal{title, description, startHeight}
+}
+
+// GetTitle returns the title of a remove mint plan proposal.
+func (p *RemoveMintPlanProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove mint plan proposal.
+func (p *RemoveMintPlanProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove mint plan proposal.
+func (p *RemoveMintPlanProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove mint plan proposal.
+func (p *RemoveMintPlanProposal) ProposalType() string { return ProposalTypeRemoveMintPlan }
+
+// ValidateBasic validates the remove mint plan proposal
+func (p *RemoveMintPlanProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+// String implements the Stringer interface.
+func (p RemoveMintPlanProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Remove Mint Plan Proposal:
+  Title:        %s
+  Description:  %s
+  Start Height: %d
+`, p.Title, p.Description, p.StartHeight))
+
+	return b.String()
+}
+
+func NewUpdateDefaultRewardProposal(title, description string, reward sdk.Dec) *UpdateDefaultRewardProposal {
+	return &UpdateDefaultRewardProposal{title, description, reward}
+}
+
+// GetTitle returns the title of an update default reward proposal.
+func (p *UpdateDefaultRewardProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update default reward proposal.
+func (p *UpdateDefaultRewardProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update default reward proposal.
+func (p *UpdateDefaultRewardProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update default reward proposal.
+func (p *UpdateDefaultRewardProposal) ProposalType() string { return ProposalTypeUpdateDefaultReward }
+
+// ValidateBasic validates the update default reward proposal
+func (p *UpdateDefaultRewardProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return validateDefaultRewardPerBlock(p.DefaultRewardPerBlock)
+}
+
+// String implements the Stringer interface.
+func (p UpdateDefaultRewardProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Update Default Reward Proposal:
+  Title:                    %s
+  Description:              %s
+  Default Reward Per Block: %s
+`, p.Title, p.Description, p.DefaultRewardPerBlock))
+
+	return b.String()
+}
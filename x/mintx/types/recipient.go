@@ -0,0 +1,142 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recipientAccounts holds the module accounts which can receive the minted coins and the addresses blocked by
+// the bank module, they are set by the app as the recipients are changed by parameter change proposals.
+var recipientAccounts struct {
+	modules map[string]bool
+	blocked map[string]bool
+}
+
+// SetRecipientAccounts sets the module accounts and the blocked addresses the recipients are validated against,
+// the module accounts and the addresses aren't checked until it's called.
+func SetRecipientAccounts(moduleAccounts []string, blockedAddrs map[string]bool) {
+	recipientAccounts.modules = make(map[string]bool, len(moduleAccounts))
+	for _, name := range moduleAccounts {
+		recipientAccounts.modules[name] = true
+	}
+	recipientAccounts.blocked = blockedAddrs
+}
+
+// DefaultDistributionRecipients sends all the minted coins to the fee collector
+func DefaultDistributionRecipients() []DistributionRecipient {
+	return []DistributionRecipient{
+		{Type: RecipientTypeFeeCollector, Weight: sdk.OneDec(), ReceivesDust: true},
+	}
+}
+
+// Key returns the store key of the recipient totals
+func (r DistributionRecipient) Key() []byte {
+	return RecipientTotalKey(r.Type, r.Address)
+}
+
+// IsVesting returns true if the share of the recipient is kept by the module at the height
+func (r DistributionRecipient) IsVesting(height uint64) bool {
+	return r.Type == RecipientTypeAddress && height < r.VestingEndHeight
+}
+
+// Name returns a human readable name of the recipient
+func (r DistributionRecipient) Name() string {
+	if r.Address == "" {
+		return r.Type.String()
+	}
+	return fmt.Sprintf("%s/%s", r.Type, r.Address)
+}
+
+// Validate returns an error if the recipient is invalid
+func (r DistributionRecipient) Validate() error {
+	switch r.Type {
+	case RecipientTypeFeeCollector, RecipientTypeCommunityPool:
+		if r.Address != "" {
+			return fmt.Errorf("%s recipient doesn't take an address", r.Type)
+		}
+	case RecipientTypeModuleAccount:
+		if r.Address == "" {
+			return fmt.Errorf("module account recipient must have a module name")
+		}
+		if recipientAccounts.modules != nil && !recipientAccounts.modules[r.Address] {
+			return fmt.Errorf("unknown module account recipient %s", r.Address)
+		}
+	case RecipientTypeAddress:
+		addr, err := sdk.AccAddressFromBech32(r.Address)
+		if err != nil {
+			return fmt.Errorf("invalid recipient address %s: %w", r.Address, err)
+		}
+		if recipientAccounts.blocked[addr.String()] {
+			return fmt.Errorf("recipient address %s is not allowed to receive funds", r.Address)
+		}
+	default:
+		return fmt.Errorf("unknown recipient type: %s", r.Type)
+	}
+
+	if r.VestingEndHeight > 0 && r.Type != RecipientTypeAddress {
+		return fmt.Errorf("only address recipients can vest, got %s", r.Type)
+	}
+	if r.Weight.IsNil() || !r.Weight.IsPositive() || r.Weight.GT(sdk.OneDec()) {
+		return fmt.Errorf("weight of recipient %s must be between 0 and 1: %s", r.Name(), r.Weight)
+	}
+	return nil
+}
+
+// NewRecipientTotal returns empty totals for the recipient
+func NewRecipientTotal(recipientType RecipientType, address string) RecipientTotal {
+	return RecipientTotal{
+		Type:        recipientType,
+		Address:     address,
+		Distributed: sdk.ZeroInt(),
+		Vesting:     sdk.ZeroInt(),
+	}
+}
+
+// Validate returns an error if the totals are invalid
+func (t RecipientTotal) Validate() error {
+	if t.Distributed.IsNil() || t.Distributed.IsNegative() {
+		return fmt.Errorf("distributed amount must not be negative: %s", t.Distributed)
+	}
+	if t.Vesting.IsNil() || t.Vesting.IsNegative() {
+		return fmt.Errorf("vesting amount must not be negative: %s", t.Vesting)
+	}
+	return nil
+}
+
+func validateDistributionRecipients(i interface{}) error {
+	v, ok := i.([]DistributionRecipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return fmt.Errorf("distribution recipients cannot be empty")
+	}
+
+	total := sdk.ZeroDec()
+	dust := 0
+	seen := make(map[string]bool)
+	for _, recipient := range v {
+		if err := recipient.Validate(); err != nil {
+			return err
+		}
+		if seen[string(recipient.Key())] {
+			return fmt.Errorf("duplicate distribution recipient %s", recipient.Name())
+		}
+		seen[string(recipient.Key())] = true
+
+		total = total.Add(recipient.Weight)
+		if recipient.ReceivesDust {
+			dust++
+		}
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("weights of the distribution recipients must sum to 1: %s", total)
+	}
+	if dust != 1 {
+		return fmt.Errorf("exactly one distribution recipient must receive the dust, got %d", dust)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultMaxCatchUpDuration limits the amount minted by the first block after a halt to 10 minutes of rewards
+const DefaultMaxCatchUpDuration = 10 * time.Minute
+
+// SecondsPerYear is the length of a julian year, used to annualize the reward per second
+const SecondsPerYear = int64(60 * 60 * 8766)
+
+// NewTimeMintPlan create a new TimeMintPlan object
+func NewTimeMintPlan(startTime, endTime time.Time, rewardPerSecond sdk.Dec) TimeMintPlan {
+	return TimeMintPlan{
+		StartTime:       startTime.UTC(),
+		EndTime:         endTime.UTC(),
+		RewardPerSecond: rewardPerSecond,
+	}
+}
+
+// Contains returns true if the time is within the window of the plan
+func (plan TimeMintPlan) Contains(t time.Time) bool {
+	return !t.Before(plan.StartTime) && t.Before(plan.EndTime)
+}
+
+// Validate checks the window and the reward of the plan
+func (plan TimeMintPlan) Validate() error {
+	if !plan.EndTime.After(plan.StartTime) {
+		return fmt.Errorf("time mint plan end time %s must be after start time %s", plan.EndTime, plan.StartTime)
+	}
+	if plan.RewardPerSecond.IsNil() || plan.RewardPerSecond.IsNegative() {
+		return fmt.Errorf("time mint plan reward per second must be non-negative: %s", plan.RewardPerSecond)
+	}
+	return nil
+}
+
+// IsTimeMinting returns true if the amount minted at every block follows the time mint plans
+func (p *Params) IsTimeMinting() bool {
+	return p.MintMode == MintModeTimePlans
+}
+
+// GetRewardRateByTime returns the reward per second of the time plan containing the time,
+// zero when no plan contains it.
+func (p *Params) GetRewardRateByTime(t time.Time) sdk.Dec {
+	for _, plan := range p.TimeMintPlans {
+		if plan.Contains(t) {
+			return plan.RewardPerSecond
+		}
+	}
+	return sdk.ZeroDec()
+}
+
+// CatchUpStartTime returns the start of the period minted by a block at the given time.
+// The first block only records its time, and the period following a halt is limited to MaxCatchUpDuration.
+func (p *Params) CatchUpStartTime(lastMintTime, blockTime time.Time) time.Time {
+	if lastMintTime.IsZero() || !blockTime.After(lastMintTime) {
+		return blockTime
+	}
+	if p.MaxCatchUpDuration > 0 && blockTime.Sub(lastMintTime) > p.MaxCatchUpDuration {
+		return blockTime.Add(-p.MaxCatchUpDuration)
+	}
+	return lastMintTime
+}
+
+// GetMintedAmountBetweenTimes returns the amount minted by the time plans from the from time to the to time,
+// in the base unit of the mint denom. The period is split at the boundaries of the plans.
+func (p *Params) GetMintedAmountBetweenTimes(from, to time.Time) sdk.Int {
+	total := sdk.ZeroDec()
+	for _, plan := range p.TimeMintPlans {
+		start, end := plan.StartTime, plan.EndTime
+		if from.After(start) {
+			start = from
+		}
+		if to.Before(end) {
+			end = to
+		}
+		if !end.After(start) {
+			continue
+		}
+		// durations have a nanosecond precision, well within the 18 decimals
+		seconds := sdk.NewDecWithPrec(end.Sub(start).Nanoseconds(), 9)
+		total = total.Add(plan.RewardPerSecond.Mul(seconds))
+	}
+	return total.Mul(RewardPrecision).TruncateInt()
+}
+
+func validateTimeMintPlans(i interface{}) error {
+	v, ok := i.([]TimeMintPlan)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	plans := make([]TimeMintPlan, len(v))
+	copy(plans, v)
+	for _, plan := range plans {
+		if err := plan.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// the plans must follow each other without overlaps or gaps
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].StartTime.Before(plans[j].StartTime)
+	})
+	for i := 1; i < len(plans); i++ {
+		if plans[i].StartTime.Before(plans[i-1].EndTime) {
+			return fmt.Errorf("time mint plans overlap at %s", plans[i].StartTime)
+		}
+		if plans[i].StartTime.After(plans[i-1].EndTime) {
+			return fmt.Errorf("time mint plans have a gap between %s and %s", plans[i-1].EndTime, plans[i].StartTime)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxCatchUpDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return errors.New("max catch up duration cannot be negative")
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 	rpctypes "github.com/tharsis/ethermint/rpc/ethereum/types"
@@ -26,6 +28,16 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(
 		GetContractByDenomCmd(),
 		GetDenomByContractCmd(),
+		GetEthereumTransferCmd(),
+		GetTransferRecordCmd(),
+		GetTransferRecordsByAddressCmd(),
+		GetTransferRecordByExternalIDCmd(),
+		GetQuarantinedDepositsCmd(),
+		GetPendingTokenDepositsCmd(),
+		GetEvmTracesCmd(),
+		GetSRC20BalanceCmd(),
+		GetSRC20BalancesCmd(),
+		GetSRC20AddressCmd(),
 	)
 
 	// this line is used by starport scaffolding # 1
@@ -94,3 +106,376 @@ func GetDenomByContractCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetEthereumTransferCmd queries the status of a transfer to ethereum initiated from the evm
+func GetEthereumTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ethereum-transfer [id]",
+		Short: "Gets the status of a transfer to ethereum initiated from the evm",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.EthereumTransferRequest{
+				Id: id,
+			}
+
+			res, err := queryClient.EthereumTransfer(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTransferRecordCmd queries a record of the bridge transfer ledger
+func GetTransferRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-record [id]",
+		Short: "Gets a record of the bridge transfer ledger",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.TransferRecordRequest{
+				Id: id,
+			}
+
+			res, err := queryClient.TransferRecord(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTransferRecordsByAddressCmd queries the records of the bridge transfer ledger sent or received by an address
+func GetTransferRecordsByAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-records [address]",
+		Short: "Gets the records of the bridge transfer ledger sent or received by a bech32 or hex address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.TransferRecordsByAddressRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TransferRecordsByAddress(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfer-records")
+	return cmd
+}
+
+// GetTransferRecordByExternalIDCmd queries a record of the bridge transfer ledger by gravity nonce or ibc sequence
+func GetTransferRecordByExternalIDCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-record-by-external-id [inbound|outbound] [gravity|ibc] [sequence] [channel]",
+		Short: "Gets a record of the bridge transfer ledger by gravity nonce or ibc sequence, the channel is required for ibc",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			direction, ok := types.TransferDirection_value["TRANSFER_DIRECTION_"+strings.ToUpper(args[0])]
+			if !ok {
+				return fmt.Errorf("invalid direction %s", args[0])
+			}
+			origin, ok := types.TransferOrigin_value["TRANSFER_ORIGIN_"+strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("invalid origin %s", args[1])
+			}
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			var channel string
+			if len(args) > 3 {
+				channel = args[3]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.TransferRecordByExternalIDRequest{
+				Direction: types.TransferDirection(direction),
+				Origin:    types.TransferOrigin(origin),
+				Channel:   channel,
+				Sequence:  sequence,
+			}
+
+			res, err := queryClient.TransferRecordByExternalID(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetQuarantinedDepositsCmd queries the gravity deposits waiting to be released
+func GetQuarantinedDepositsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantined-deposits",
+		Short: "Gets the gravity deposits whose receiver couldn't be parsed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuarantinedDepositsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.QuarantinedDeposits(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "quarantined-deposits")
+	return cmd
+}
+
+// GetPendingTokenDepositsCmd queries the gravity deposits waiting for a token mapping
+func GetPendingTokenDepositsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-token-deposits [token-contract]",
+		Short: "Gets the gravity deposits waiting for a token mapping, of all the tokens if the ethereum contract is omitted",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.PendingTokenDepositsRequest{
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				req.TokenContract = args[0]
+			}
+
+			res, err := queryClient.PendingTokenDeposits(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-token-deposits")
+	return cmd
+}
+
+// GetEvmTracesCmd queries the traces of the evm calls made by a message of a tx
+func GetEvmTracesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-traces [tx-hash] [msg-index]",
+		Short: "Gets the traces of the evm calls made by the module while executing a message of a recent tx, the first one if the index is omitted",
+		Long:  "Gets the traces of the evm calls made by the module while executing a message of a recent tx. The traces are only kept in memory by the nodes configured with an evm tracer in app.toml.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var msgIndex uint64
+			if len(args) > 1 {
+				msgIndex, err = strconv.ParseUint(args[1], 10, 32)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.EvmTracesRequest{
+				TxHash:   args[0],
+				MsgIndex: uint32(msgIndex),
+			}
+
+			res, err := queryClient.EvmTraces(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSRC20BalanceCmd queries the balance of an address in a denom, in the bank and wrapped in the SRC20 contract
+func GetSRC20BalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "src20-balance [address] [denom]",
+		Short: "Gets the balance of an address in a denom, both in the bank and wrapped in the SRC20 contract of the denom, the address could be either bech32 or hex encoded",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.SRC20BalanceRequest{
+				Address: args[0],
+				Denom:   args[1],
+			}
+
+			res, err := queryClient.SRC20Balance(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSRC20BalancesCmd queries the balances of an address in the denoms held in the bank or mapped to a SRC20 contract
+func GetSRC20BalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "src20-balances [address]",
+		Short: "Gets the balances of an address in the denoms held in the bank or mapped to a SRC20 contract, the address could be either bech32 or hex encoded",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.SRC20BalancesRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.SRC20Balances(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSRC20AddressCmd queries the address of the SRC20 contract auto deployed for a denom
+func GetSRC20AddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "src20-address [denom]",
+		Short: "Gets the address of the SRC20 contract auto deployed for a denom, known before the contract is deployed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.SRC20AddressRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.SRC20Address(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,12 +2,16 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzcli "github.com/cosmos/cosmos-sdk/x/authz/client/cli"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -19,6 +23,13 @@ import (
 	"github.com/Seele-N/Seele/x/seele/types"
 )
 
+const (
+	// FlagReleaseNative defines the flag to release the pending deposits as native coins
+	FlagReleaseNative = "release-native"
+	// FlagAllowedDestinations defines the flag restricting the receivers of a bridge transfer grant
+	FlagAllowedDestinations = "allowed-destinations"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -32,6 +43,9 @@ func GetTxCmd() *cobra.Command {
 	// this line is used by starport scaffolding # 1
 
 	cmd.AddCommand(CmdUpdateTokenMapping())
+	cmd.AddCommand(CmdConvertSRC20())
+	cmd.AddCommand(CmdGrantConvert())
+	cmd.AddCommand(CmdGrantBridgeTransfer())
 
 	return cmd
 }
@@ -105,6 +119,149 @@ $ %s tx gov submit-proposal token-mapping-change gravity0x0000...0000 0x0000...0
 	return cmd
 }
 
+// NewSubmitPendingTokenApprovalProposalTxCmd returns a CLI command handler for creating
+// a pending token approval proposal governance transaction.
+func NewSubmitPendingTokenApprovalProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-token-approval [token-contract]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to convert or release the gravity deposits waiting for a token mapping",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to convert the gravity deposits waiting for a token mapping to a new src20 contract,
+or to release them as native coins with --release-native.
+
+Example:
+$ %s tx gov submit-proposal pending-token-approval 0x0000...0000 --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			releaseNative, err := cmd.Flags().GetBool(FlagReleaseNative)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid token contract address: %s", args[0])
+			}
+
+			content := types.NewPendingTokenApprovalProposal(
+				title, description, common.HexToAddress(args[0]), releaseNative,
+			)
+
+			from := clientCtx.GetFromAddress()
+
+			strDeposit, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(strDeposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().Bool(FlagReleaseNative, false, "Release the deposits as native coins instead of converting them")
+
+	return cmd
+}
+
+// NewSubmitQuarantinedDepositReleaseProposalTxCmd returns a CLI command handler for creating
+// a quarantined deposit release proposal governance transaction.
+func NewSubmitQuarantinedDepositReleaseProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantined-deposit-release [id] [receiver]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to release a quarantined gravity deposit to a bech32 or hex receiver",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to release a quarantined gravity deposit to a bech32 or hex receiver.
+
+Example:
+$ %s tx gov submit-proposal quarantined-deposit-release 1 0x0000...0000 --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewQuarantinedDepositReleaseProposal(title, description, id, args[1])
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			strDeposit, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(strDeposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
 // CmdUpdateTokenMapping returns a CLI command handler for update token mapping
 func CmdUpdateTokenMapping() *cobra.Command {
 	cmd := &cobra.Command{
@@ -129,3 +286,142 @@ func CmdUpdateTokenMapping() *cobra.Command {
 
 	return cmd
 }
+
+// CmdConvertSRC20 returns a CLI command handler for converting the SRC20 tokens held by the hex address
+// of the signer back to native coins
+func CmdConvertSRC20() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-src20 [contract] [amount]",
+		Short: "Convert the SRC20 tokens held by the hex address of the signer back to native coins",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert the SRC20 tokens held by the hex address of the signer back to native coins.
+The tokens are burnt by the module, no ethereum signature is needed so that multisig accounts
+or authz grantees can convert them.
+
+Example:
+$ %s tx seele convert-src20 0x0000...0000 1000 --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[1])
+			}
+
+			msg := types.NewMsgConvertSRC20(clientCtx.GetFromAddress().String(), args[0], amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdGrantConvert returns a CLI command handler for granting the conversion of ibc vouchers
+func CmdGrantConvert() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-convert [grantee] [spend-limit]",
+		Short: "Grant an account the conversion of up to spend-limit ibc vouchers on behalf of the signer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an account the conversion of up to spend-limit ibc vouchers on behalf of the signer,
+the grantee executes the conversions with the authz exec command until the expiration.
+
+Example:
+$ %s tx seele grant-convert <grantee> 1000ibc/0000...0000 --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spendLimit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			return grantAuthorization(cmd, args[0], types.NewConvertAuthorization(spendLimit))
+		},
+	}
+
+	cmd.Flags().Int64(authzcli.FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp of the expiration of the grant, one year by default")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdGrantBridgeTransfer returns a CLI command handler for granting the transfer of evm coins through ibc
+func CmdGrantBridgeTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-bridge-transfer [grantee] [spend-limit]",
+		Short: "Grant an account the transfer of up to spend-limit evm coins through ibc on behalf of the signer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an account the transfer of up to spend-limit evm coins through ibc on behalf of the signer,
+to the receivers of --allowed-destinations or to any receiver when the flag is empty.
+
+Example:
+$ %s tx seele grant-bridge-transfer <grantee> 1000000000000000000snp --allowed-destinations=cosmos1...,cosmos1... --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spendLimit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			destinations, err := cmd.Flags().GetStringSlice(FlagAllowedDestinations)
+			if err != nil {
+				return err
+			}
+
+			return grantAuthorization(cmd, args[0], types.NewBridgeTransferAuthorization(spendLimit, destinations))
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllowedDestinations, []string{}, "The receivers allowed on the destination chain, comma separated")
+	cmd.Flags().Int64(authzcli.FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp of the expiration of the grant, one year by default")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// grantAuthorization broadcasts a grant of the authorization from the signer to the grantee
+func grantAuthorization(cmd *cobra.Command, grantee string, authorization authz.Authorization) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return err
+	}
+
+	if err := authorization.ValidateBasic(); err != nil {
+		return err
+	}
+
+	exp, err := cmd.Flags().GetInt64(authzcli.FlagExpiration)
+	if err != nil {
+		return err
+	}
+
+	msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), granteeAddr, authorization, time.Unix(exp, 0))
+	if err != nil {
+		return err
+	}
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
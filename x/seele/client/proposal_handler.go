@@ -9,3 +9,9 @@ import (
 
 // ProposalHandler is the token mapping change proposal handler.
 var ProposalHandler = govclient.NewProposalHandler(cli.NewSubmitTokenMappingChangeProposalTxCmd, rest.ProposalRESTHandler)
+
+// PendingTokenApprovalProposalHandler is the pending token approval proposal handler.
+var PendingTokenApprovalProposalHandler = govclient.NewProposalHandler(cli.NewSubmitPendingTokenApprovalProposalTxCmd, rest.PendingTokenApprovalProposalRESTHandler)
+
+// QuarantinedDepositReleaseProposalHandler is the quarantined deposit release proposal handler.
+var QuarantinedDepositReleaseProposalHandler = govclient.NewProposalHandler(cli.NewSubmitQuarantinedDepositReleaseProposalTxCmd, rest.QuarantinedDepositReleaseProposalRESTHandler)
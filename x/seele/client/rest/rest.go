@@ -26,6 +26,30 @@ type (
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// PendingTokenApprovalProposalReq defines a pending token approval proposal request body.
+	PendingTokenApprovalProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title         string         `json:"title" yaml:"title"`
+		Description   string         `json:"description" yaml:"description"`
+		TokenContract string         `json:"token_contract" yaml:"token_contract"`
+		ReleaseNative bool           `json:"release_native" yaml:"release_native"`
+		Proposer      sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit       sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// QuarantinedDepositReleaseProposalReq defines a quarantined deposit release proposal request body.
+	QuarantinedDepositReleaseProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		ID          uint64         `json:"id" yaml:"id"`
+		Receiver    string         `json:"receiver" yaml:"receiver"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ProposalRESTHandler returns a ProposalRESTHandler that exposes the param
@@ -68,3 +92,73 @@ func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+// PendingTokenApprovalProposalRESTHandler returns a ProposalRESTHandler that exposes the pending token
+// approval REST handler with a given sub-route.
+func PendingTokenApprovalProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pending_token_approval",
+		Handler:  postPendingTokenApprovalProposalHandlerFn(clientCtx),
+	}
+}
+
+func postPendingTokenApprovalProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PendingTokenApprovalProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewPendingTokenApprovalProposal(req.Title, req.Description, common.HexToAddress(req.TokenContract), req.ReleaseNative)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// QuarantinedDepositReleaseProposalRESTHandler returns a ProposalRESTHandler that exposes the quarantined
+// deposit release REST handler with a given sub-route.
+func QuarantinedDepositReleaseProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "quarantined_deposit_release",
+		Handler:  postQuarantinedDepositReleaseProposalHandlerFn(clientCtx),
+	}
+}
+
+func postQuarantinedDepositReleaseProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req QuarantinedDepositReleaseProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewQuarantinedDepositReleaseProposal(req.Title, req.Description, req.ID, req.Receiver)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -34,6 +34,38 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetAutoContractForDenom(ctx, m.Denom, common.HexToAddress(m.Contract))
 	}
 
+	for _, denom := range genState.PredeployedDenoms {
+		if _, found := k.GetContractByDenom(ctx, denom); found {
+			continue
+		}
+		if _, err := k.DeployAutoContract(ctx, denom); err != nil {
+			panic(fmt.Sprintf("failed to deploy the contract of %s: %s", denom, err))
+		}
+	}
+
+	for _, transfer := range genState.EthereumTransfers {
+		k.ImportEthereumTransfer(ctx, transfer)
+	}
+	k.SetLastEthereumTransferID(ctx, genState.LastEthereumTransferId)
+
+	for _, record := range genState.TransferRecords {
+		k.ImportTransferRecord(ctx, record)
+	}
+	k.SetLastTransferRecordID(ctx, genState.LastTransferRecordId)
+
+	for _, deposit := range genState.QuarantinedDeposits {
+		k.SetQuarantinedDeposit(ctx, deposit)
+	}
+	k.SetLastQuarantinedDepositID(ctx, genState.LastQuarantinedDepositId)
+
+	for _, deposit := range genState.PendingTokenDeposits {
+		k.SetPendingTokenDeposit(ctx, deposit)
+	}
+	k.SetLastPendingTokenDepositID(ctx, genState.LastPendingTokenDepositId)
+	for _, approval := range genState.PendingTokenApprovals {
+		k.SetPendingTokenApproval(ctx, approval)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 
 	// this line is used by starport scaffolding # ibc/genesis/init
@@ -46,8 +78,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// this line is used by starport scaffolding # ibc/genesis/export
 
 	return &types.GenesisState{
-		Params:            k.GetParams(ctx),
-		ExternalContracts: k.GetExternalContracts(ctx),
-		AutoContracts:     k.GetAutoContracts(ctx),
+		Params:                    k.GetParams(ctx),
+		ExternalContracts:         k.GetExternalContracts(ctx),
+		AutoContracts:             k.GetAutoContracts(ctx),
+		EthereumTransfers:         k.GetEthereumTransfers(ctx),
+		LastEthereumTransferId:    k.GetLastEthereumTransferID(ctx),
+		TransferRecords:           k.GetTransferRecords(ctx),
+		LastTransferRecordId:      k.GetLastTransferRecordID(ctx),
+		QuarantinedDeposits:       k.GetAllQuarantinedDeposits(ctx),
+		LastQuarantinedDepositId:  k.GetLastQuarantinedDepositID(ctx),
+		PendingTokenDeposits:      k.GetAllPendingTokenDeposits(ctx),
+		LastPendingTokenDepositId: k.GetLastPendingTokenDepositID(ctx),
+		PendingTokenApprovals:     k.GetPendingTokenApprovals(ctx),
 	}
 }
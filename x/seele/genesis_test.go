@@ -1,6 +1,10 @@
 package seele_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+
 	"github.com/Seele-N/Seele/x/seele"
 	"github.com/Seele-N/Seele/x/seele/types"
 )
@@ -134,3 +138,160 @@ func (suite *SeeleTestSuite) TestExportGenesis() {
 	genesisState := seele.ExportGenesis(suite.ctx, suite.app.SeeleKeeper)
 	suite.Require().Equal(genesisState.Params.IbcCroDenom, types.DefaultParams().IbcCroDenom)
 }
+
+func (suite *SeeleTestSuite) TestExportGenesisEthereumTransfers() {
+	erc20 := "0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503"
+	denom := "gravity" + erc20
+	transfer := types.EthereumTransfer{
+		Sender:            "0x0000000000000000000000000000000000000065",
+		BridgeContract:    "0x0000000000000000000000000000000000000064",
+		TokenContract:     "0x0000000000000000000000000000000000000066",
+		EthereumRecipient: "0x0000000000000000000000000000000000000067",
+		Amount:            sdk.NewInt64Coin(denom, 90),
+		BridgeFee:         sdk.NewInt64Coin(denom, 10),
+	}
+	queued, batched, completed := transfer, transfer, transfer
+	queued.Id, queued.Status = 1, types.EthereumTransferStatusQueued
+	batched.Id, batched.Status, batched.Erc20Contract, batched.OutgoingTxId, batched.BatchNonce = 2, types.EthereumTransferStatusBatched, erc20, 5, 3
+	completed.Id, completed.Status, completed.Erc20Contract, completed.OutgoingTxId, completed.BatchNonce = 3, types.EthereumTransferStatusCompleted, erc20, 4, 2
+
+	genState := types.DefaultGenesis()
+	genState.EthereumTransfers = []types.EthereumTransfer{queued, batched, completed}
+	genState.LastEthereumTransferId = 4
+	suite.Require().NoError(genState.Validate())
+	seele.InitGenesis(suite.ctx, suite.app.SeeleKeeper, *genState)
+
+	exported := seele.ExportGenesis(suite.ctx, suite.app.SeeleKeeper)
+	suite.Require().Equal(genState.EthereumTransfers, exported.EthereumTransfers)
+	suite.Require().Equal(uint64(4), exported.LastEthereumTransferId)
+
+	// the batched transfer is indexed again
+	suite.app.SeeleKeeper.AfterBatchExecutedEvent(suite.ctx, gravitytypes.BatchExecutedEvent{TokenContract: erc20, BatchNonce: 3})
+	executed, found := suite.app.SeeleKeeper.GetEthereumTransfer(suite.ctx, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(types.EthereumTransferStatusCompleted, executed.Status)
+}
+
+func (suite *SeeleTestSuite) TestExportGenesisTransferRecords() {
+	receiver := "0x0000000000000000000000000000000000000067"
+	completed := types.TransferRecord{
+		Id:            1,
+		Direction:     types.TransferDirectionInbound,
+		Origin:        types.TransferOriginGravity,
+		Sequence:      7,
+		Sender:        "0x0000000000000000000000000000000000000065",
+		Receiver:      receiver,
+		Amount:        sdk.NewInt64Coin("snp", 10),
+		Status:        types.TransferStatusCompleted,
+		CreatedHeight: 1,
+		UpdatedHeight: 2,
+	}
+	pending := completed
+	pending.Id, pending.Direction, pending.Sequence, pending.Status = 3, types.TransferDirectionOutbound, 0, types.TransferStatusPending
+
+	genState := types.DefaultGenesis()
+	genState.TransferRecords = []types.TransferRecord{completed, pending}
+	genState.LastTransferRecordId = 3
+	suite.Require().NoError(genState.Validate())
+	seele.InitGenesis(suite.ctx, suite.app.SeeleKeeper, *genState)
+
+	exported := seele.ExportGenesis(suite.ctx, suite.app.SeeleKeeper)
+	suite.Require().Equal(genState.TransferRecords, exported.TransferRecords)
+	suite.Require().Equal(uint64(3), exported.LastTransferRecordId)
+
+	// the indexes are rebuilt
+	record, found := suite.app.SeeleKeeper.GetTransferRecordByExternalID(suite.ctx, types.TransferDirectionInbound, types.TransferOriginGravity, "", 7)
+	suite.Require().True(found)
+	suite.Require().Equal(completed, record)
+	records, _, err := suite.app.SeeleKeeper.GetTransferRecordsByAddress(suite.ctx, common.HexToAddress(receiver).Bytes(), nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 2)
+
+	// the completed record is pruned after the retention period
+	params := suite.app.SeeleKeeper.GetParams(suite.ctx)
+	params.TransferRecordRetention = 10
+	suite.app.SeeleKeeper.SetParams(suite.ctx, params)
+	suite.app.SeeleKeeper.PruneTransferRecords(suite.ctx.WithBlockHeight(13))
+	exported = seele.ExportGenesis(suite.ctx, suite.app.SeeleKeeper)
+	suite.Require().Equal([]types.TransferRecord{pending}, exported.TransferRecords)
+}
+
+func (suite *SeeleTestSuite) TestExportGenesisQuarantinedDeposits() {
+	deposit := types.QuarantinedDeposit{
+		Id:             2,
+		EventNonce:     5,
+		TokenContract:  "0x0000000000000000000000000000000000000065",
+		EthereumSender: "0x0000000000000000000000000000000000000066",
+		CosmosReceiver: "cosmos1invalid",
+		Amount:         sdk.NewInt64Coin("snp", 10),
+		Height:         1,
+		RecordId:       4,
+	}
+
+	genState := types.DefaultGenesis()
+	genState.QuarantinedDeposits = []types.QuarantinedDeposit{deposit}
+	genState.LastQuarantinedDepositId = 3
+	suite.Require().NoError(genState.Validate())
+	seele.InitGenesis(suite.ctx, suite.app.SeeleKeeper, *genState)
+
+	exported := seele.ExportGenesis(suite.ctx, suite.app.SeeleKeeper)
+	suite.Require().Equal(genState.QuarantinedDeposits, exported.QuarantinedDeposits)
+	suite.Require().Equal(uint64(3), exported.LastQuarantinedDepositId)
+	stored, found := suite.app.SeeleKeeper.GetQuarantinedDeposit(suite.ctx, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(deposit, stored)
+}
+
+func (suite *SeeleTestSuite) TestExportGenesisPendingTokenDeposits() {
+	token := common.HexToAddress("0x0000000000000000000000000000000000000065")
+	deposit := types.PendingTokenDeposit{
+		Id:            2,
+		TokenContract: token.Hex(),
+		Receiver:      "0x0000000000000000000000000000000000000066",
+		Amount:        sdk.NewInt64Coin("gravity"+token.Hex(), 10),
+		Height:        1,
+		RecordId:      4,
+	}
+	approval := types.PendingTokenApproval{TokenContract: token.Hex(), ReleaseNative: true}
+
+	genState := types.DefaultGenesis()
+	genState.PendingTokenDeposits = []types.PendingTokenDeposit{deposit}
+	genState.LastPendingTokenDepositId = 3
+	genState.PendingTokenApprovals = []types.PendingTokenApproval{approval}
+	suite.Require().NoError(genState.Validate())
+	seele.InitGenesis(suite.ctx, suite.app.SeeleKeeper, *genState)
+
+	exported := seele.ExportGenesis(suite.ctx, suite.app.SeeleKeeper)
+	suite.Require().Equal(genState.PendingTokenDeposits, exported.PendingTokenDeposits)
+	suite.Require().Equal(uint64(3), exported.LastPendingTokenDepositId)
+	suite.Require().Equal(genState.PendingTokenApprovals, exported.PendingTokenApprovals)
+	deposits, _, err := suite.app.SeeleKeeper.GetPendingTokenDeposits(suite.ctx, &token, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PendingTokenDeposit{deposit}, deposits)
+}
+
+func (suite *SeeleTestSuite) TestInitGenesisPredeployedDenoms() {
+	genState := types.DefaultGenesis()
+	genState.PredeployedDenoms = []string{"snp"}
+	suite.Require().NoError(genState.Validate())
+
+	// the contracts are deployed in the genesis block
+	ctx := suite.ctx.WithBlockHeight(0)
+	seele.InitGenesis(ctx, suite.app.SeeleKeeper, *genState)
+
+	contract, found := suite.app.SeeleKeeper.GetContractByDenom(ctx, "snp")
+	suite.Require().True(found)
+	expected, err := types.ModuleSRC20Address("snp", "snp", 18)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, contract)
+	_, found = suite.app.SeeleKeeper.GetContractByName(ctx, types.SnpDelegateContract.ContractName)
+	suite.Require().True(found)
+
+	// the denoms which already have a contract are skipped
+	suite.Require().NotPanics(func() {
+		seele.InitGenesis(ctx, suite.app.SeeleKeeper, *genState)
+	})
+
+	genState.PredeployedDenoms = []string{"snp", "snp"}
+	suite.Require().Error(genState.Validate())
+}
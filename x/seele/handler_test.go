@@ -141,12 +141,17 @@ func (suite *SeeleTestSuite) TestUpdateTokenMapping() {
 	denom := "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067"
 	contract := "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"
 
-	msg := types.NewMsgUpdateTokenMapping(suite.address.String(), denom, contract)
 	handler := seele.NewHandler(suite.app.SeeleKeeper)
-	_, err := handler(suite.ctx, msg)
+	// only the admin can update the mapping
+	_, _, other := testdata.KeyTestPubAddr()
+	_, err := handler(suite.ctx, types.NewMsgUpdateTokenMapping(other.String(), denom, contract))
+	suite.Require().Error(err)
+
+	msg := types.NewMsgUpdateTokenMapping(suite.address.String(), denom, contract)
+	_, err = handler(suite.ctx, msg)
 	suite.Require().NoError(err)
 
-	contractAddr, found := suite.app.SeeleKeeper.GetContractByDenom(suite.ctx, denom)
-	suite.Require().True(found)
-	suite.Require().Equal(contract, contractAddr.Hex())
+	rsp, err := suite.app.SeeleKeeper.ContractByDenom(sdk.WrapSDKContext(suite.ctx), &types.ContractByDenomRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(contract, rsp.Contract)
 }
@@ -0,0 +1,81 @@
+package seele
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/modules/apps/transfer"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/Seele-N/Seele/x/seele/keeper"
+)
+
+var _ porttypes.IBCModule = TransferMiddleware{}
+
+// TransferMiddleware wraps the ibc transfer module to follow the ibc transfers in the transfer ledger
+type TransferMiddleware struct {
+	transfer.AppModule
+	keeper keeper.Keeper
+}
+
+// NewTransferMiddleware creates a new TransferMiddleware
+func NewTransferMiddleware(app transfer.AppModule, k keeper.Keeper) TransferMiddleware {
+	return TransferMiddleware{
+		AppModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface, the state changes of a failed receive are discarded so only
+// the received transfers are recorded.
+func (im TransferMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	ack := im.AppModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	var data ibctransfertypes.FungibleTokenPacketData
+	// already validated by the transfer module
+	_ = ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data)
+	im.keeper.OnTransferPacketReceived(ctx, packet, data)
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im TransferMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	res, err := im.AppModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	if err != nil {
+		return nil, err
+	}
+
+	var ack channeltypes.Acknowledgement
+	// already validated by the transfer module
+	_ = ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack)
+	im.keeper.OnTransferPacketResult(ctx, packet.SourceChannel, packet.Sequence, ack.Success(), ack.GetError())
+	return res, nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im TransferMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	res, err := im.AppModule.OnTimeoutPacket(ctx, packet, relayer)
+	if err != nil {
+		return nil, err
+	}
+
+	im.keeper.OnTransferPacketResult(ctx, packet.SourceChannel, packet.Sequence, false, "packet timed out")
+	return res, nil
+}
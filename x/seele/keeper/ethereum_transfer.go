@@ -0,0 +1,306 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+// GetEthereumTransfer returns the transfer to ethereum with the given id
+func (k Keeper) GetEthereumTransfer(ctx sdk.Context, id uint64) (types.EthereumTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EthereumTransferKey(id))
+	if len(bz) == 0 {
+		return types.EthereumTransfer{}, false
+	}
+	var transfer types.EthereumTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+// setEthereumTransfer stores the transfer, updates its record in the transfer ledger
+// and emits an event reporting its status
+func (k Keeper) setEthereumTransfer(ctx sdk.Context, transfer types.EthereumTransfer) {
+	transfer.UpdatedHeight = ctx.BlockHeight()
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EthereumTransferKey(transfer.Id), k.cdc.MustMarshal(&transfer))
+	k.UpdateTransferRecord(ctx, transfer.RecordId, transfer.Status.TransferStatus(), transfer.OutgoingTxId, transfer.Error)
+	ctx.EventManager().EmitEvent(types.NewEthereumTransferEvent(transfer))
+}
+
+// GetEthereumTransfers returns all the transfers to ethereum ordered by id
+func (k Keeper) GetEthereumTransfers(ctx sdk.Context) (out []types.EthereumTransfer) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEthereumTransfer).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var transfer types.EthereumTransfer
+		k.cdc.MustUnmarshal(iter.Value(), &transfer)
+		out = append(out, transfer)
+	}
+	return
+}
+
+// ImportEthereumTransfer stores a transfer exported in genesis,
+// the queue and the pending indexes are rebuilt from its status.
+func (k Keeper) ImportEthereumTransfer(ctx sdk.Context, transfer types.EthereumTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EthereumTransferKey(transfer.Id), k.cdc.MustMarshal(&transfer))
+	switch transfer.Status {
+	case types.EthereumTransferStatusQueued:
+		store.Set(types.EthereumTransferQueueKey(transfer.Id), []byte{})
+	case types.EthereumTransferStatusPending, types.EthereumTransferStatusBatched:
+		k.setPendingEthereumTransfer(ctx, common.HexToAddress(transfer.Erc20Contract), transfer.OutgoingTxId, transfer.Id, transfer.BatchNonce)
+	}
+}
+
+// GetLastEthereumTransferID returns the id of the last transfer to ethereum
+func (k Keeper) GetLastEthereumTransferID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLastEthereumTransferID)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastEthereumTransferID sets the id of the last transfer to ethereum
+func (k Keeper) SetLastEthereumTransferID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyLastEthereumTransferID, sdk.Uint64ToBigEndian(id))
+}
+
+// nextEthereumTransferID increments and returns the last ethereum transfer id
+func (k Keeper) nextEthereumTransferID(ctx sdk.Context) uint64 {
+	id := k.GetLastEthereumTransferID(ctx) + 1
+	k.SetLastEthereumTransferID(ctx, id)
+	return id
+}
+
+// QueueEthereumTransfer records a transfer request emitted by the bridge contract,
+// the tokens are submitted to the gravity module at the end of the block, because the evm
+// can't be called again while the request is processed in an evm hook.
+func (k Keeper) QueueEthereumTransfer(
+	ctx sdk.Context,
+	sender, bridgeContract, tokenContract, recipient common.Address,
+	amount, bridgeFee sdk.Int,
+) (types.EthereumTransfer, error) {
+	denom, found := k.GetDenomByContract(ctx, tokenContract)
+	if !found {
+		return types.EthereumTransfer{}, fmt.Errorf("the contract address %s is not mapped to native token", tokenContract.String())
+	}
+	if !amount.IsPositive() {
+		return types.EthereumTransfer{}, fmt.Errorf("invalid amount %s", amount)
+	}
+	if bridgeFee.IsNegative() {
+		return types.EthereumTransfer{}, fmt.Errorf("invalid bridge fee %s", bridgeFee)
+	}
+
+	transfer := types.EthereumTransfer{
+		Id:                k.nextEthereumTransferID(ctx),
+		Sender:            sender.Hex(),
+		BridgeContract:    bridgeContract.Hex(),
+		TokenContract:     tokenContract.Hex(),
+		EthereumRecipient: recipient.Hex(),
+		Amount:            sdk.NewCoin(denom, amount),
+		BridgeFee:         sdk.NewCoin(denom, bridgeFee),
+		Status:            types.EthereumTransferStatusQueued,
+		CreatedHeight:     ctx.BlockHeight(),
+	}
+	transfer.RecordId = k.AppendTransferRecord(ctx, types.TransferRecord{
+		Direction: types.TransferDirectionOutbound,
+		Origin:    types.TransferOriginGravity,
+		Sender:    transfer.Sender,
+		Receiver:  transfer.EthereumRecipient,
+		Amount:    transfer.Amount,
+		Status:    types.TransferStatusPending,
+	})
+	k.setEthereumTransfer(ctx, transfer)
+	ctx.KVStore(k.storeKey).Set(types.EthereumTransferQueueKey(transfer.Id), []byte{})
+	return transfer, nil
+}
+
+// ProcessEthereumTransferQueue submits the queued transfers to the gravity module,
+// the tokens of a failed transfer are given back to the sender if possible.
+func (k Keeper) ProcessEthereumTransferQueue(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var ids []uint64
+	iter := prefix.NewStore(store, types.KeyPrefixEthereumTransferQueue).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iter.Key()))
+	}
+	iter.Close()
+
+	for _, id := range ids {
+		store.Delete(types.EthereumTransferQueueKey(id))
+		transfer, found := k.GetEthereumTransfer(ctx, id)
+		if !found {
+			continue
+		}
+
+		cacheCtx, commit := ctx.CacheContext()
+		err := k.submitEthereumTransfer(cacheCtx, &transfer)
+		if err == nil {
+			commit()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			transfer.Status = types.EthereumTransferStatusPending
+			k.setPendingEthereumTransfer(ctx, common.HexToAddress(transfer.Erc20Contract), transfer.OutgoingTxId, id, 0)
+		} else {
+			k.Logger(ctx).Error("failed to send tokens to ethereum", "id", id, "error", err)
+			transfer.Status = types.EthereumTransferStatusFailed
+			transfer.Error = err.Error()
+			if k.refundEthereumTransfer(ctx, transfer) {
+				transfer.Status = types.EthereumTransferStatusRefunded
+			}
+		}
+		k.setEthereumTransfer(ctx, transfer)
+	}
+}
+
+// submitEthereumTransfer unwraps the src20 tokens held by the bridge contract and sends them to the gravity module
+func (k Keeper) submitEthereumTransfer(ctx sdk.Context, transfer *types.EthereumTransfer) error {
+	bridgeContract := common.HexToAddress(transfer.BridgeContract)
+	total := transfer.Amount.Add(transfer.BridgeFee)
+
+	_, erc20, err := k.gravityKeeper.DenomToERC20Lookup(ctx, total.Denom)
+	if err != nil {
+		return err
+	}
+
+	err = k.ConvertCoinFromSRC20ToNative(ctx, common.HexToAddress(transfer.TokenContract), bridgeContract, total.Amount)
+	if err != nil {
+		return err
+	}
+
+	// the bridge contract is the sender in the gravity pool, so the transfer can't be cancelled by anyone.
+	rsp, err := k.gravityKeeper.SendToEthereum(sdk.WrapSDKContext(ctx), &gravitytypes.MsgSendToEthereum{
+		Sender:            sdk.AccAddress(bridgeContract.Bytes()).String(),
+		EthereumRecipient: transfer.EthereumRecipient,
+		Amount:            transfer.Amount,
+		BridgeFee:         transfer.BridgeFee,
+	})
+	if err != nil {
+		return err
+	}
+
+	transfer.Erc20Contract = erc20.Hex()
+	transfer.OutgoingTxId = rsp.Id
+	return nil
+}
+
+// refundEthereumTransfer gives the src20 tokens held by the bridge contract back to the sender
+func (k Keeper) refundEthereumTransfer(ctx sdk.Context, transfer types.EthereumTransfer) bool {
+	cacheCtx, commit := ctx.CacheContext()
+	contract := common.HexToAddress(transfer.TokenContract)
+	total := transfer.Amount.Add(transfer.BridgeFee).Amount.BigInt()
+	_, err := k.CallModuleSRC20(cacheCtx, contract, "burn_by_seele_module", common.HexToAddress(transfer.BridgeContract), total)
+	if err == nil {
+		_, err = k.CallModuleSRC20(cacheCtx, contract, "mint_by_seele_module", common.HexToAddress(transfer.Sender), total)
+	}
+	if err != nil {
+		k.Logger(ctx).Error("failed to refund ethereum transfer", "id", transfer.Id, "error", err)
+		return false
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return true
+}
+
+// setPendingEthereumTransfer indexes a transfer waiting in the gravity module by its erc20 contract and outgoing
+// transaction, the value holds the transfer id and the nonce of its batch, zero while it's in the pool.
+func (k Keeper) setPendingEthereumTransfer(ctx sdk.Context, erc20Contract common.Address, outgoingTxID, id, batchNonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingEthereumTransferKey(erc20Contract, outgoingTxID), append(sdk.Uint64ToBigEndian(id), sdk.Uint64ToBigEndian(batchNonce)...))
+	if batchNonce != 0 {
+		store.Set(types.BatchedEthereumTransferKey(erc20Contract, batchNonce, outgoingTxID), sdk.Uint64ToBigEndian(id))
+	}
+}
+
+// setEthereumTransferStatus updates the status and the batch nonce of a transfer if they have changed
+func (k Keeper) setEthereumTransferStatus(ctx sdk.Context, id uint64, status types.EthereumTransferStatus, batchNonce uint64) {
+	transfer, found := k.GetEthereumTransfer(ctx, id)
+	if !found || (transfer.Status == status && transfer.BatchNonce == batchNonce) {
+		return
+	}
+	transfer.Status, transfer.BatchNonce = status, batchNonce
+	k.setEthereumTransfer(ctx, transfer)
+}
+
+// SyncEthereumTransferBatches records the batch of the pending transfers picked by the gravity module. The batches
+// are built without a hook, so the outstanding batches are checked at the end of the block while transfers are
+// pending and every transaction of a batch is looked up directly in the pending index.
+func (k Keeper) SyncEthereumTransferBatches(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixPendingEthereumTransfer)
+	pending := iter.Valid()
+	iter.Close()
+	if !pending {
+		return
+	}
+
+	k.gravityKeeper.IterateOutgoingTxsByType(ctx, gravitytypes.BatchTxPrefixByte, func(_ []byte, outgoing gravitytypes.OutgoingTx) bool {
+		batch, ok := outgoing.(*gravitytypes.BatchTx)
+		if !ok {
+			return false
+		}
+		erc20Contract := common.HexToAddress(batch.TokenContract)
+		for _, ste := range batch.Transactions {
+			bz := store.Get(types.PendingEthereumTransferKey(erc20Contract, ste.Id))
+			if len(bz) == 0 {
+				continue
+			}
+			id, batchNonce := sdk.BigEndianToUint64(bz[:8]), sdk.BigEndianToUint64(bz[8:])
+			if batchNonce == batch.BatchNonce {
+				continue
+			}
+			if batchNonce != 0 {
+				// the previous batch has timed out and its transactions went back to the pool
+				store.Delete(types.BatchedEthereumTransferKey(erc20Contract, batchNonce, ste.Id))
+			}
+			k.setPendingEthereumTransfer(ctx, erc20Contract, ste.Id, id, batch.BatchNonce)
+			k.setEthereumTransferStatus(ctx, id, types.EthereumTransferStatusBatched, batch.BatchNonce)
+		}
+		return false
+	})
+}
+
+// completeEthereumTransferBatch completes the transfers of a batch executed on ethereum, the gravity module has
+// cancelled the earlier batches of the same contract so their transfers are back in the pool.
+func (k Keeper) completeEthereumTransferBatch(ctx sdk.Context, erc20Contract common.Address, batchNonce uint64) {
+	type batched struct {
+		key                          []byte
+		id, batchNonce, outgoingTxID uint64
+	}
+	var transfers []batched
+
+	store := ctx.KVStore(k.storeKey)
+	prefixLen := len(types.BatchedEthereumTransferPrefix(erc20Contract))
+	iter := sdk.KVStorePrefixIterator(store, types.BatchedEthereumTransferPrefix(erc20Contract))
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		nonce := sdk.BigEndianToUint64(key[prefixLen : prefixLen+8])
+		if nonce > batchNonce {
+			break
+		}
+		transfers = append(transfers, batched{
+			key:          key,
+			id:           sdk.BigEndianToUint64(iter.Value()),
+			batchNonce:   nonce,
+			outgoingTxID: sdk.BigEndianToUint64(key[prefixLen+8:]),
+		})
+	}
+	iter.Close()
+
+	for _, transfer := range transfers {
+		store.Delete(transfer.key)
+		if transfer.batchNonce == batchNonce {
+			store.Delete(types.PendingEthereumTransferKey(erc20Contract, transfer.outgoingTxID))
+			k.setEthereumTransferStatus(ctx, transfer.id, types.EthereumTransferStatusCompleted, batchNonce)
+		} else {
+			k.setPendingEthereumTransfer(ctx, erc20Contract, transfer.outgoingTxID, transfer.id, 0)
+			k.setEthereumTransferStatus(ctx, transfer.id, types.EthereumTransferStatusPending, 0)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+
+	seelekeeper "github.com/Seele-N/Seele/x/seele/keeper"
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+func (suite *KeeperTestSuite) TestSendToEthereum() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+
+	erc20 := common.HexToAddress("0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503")
+	denom := "gravity" + erc20.Hex()
+	bridge := common.BigToAddress(big.NewInt(100))
+	sender := common.BigToAddress(big.NewInt(101))
+	recipient := common.BigToAddress(big.NewInt(102))
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))
+
+	// the bridge contract holds the src20 tokens of the sender
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.AccAddress(bridge.Bytes()), coins)
+	suite.Require().NoError(err)
+	err = keeper.ConvertCoinFromNativeToSRC20(suite.ctx, bridge, coins[0], true)
+	suite.Require().NoError(err)
+	token, found := keeper.GetContractByDenom(suite.ctx, denom)
+	suite.Require().True(found)
+
+	handler := seelekeeper.NewSendToEthereumHandler(keeper)
+	data, err := seelekeeper.SendToEthereumEvent.Inputs.Pack(sender, token, recipient, big.NewInt(90), big.NewInt(10))
+	suite.Require().NoError(err)
+
+	// the bridge contract is not registered
+	err = handler.Handle(suite.ctx, bridge, data)
+	suite.Require().Error(err)
+
+	params := keeper.GetParams(suite.ctx)
+	params.GravityBridgeContract = bridge.Hex()
+	keeper.SetParams(suite.ctx, params)
+
+	err = handler.Handle(suite.ctx, sender, data)
+	suite.Require().Error(err)
+	err = handler.Handle(suite.ctx, bridge, data)
+	suite.Require().NoError(err)
+
+	transfer, found := keeper.GetEthereumTransfer(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.EthereumTransferStatusQueued, transfer.Status)
+	suite.Require().Equal(sender.Hex(), transfer.Sender)
+	suite.Require().Equal(sdk.NewCoin(denom, sdk.NewInt(90)), transfer.Amount)
+	suite.Require().Equal(sdk.NewCoin(denom, sdk.NewInt(10)), transfer.BridgeFee)
+
+	// submit to the gravity pool, the events of the gravity module and of the evm calls are emitted
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	keeper.ProcessEthereumTransferQueue(ctx)
+	eventTypes := make(map[string]bool)
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes[event.Type] = true
+	}
+	suite.Require().True(eventTypes[gravitytypes.EventTypeBridgeWithdrawalReceived])
+	suite.Require().Positive(types.EvmGasUsed(ctx.EventManager().Events()))
+	transfer, _ = keeper.GetEthereumTransfer(suite.ctx, 1)
+	suite.Require().Equal(types.EthereumTransferStatusPending, transfer.Status)
+	suite.Require().Equal(erc20.Hex(), transfer.Erc20Contract)
+	suite.Require().NotZero(transfer.OutgoingTxId)
+
+	ret, err := keeper.CallModuleSRC20(suite.ctx, token, "balanceOf", bridge)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, big.NewInt(0).Cmp(big.NewInt(0).SetBytes(ret)))
+
+	// batched
+	batch := suite.app.GravityKeeper.BuildBatchTx(suite.ctx, erc20, 10)
+	suite.Require().NotNil(batch)
+	keeper.SyncEthereumTransferBatches(suite.ctx)
+	transfer, _ = keeper.GetEthereumTransfer(suite.ctx, 1)
+	suite.Require().Equal(types.EthereumTransferStatusBatched, transfer.Status)
+	suite.Require().Equal(batch.BatchNonce, transfer.BatchNonce)
+
+	// executed, the gravity module removes the batch before calling the hook
+	suite.app.GravityKeeper.DeleteOutgoingTx(suite.ctx, batch.GetStoreIndex())
+	keeper.AfterBatchExecutedEvent(suite.ctx, gravitytypes.BatchExecutedEvent{
+		TokenContract: erc20.Hex(),
+		BatchNonce:    batch.BatchNonce,
+	})
+	transfer, _ = keeper.GetEthereumTransfer(suite.ctx, 1)
+	suite.Require().Equal(types.EthereumTransferStatusCompleted, transfer.Status)
+	suite.Require().Equal(batch.BatchNonce, transfer.BatchNonce)
+
+	record, found := keeper.GetTransferRecordByExternalID(suite.ctx, types.TransferDirectionOutbound, types.TransferOriginGravity, "", transfer.OutgoingTxId)
+	suite.Require().True(found)
+	suite.Require().Equal(transfer.RecordId, record.Id)
+	suite.Require().Equal(types.TransferStatusCompleted, record.Status)
+	suite.Require().Equal(recipient.Hex(), record.Receiver)
+}
+
+func (suite *KeeperTestSuite) TestEthereumTransferBatches() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+
+	erc20 := common.HexToAddress("0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503")
+	denom := "gravity" + erc20.Hex()
+	bridge := common.BigToAddress(big.NewInt(100))
+	sender := common.BigToAddress(big.NewInt(101))
+	recipient := common.BigToAddress(big.NewInt(102))
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(200)))
+
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.AccAddress(bridge.Bytes()), coins)
+	suite.Require().NoError(err)
+	err = keeper.ConvertCoinFromNativeToSRC20(suite.ctx, bridge, coins[0], true)
+	suite.Require().NoError(err)
+	token, found := keeper.GetContractByDenom(suite.ctx, denom)
+	suite.Require().True(found)
+
+	// the first transfer is batched alone
+	_, err = keeper.QueueEthereumTransfer(suite.ctx, sender, bridge, token, recipient, sdk.NewInt(90), sdk.NewInt(10))
+	suite.Require().NoError(err)
+	keeper.ProcessEthereumTransferQueue(suite.ctx)
+	first := suite.app.GravityKeeper.BuildBatchTx(suite.ctx, erc20, 1)
+	suite.Require().NotNil(first)
+	keeper.SyncEthereumTransferBatches(suite.ctx)
+
+	// the second transfer pays a higher fee and is batched alone in a later batch
+	_, err = keeper.QueueEthereumTransfer(suite.ctx, sender, bridge, token, recipient, sdk.NewInt(80), sdk.NewInt(20))
+	suite.Require().NoError(err)
+	keeper.ProcessEthereumTransferQueue(suite.ctx)
+	second := suite.app.GravityKeeper.BuildBatchTx(suite.ctx, erc20, 1)
+	suite.Require().NotNil(second)
+	keeper.SyncEthereumTransferBatches(suite.ctx)
+
+	transfer, _ := keeper.GetEthereumTransfer(suite.ctx, 1)
+	suite.Require().Equal(types.EthereumTransferStatusBatched, transfer.Status)
+	suite.Require().Equal(first.BatchNonce, transfer.BatchNonce)
+	transfer, _ = keeper.GetEthereumTransfer(suite.ctx, 2)
+	suite.Require().Equal(types.EthereumTransferStatusBatched, transfer.Status)
+	suite.Require().Equal(second.BatchNonce, transfer.BatchNonce)
+
+	// executing the second batch cancels the first one back into the pool
+	suite.app.GravityKeeper.CancelBatchTx(suite.ctx, erc20, first.BatchNonce)
+	suite.app.GravityKeeper.DeleteOutgoingTx(suite.ctx, second.GetStoreIndex())
+	keeper.AfterBatchExecutedEvent(suite.ctx, gravitytypes.BatchExecutedEvent{
+		TokenContract: erc20.Hex(),
+		BatchNonce:    second.BatchNonce,
+	})
+	transfer, _ = keeper.GetEthereumTransfer(suite.ctx, 1)
+	suite.Require().Equal(types.EthereumTransferStatusPending, transfer.Status)
+	suite.Require().Zero(transfer.BatchNonce)
+	transfer, _ = keeper.GetEthereumTransfer(suite.ctx, 2)
+	suite.Require().Equal(types.EthereumTransferStatusCompleted, transfer.Status)
+
+	// the first transfer is batched again
+	third := suite.app.GravityKeeper.BuildBatchTx(suite.ctx, erc20, 1)
+	suite.Require().NotNil(third)
+	keeper.SyncEthereumTransferBatches(suite.ctx)
+	transfer, _ = keeper.GetEthereumTransfer(suite.ctx, 1)
+	suite.Require().Equal(types.EthereumTransferStatusBatched, transfer.Status)
+	suite.Require().Equal(third.BatchNonce, transfer.BatchNonce)
+}
+
+func (suite *KeeperTestSuite) TestSendToEthereumRefund() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	bridge := common.BigToAddress(big.NewInt(100))
+	sender := common.BigToAddress(big.NewInt(101))
+	recipient := common.BigToAddress(big.NewInt(102))
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))
+
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.AccAddress(bridge.Bytes()), coins)
+	suite.Require().NoError(err)
+	err = keeper.ConvertCoinFromNativeToSRC20(suite.ctx, bridge, coins[0], true)
+	suite.Require().NoError(err)
+	token, found := keeper.GetContractByDenom(suite.ctx, denom)
+	suite.Require().True(found)
+
+	// the denom has no erc20 representation on ethereum
+	_, err = keeper.QueueEthereumTransfer(suite.ctx, sender, bridge, token, recipient, sdk.NewInt(100), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	keeper.ProcessEthereumTransferQueue(suite.ctx)
+
+	transfer, found := keeper.GetEthereumTransfer(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.EthereumTransferStatusRefunded, transfer.Status)
+	suite.Require().NotEmpty(transfer.Error)
+
+	ret, err := keeper.CallModuleSRC20(suite.ctx, token, "balanceOf", sender)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(100), big.NewInt(0).SetBytes(ret))
+
+	record, found := keeper.GetTransferRecord(suite.ctx, transfer.RecordId)
+	suite.Require().True(found)
+	suite.Require().Equal(types.TransferStatusRefunded, record.Status)
+}
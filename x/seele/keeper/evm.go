@@ -3,36 +3,38 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
-	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/tharsis/ethermint/server/config"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 
 	"github.com/Seele-N/Seele/x/seele/types"
 )
 
-// CallEVM execute an evm message from native module
+// CallEVM execute an evm message from native module. The gas limit of the message is the one of the params,
+// capped by the gas left in the meter of the context, and the evm gas used is charged to the meter at the
+// gas ratio of the params.
 func (k Keeper) CallEVM(ctx sdk.Context, to *common.Address, data []byte, value *big.Int) (*ethtypes.Message, *evmtypes.MsgEthereumTxResponse, error) {
-	k.evmKeeper.WithContext(ctx)
+	return k.callEVM(ctx, to, data, value, nil)
+}
 
-	nonce := k.evmKeeper.GetNonce(types.EVMModuleAddress)
-	msg := ethtypes.NewMessage(
-		types.EVMModuleAddress,
-		to,
-		nonce,
-		value, // amount
-		config.DefaultGasCap,
-		big.NewInt(0), // gasPrice
-		data,
-		nil,   // accessList
-		false, // checkNonce
-	)
+// Create2EVM deploys a contract from native module with CREATE2, the address of the contract only depends on
+// the module address, the salt and the creation code. The gas is metered as for CallEVM.
+func (k Keeper) Create2EVM(ctx sdk.Context, initCode []byte, salt common.Hash) (*evmtypes.MsgEthereumTxResponse, error) {
+	_, res, err := k.callEVM(ctx, nil, initCode, big.NewInt(0), &salt)
+	return res, err
+}
 
+// callEVM executes an evm message from native module, a contract creation uses CREATE2 if a salt is given
+func (k Keeper) callEVM(ctx sdk.Context, to *common.Address, data []byte, value *big.Int, salt *common.Hash) (*ethtypes.Message, *evmtypes.MsgEthereumTxResponse, error) {
 	params := k.evmKeeper.GetParams(ctx)
 	// return error if contract creation or call are disabled through governance
 	if !params.EnableCreate && to == nil {
@@ -42,20 +44,192 @@ func (k Keeper) CallEVM(ctx sdk.Context, to *common.Address, data []byte, value
 	}
 	ethCfg := params.ChainConfig.EthereumConfig(k.evmKeeper.ChainID())
 
-	// get the coinbase address from the block proposer
+	// get the coinbase address from the block proposer, the genesis block has none
+	// but the calls of the module pay no fee to the coinbase
 	coinbase, err := k.evmKeeper.GetCoinbaseAddress(ctx)
-	if err != nil {
+	if err != nil && ctx.BlockHeight() != 0 {
 		return nil, nil, errors.New("failed to obtain coinbase address")
 	}
-	evm := k.evmKeeper.NewEVM(msg, ethCfg, params, coinbase, types.NewDummyTracer())
-	ret, err := k.evmKeeper.ApplyMessage(evm, msg, ethCfg, true)
+
+	seeleParams := k.GetParams(ctx)
+	gasLimit := evmCallGasLimit(ctx.GasMeter(), seeleParams)
+	if gasLimit == 0 {
+		return nil, nil, sdkerrors.Wrap(types.ErrEvmOutOfGas, "no gas left for the evm call")
+	}
+
+	// the store accesses of the evm are paid by the evm gas, the evm keeper is given back the context afterwards
+	k.evmKeeper.WithContext(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+	defer k.evmKeeper.WithContext(ctx)
+
+	nonce := k.evmKeeper.GetNonce(types.EVMModuleAddress)
+	msg := ethtypes.NewMessage(
+		types.EVMModuleAddress,
+		to,
+		nonce,
+		value, // amount
+		gasLimit,
+		big.NewInt(0), // gasPrice
+		data,
+		nil,   // accessList
+		false, // checkNonce
+	)
+
+	tracer := k.newEvmTracer(ctx)
+	evm := k.newEVM(msg, ethCfg, params, coinbase, tracer)
+	var ret *evmtypes.MsgEthereumTxResponse
+	if salt != nil {
+		ret, err = k.applyCreate2(evm, msg, ethCfg, *salt)
+	} else {
+		ret, err = k.evmKeeper.ApplyMessage(evm, msg, ethCfg, true)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 	k.evmKeeper.CommitCachedContexts()
+
+	// NOTE: within the hooks of an ethereum tx, ethermint resets the meter to the gas used by the tx afterwards
+	gasCharged := evmGasCharged(ret.GasUsed, seeleParams)
+	ctx.GasMeter().ConsumeGas(gasCharged, "seele evm call")
+	contract := ""
+	if to != nil {
+		contract = to.Hex()
+	}
+	ctx.EventManager().EmitEvent(types.NewEvmCallEvent(contract, ret.GasUsed, gasCharged))
+	k.traceEvmCall(ctx, contract, ret, tracer)
+
 	return &msg, ret, nil
 }
 
+// applyCreate2 applies a contract creation message with CREATE2, metering the gas as ethermint ApplyMessage does
+// for the queries: the intrinsic gas is charged and the refund is deducted from the gas used.
+func (k Keeper) applyCreate2(evm *vm.EVM, msg ethtypes.Message, cfg *params.ChainConfig, salt common.Hash) (*evmtypes.MsgEthereumTxResponse, error) {
+	defer k.evmKeeper.ClearStateError()
+
+	intrinsicGas, err := k.evmKeeper.GetEthIntrinsicGas(msg, cfg, true)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Gas() < intrinsicGas {
+		return nil, sdkerrors.Wrapf(types.ErrEvmOutOfGas, "gas limit %d below intrinsic gas %d", msg.Gas(), intrinsicGas)
+	}
+	leftoverGas := msg.Gas() - intrinsicGas
+
+	if rules := cfg.Rules(evm.Context.BlockNumber); rules.IsBerlin {
+		k.evmKeeper.PrepareAccessList(msg.From(), nil, vm.ActivePrecompiles(rules), msg.AccessList())
+	}
+
+	ret, _, leftoverGas, vmErr := evm.Create2(vm.AccountRef(msg.From()), msg.Data(), leftoverGas, msg.Value(), new(uint256.Int).SetBytes(salt.Bytes()))
+	leftoverGas += k.evmKeeper.GasToRefund(msg.Gas()-leftoverGas, 2)
+
+	res := &evmtypes.MsgEthereumTxResponse{
+		GasUsed: msg.Gas() - leftoverGas,
+		Ret:     ret,
+	}
+	if vmErr != nil {
+		res.VmError = vmErr.Error()
+	}
+	return res, nil
+}
+
+// StaticCallEVM executes a read-only evm call from native module against a throwaway copy of the context,
+// nothing is written to the state and the module nonce is left untouched. The gas limit and the gas charged
+// to the meter of the context follow the params as for CallEVM.
+func (k Keeper) StaticCallEVM(ctx sdk.Context, contract common.Address, data []byte) (*evmtypes.MsgEthereumTxResponse, error) {
+	params := k.evmKeeper.GetParams(ctx)
+	if !params.EnableCall {
+		return nil, errors.New("failed to call contract")
+	}
+	ethCfg := params.ChainConfig.EthereumConfig(k.evmKeeper.ChainID())
+
+	// the coinbase is irrelevant to a read-only call, the queries could be served without block proposer
+	coinbase, _ := k.evmKeeper.GetCoinbaseAddress(ctx)
+
+	seeleParams := k.GetParams(ctx)
+	gasLimit := evmCallGasLimit(ctx.GasMeter(), seeleParams)
+	if gasLimit == 0 {
+		return nil, sdkerrors.Wrap(types.ErrEvmOutOfGas, "no gas left for the evm call")
+	}
+
+	// the evm runs on a copy of the evm keeper against a cache of the context which is never written,
+	// so the context of the shared keeper is left untouched by the queries
+	cacheCtx, _ := ctx.CacheContext()
+	evmKeeper := *k.evmKeeper
+	evmKeeper.WithContext(cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+
+	msg := ethtypes.NewMessage(
+		types.EVMModuleAddress,
+		&contract,
+		evmKeeper.GetNonce(types.EVMModuleAddress),
+		big.NewInt(0), // amount
+		gasLimit,
+		big.NewInt(0), // gasPrice
+		data,
+		nil,   // accessList
+		false, // checkNonce
+	)
+
+	evm := evmKeeper.NewEVM(msg, ethCfg, params, coinbase, types.NewDummyTracer())
+	ret, leftoverGas, vmErr := evm.StaticCall(vm.AccountRef(types.EVMModuleAddress), contract, data, gasLimit)
+	res := &evmtypes.MsgEthereumTxResponse{
+		Ret:     ret,
+		GasUsed: gasLimit - leftoverGas,
+	}
+	if vmErr != nil {
+		res.VmError = vmErr.Error()
+	}
+
+	ctx.GasMeter().ConsumeGas(evmGasCharged(res.GasUsed, seeleParams), "seele evm static call")
+	return res, nil
+}
+
+// isContractDeployed returns true if a contract is deployed at the address, the code is read through a copy
+// of the evm keeper so the context of the shared keeper is left untouched
+func (k Keeper) isContractDeployed(ctx sdk.Context, address common.Address) bool {
+	evmKeeper := *k.evmKeeper
+	evmKeeper.WithContext(ctx)
+	return evmKeeper.GetCodeSize(address) > 0
+}
+
+// evmCallGasLimit returns the gas limit of an evm call, the limit of the params capped by the gas left in the meter.
+// An infinite meter has no limit.
+func evmCallGasLimit(meter sdk.GasMeter, params types.Params) uint64 {
+	limit := params.EvmCallGasLimit
+	if meter.Limit() == 0 || params.EvmGasRatio.IsZero() {
+		return limit
+	}
+	if meter.IsOutOfGas() {
+		return 0
+	}
+	left := sdk.NewIntFromUint64(meter.Limit() - meter.GasConsumed()).ToDec().Quo(params.EvmGasRatio).TruncateInt()
+	if left.IsUint64() && left.Uint64() < limit {
+		return left.Uint64()
+	}
+	return limit
+}
+
+// evmGasCharged returns the gas charged to the meter for the evm gas used, rounded down
+// so that it never exceeds the gas left which capped the call, and saturated at the max uint64
+func evmGasCharged(gasUsed uint64, params types.Params) uint64 {
+	charged := sdk.NewIntFromUint64(gasUsed).ToDec().Mul(params.EvmGasRatio).TruncateInt()
+	if !charged.IsUint64() {
+		return math.MaxUint64
+	}
+	return charged.Uint64()
+}
+
+// evmCallError returns the error of a failed evm call, with the decoded reason of a revert
+func evmCallError(res *evmtypes.MsgEthereumTxResponse) error {
+	switch res.VmError {
+	case vm.ErrOutOfGas.Error():
+		return sdkerrors.Wrapf(types.ErrEvmOutOfGas, "gas used %d", res.GasUsed)
+	case vm.ErrExecutionReverted.Error():
+		if reason := types.DecodeRevertReason(res.Ret); reason != "" {
+			return sdkerrors.Wrapf(types.ErrEvmCallFailed, "%s: %s", res.VmError, reason)
+		}
+	}
+	return sdkerrors.Wrap(types.ErrEvmCallFailed, res.VmError)
+}
+
 // CallModuleSRC20 call a method of ModuleSRC20 contract
 func (k Keeper) CallModuleSRC20(ctx sdk.Context, contract common.Address, method string, args ...interface{}) ([]byte, error) {
 	data, err := types.ModuleSRC20Contract.ABI.Pack(method, args...)
@@ -67,11 +241,27 @@ func (k Keeper) CallModuleSRC20(ctx sdk.Context, contract common.Address, method
 		return nil, err
 	}
 	if res.Failed() {
-		return nil, fmt.Errorf("call contract failed: %s, %s, %s", contract.Hex(), method, res.Ret)
+		return nil, sdkerrors.Wrapf(evmCallError(res), "call contract %s, %s", contract.Hex(), method)
 	}
 	return res.Ret, nil
 }
 
+// StaticCallModuleSRC20 call a read-only method of ModuleSRC20 contract, returning the unpacked outputs
+func (k Keeper) StaticCallModuleSRC20(ctx sdk.Context, contract common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := types.ModuleSRC20Contract.ABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	res, err := k.StaticCallEVM(ctx, contract, data)
+	if err != nil {
+		return nil, err
+	}
+	if res.Failed() {
+		return nil, sdkerrors.Wrapf(evmCallError(res), "call contract %s, %s", contract.Hex(), method)
+	}
+	return types.ModuleSRC20Contract.ABI.Unpack(method, res.Ret)
+}
+
 // DeploySnpDelegate deploy an embed snp delegate contract
 func (k Keeper) DeploySnpDelegate(ctx sdk.Context) (common.Address, error) {
 	ctor, err := types.SnpDelegateContract.ABI.Pack("")
@@ -87,74 +277,92 @@ func (k Keeper) DeploySnpDelegate(ctx sdk.Context) (common.Address, error) {
 	}
 
 	if res.Failed() {
-		return common.Address{}, fmt.Errorf("contract deploy failed: %s", res.Ret)
+		return common.Address{}, sdkerrors.Wrap(evmCallError(res), "contract deploy")
 	}
 	return crypto.CreateAddress(types.EVMModuleAddress, msg.Nonce()), nil
 }
 
-// DeployModuleSRC20 deploy an embed erc20 contract
-func (k Keeper) DeployModuleSRC20(ctx sdk.Context, denom string, decimals uint8) (common.Address, error) {
-	ctor, err := types.ModuleSRC20Contract.ABI.Pack("", denom+" Token", denom, decimals)
+// DeployModuleSRC20 deploy an embed erc20 contract named after name with CREATE2, the salt being the hash of
+// the denom, so that the address of the contract of a denom is predictable and identical on every network
+func (k Keeper) DeployModuleSRC20(ctx sdk.Context, denom, name string, decimals uint8) (common.Address, error) {
+	initCode, err := types.ModuleSRC20InitCode(name, decimals)
 	if err != nil {
 		return common.Address{}, err
 	}
-	data := types.ModuleSRC20Contract.Bin
-	data = append(data, ctor...)
 
-	msg, res, err := k.CallEVM(ctx, nil, data, big.NewInt(0))
+	res, err := k.Create2EVM(ctx, initCode, types.ModuleSRC20Salt(denom))
 	if err != nil {
 		return common.Address{}, err
 	}
 
 	if res.Failed() {
-		return common.Address{}, fmt.Errorf("contract deploy failed: %s", res.Ret)
+		return common.Address{}, sdkerrors.Wrap(evmCallError(res), "contract deploy")
 	}
-	return crypto.CreateAddress(types.EVMModuleAddress, msg.Nonce()), nil
+	return types.ModuleSRC20Address(denom, name, decimals)
+}
+
+// autoContractMetadata returns the name and the decimals of the contract auto-deployed for a denom: the ethereum
+// tokens are named after the denom of their approved mapping, the other denoms after themselves
+func (k Keeper) autoContractMetadata(ctx sdk.Context, denom string) (string, uint8) {
+	name, decimals := denom, uint8(18)
+	if !types.IsValidGravityDenom(denom) {
+		return name, decimals
+	}
+	_, tokenContract, err := k.gravityKeeper.DenomToERC20Lookup(ctx, denom)
+	if err != nil {
+		return name, decimals
+	}
+	if mapped, found := k.getExternalDenomByContract(ctx, tokenContract.Hex()); found {
+		name = mapped
+	}
+	if tokenContract == common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7") { // Fixme hard code for USDT
+		name, decimals = "USDT", uint8(6)
+	}
+	return name, decimals
+}
+
+// DeployAutoContract deploys the module SRC20 contract of a denom, and the system contracts depending on it:
+// the snp delegate contract comes with the contract of snp.
+func (k Keeper) DeployAutoContract(ctx sdk.Context, denom string) (common.Address, error) {
+	name, decimals := k.autoContractMetadata(ctx, denom)
+	contract, err := k.DeployModuleSRC20(ctx, denom, name, decimals)
+	if err != nil {
+		return common.Address{}, err
+	}
+	k.SetAutoContractForDenom(ctx, denom, contract)
+
+	k.Logger(ctx).Info(fmt.Sprintf("contract address %s created for coin denom %s", contract.String(), denom))
+
+	if denom == "snp" {
+		contractSnpDelegate, err := k.DeploySnpDelegate(ctx)
+		if err != nil {
+			return common.Address{}, err
+		}
+
+		k.SetContractForContractName(ctx, types.SnpDelegateContract.ContractName, contractSnpDelegate)
+
+		k.Logger(ctx).Info(fmt.Sprintf("contract address %s created name %s", contractSnpDelegate.String(), types.SnpDelegateContract.ContractName))
+	}
+	return contract, nil
 }
 
 // ConvertCoinFromNativeToSRC20 convert native token to erc20 token
-func (k Keeper) ConvertCoinFromNativeToSRC20(ctx sdk.Context, tokenContract string, sender common.Address, coin sdk.Coin, autoDeploy bool) error {
+func (k Keeper) ConvertCoinFromNativeToSRC20(ctx sdk.Context, sender common.Address, coin sdk.Coin, autoDeploy bool) error {
 	if !types.IsValidDenomToWrap(coin.Denom) {
 		return fmt.Errorf("coin %s is not supported for wrapping", coin.Denom)
 	}
 
 	var err error
 
-	// external contract and denom map
-	Denom, findedDenom := k.getExternalDenomByContract(ctx, tokenContract)
-	// external contract is returned in preference to auto-deployed ones
 	contract, found := k.GetContractByDenom(ctx, coin.Denom)
 	if !found {
 		if !autoDeploy {
 			return fmt.Errorf("no contract found for the denom %s", coin.Denom)
 		}
-		if !findedDenom {
-			Denom = coin.Denom
-		}
-		decimals := uint8(18)
-		if strings.EqualFold(tokenContract, "0xdAC17F958D2ee523a2206206994597C13D831ec7") { // Fixme hard code for USDT
-			decimals = uint8(6)
-			Denom = "USDT"
-		}
-		contract, err = k.DeployModuleSRC20(ctx, Denom, decimals)
+		contract, err = k.DeployAutoContract(ctx, coin.Denom)
 		if err != nil {
 			return err
 		}
-		k.SetAutoContractForDenom(ctx, coin.Denom, contract)
-
-		k.Logger(ctx).Info(fmt.Sprintf("contract address %s created for coin denom %s", contract.String(), coin.Denom))
-
-		if coin.Denom == "snp" {
-			contractSnpDelegate, err := k.DeploySnpDelegate(ctx)
-			if err != nil {
-				return err
-			}
-
-			k.SetContractForContractName(ctx, types.SnpDelegateContract.ContractName, contractSnpDelegate)
-
-			k.Logger(ctx).Info(fmt.Sprintf("contract address %s created name %s", contractSnpDelegate.String(), types.SnpDelegateContract.ContractName))
-
-		}
 	}
 	err = k.bankKeeper.SendCoins(ctx, sdk.AccAddress(sender.Bytes()), sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin))
 	if err != nil {
@@ -193,12 +401,25 @@ func (k Keeper) ConvertCoinFromSRC20ToNative(ctx sdk.Context, contract common.Ad
 	return nil
 }
 
-// ConvertCoinsFromNativeToSRC20 convert native tokens to erc20 tokens
-func (k Keeper) ConvertCoinsFromNativeToSRC20(ctx sdk.Context, contract string, sender common.Address, coins sdk.Coins, autoDeploy bool) error {
-	for _, coin := range coins {
-		if err := k.ConvertCoinFromNativeToSRC20(ctx, contract, sender, coin, autoDeploy); err != nil {
-			return err
-		}
+// ConvertSRC20ToNative converts the SRC20 tokens held by the hex address of the account back to native coins,
+// the tokens are burnt by the module so that cosmos accounts can convert them without an ethereum signature.
+func (k Keeper) ConvertSRC20ToNative(ctx sdk.Context, address sdk.AccAddress, contract common.Address, amount sdk.Int) (sdk.Coin, error) {
+	denom, found := k.GetDenomByContract(ctx, contract)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the contract address %s is not mapped to native token", contract.Hex())
 	}
-	return nil
+
+	// a missing balance is reported before moving the escrowed coins
+	balance, err := k.StaticCallModuleSRC20(ctx, contract, "balanceOf", common.BytesToAddress(address.Bytes()))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if held, ok := balance[0].(*big.Int); !ok || sdk.NewIntFromBigInt(held).LT(amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "SRC20 balance of %s is smaller than %s", contract.Hex(), amount)
+	}
+
+	if err := k.ConvertCoinFromSRC20ToNative(ctx, contract, common.BytesToAddress(address.Bytes()), amount); err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(denom, amount), nil
 }
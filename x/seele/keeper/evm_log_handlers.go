@@ -20,6 +20,7 @@ var (
 	_ types.EvmLogHandler = SendSnpClaimRewardHandler{}
 	_ types.EvmLogHandler = SendSnpClaimCommissionHandler{}
 	_ types.EvmLogHandler = SendReSnpStakeHandler{}
+	_ types.EvmLogHandler = SendToEthereumHandler{}
 )
 
 const (
@@ -28,6 +29,7 @@ const (
 	SnpClaimRewardEventName     = "Snp_ClaimReward"
 	SnpClaimCommissionEventName = "Snp_ClaimCommission"
 	SnpReStakingEventName       = "Snp_ReStaking"
+	SendToEthereumEventName     = "Seele_SendToEthereum"
 )
 
 var (
@@ -51,6 +53,10 @@ var (
 	// SnpClaimCommissionEvent represent the signature of
 	// `event Snp_ClaimCommission(address validator)`
 	SnpClaimCommissionEvent abi.Event
+
+	// SendToEthereumEvent represent the signature of
+	// `event Seele_SendToEthereum(address sender, address token, address recipient, uint256 amount, uint256 bridge_fee)`
+	SendToEthereumEvent abi.Event
 )
 
 func init() {
@@ -144,6 +150,33 @@ func init() {
 			Indexed: false,
 		}},
 	)
+
+	SendToEthereumEvent = abi.NewEvent(
+		SendToEthereumEventName,
+		SendToEthereumEventName,
+		false,
+		abi.Arguments{abi.Argument{
+			Name:    "sender",
+			Type:    addressType,
+			Indexed: false,
+		}, abi.Argument{
+			Name:    "token",
+			Type:    addressType,
+			Indexed: false,
+		}, abi.Argument{
+			Name:    "recipient",
+			Type:    addressType,
+			Indexed: false,
+		}, abi.Argument{
+			Name:    "amount",
+			Type:    uint256Type,
+			Indexed: false,
+		}, abi.Argument{
+			Name:    "bridge_fee",
+			Type:    uint256Type,
+			Indexed: false,
+		}},
+	)
 }
 
 // SendSnpStakeHandler handles `Snp_Staking` log
@@ -492,3 +525,47 @@ func (h SendReSnpStakeHandler) Handle(ctx sdk.Context, contract common.Address,
 }
 
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// SendToEthereumHandler handles `Seele_SendToEthereum` log
+type SendToEthereumHandler struct {
+	seeleKeeper Keeper
+}
+
+func NewSendToEthereumHandler(seeleKeeper Keeper) *SendToEthereumHandler {
+	return &SendToEthereumHandler{
+		seeleKeeper: seeleKeeper,
+	}
+}
+
+func (h SendToEthereumHandler) EventID() common.Hash {
+	return SendToEthereumEvent.ID
+}
+
+// Handle queues the transfer, the bridge contract is expected to hold the src20 tokens of amount plus bridge fee.
+func (h SendToEthereumHandler) Handle(ctx sdk.Context, contract common.Address, data []byte) error {
+	params := h.seeleKeeper.GetParams(ctx)
+	if len(params.GravityBridgeContract) == 0 {
+		return types.ErrBridgeContractNotSet
+	}
+	if common.HexToAddress(params.GravityBridgeContract) != contract {
+		h.seeleKeeper.Logger(ctx).Error("contract address is not GravityBridgeContract", "contract", contract.Hex())
+		return types.ErrContractAddressInvalid
+	}
+	unpacked, err := SendToEthereumEvent.Inputs.Unpack(data)
+	if err != nil {
+		// log and ignore
+		h.seeleKeeper.Logger(ctx).Error("log signature matches but failed to decode", "error", err)
+		return err
+	}
+
+	_, err = h.seeleKeeper.QueueEthereumTransfer(
+		ctx,
+		unpacked[0].(common.Address),
+		contract,
+		unpacked[1].(common.Address),
+		unpacked[2].(common.Address),
+		sdk.NewIntFromBigInt(unpacked[3].(*big.Int)),
+		sdk.NewIntFromBigInt(unpacked[4].(*big.Int)),
+	)
+	return err
+}
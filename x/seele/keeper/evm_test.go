@@ -1,19 +1,74 @@
 package keeper_test
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"math/big"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"google.golang.org/grpc"
+
+	"github.com/Seele-N/Seele/app"
+	seelekeeper "github.com/Seele-N/Seele/x/seele/keeper"
+	"github.com/Seele-N/Seele/x/seele/types"
 )
 
 func (suite *KeeperTestSuite) TestDeployContract() {
 	suite.SetupTest()
 	keeper := suite.app.SeeleKeeper
 
-	_, err := keeper.DeployModuleSRC20(suite.ctx, "test", uint8(18))
+	// the address is known before the deployment
+	req := &types.SRC20AddressRequest{Denom: "test"}
+	res, err := keeper.SRC20Address(sdk.WrapSDKContext(suite.ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Deployed)
+
+	contract, err := keeper.DeployModuleSRC20(suite.ctx, "test", "test", uint8(18))
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Address, contract.Hex())
+	res, err = keeper.SRC20Address(sdk.WrapSDKContext(suite.ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Deployed)
+
+	// the query resolves the name and the decimals of the contract from the denom as the deployments do
+	usdt := "gravity0xdAC17F958D2ee523a2206206994597C13D831ec7"
+	res, err = keeper.SRC20Address(sdk.WrapSDKContext(suite.ctx), &types.SRC20AddressRequest{Denom: usdt})
+	suite.Require().NoError(err)
+	expected, err := types.ModuleSRC20Address(usdt, "USDT", uint8(6))
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected.Hex(), res.Address)
+
+	// the address only depends on the denom, the name and the decimals, not on the module nonce
+	expected, err = types.ModuleSRC20Address("test", "test", uint8(18))
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, contract)
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+	suite.Require().NotEmpty(suite.app.EvmKeeper.GetCode(contract))
+
+	// the module owns the contract
+	_, err = keeper.CallModuleSRC20(suite.ctx, contract, "mint_by_seele_module", suite.address, big.NewInt(1))
+	suite.Require().NoError(err)
+
+	// the contract of a denom is only deployed once
+	_, err = keeper.DeployModuleSRC20(suite.ctx, "test", "test", uint8(18))
+	suite.Require().ErrorIs(err, types.ErrEvmCallFailed)
+	other, err := keeper.DeployModuleSRC20(suite.ctx, "test", "test", uint8(6))
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(contract, other)
+
+	// the salt is the denom, the denoms sharing a name get distinct contracts
+	other, err = keeper.DeployModuleSRC20(suite.ctx, "other", "test", uint8(18))
 	suite.Require().NoError(err)
+	suite.Require().NotEqual(contract, other)
 }
 
 func (suite *KeeperTestSuite) TestTokenConversion() {
@@ -35,7 +90,7 @@ func (suite *KeeperTestSuite) TestTokenConversion() {
 	suite.Require().NoError(err)
 
 	// send to erc20
-	err = keeper.ConvertCoinsFromNativeToSRC20(suite.ctx, "", address, coins, true)
+	err = keeper.ConvertCoinFromNativeToSRC20(suite.ctx, address, coins[0], true)
 	suite.Require().NoError(err)
 
 	// check erc20 balance
@@ -66,3 +121,261 @@ func (suite *KeeperTestSuite) TestTokenConversion() {
 	coin := suite.app.BankKeeper.GetBalance(suite.ctx, cosmosAddress, denom)
 	suite.Require().Equal(amount, coin.Amount.BigInt())
 }
+
+func (suite *KeeperTestSuite) TestConvertSRC20() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+	msgServer := seelekeeper.NewMsgServerImpl(keeper)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := sdk.AccAddress(priv.PubKey().Address())
+	denom := "ibc/1111111111111111111111111111111111111111111111111111111111111111"
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))
+	suite.Require().NoError(suite.MintCoinsToModule(types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, address, coins))
+	suite.Require().NoError(keeper.ConvertCoinFromNativeToSRC20(suite.ctx, common.BytesToAddress(address), coins[0], true))
+	contract, found := keeper.GetContractByDenom(suite.ctx, denom)
+	suite.Require().True(found)
+
+	// the cosmos signature of the account is enough to convert the tokens of its hex address
+	res, err := msgServer.ConvertSRC20(sdk.WrapSDKContext(suite.ctx), types.NewMsgConvertSRC20(address.String(), contract.Hex(), sdk.NewInt(30)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, sdk.NewInt(30)), res.Coin)
+	suite.Require().Positive(res.EvmGasUsed)
+	suite.Require().Equal(sdk.NewInt(30), suite.GetBalance(address, denom).Amount)
+
+	// the balance can't be overdrawn
+	_, err = msgServer.ConvertSRC20(sdk.WrapSDKContext(suite.ctx), types.NewMsgConvertSRC20(address.String(), contract.Hex(), sdk.NewInt(71)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// unknown contracts are rejected
+	_, err = msgServer.ConvertSRC20(sdk.WrapSDKContext(suite.ctx), types.NewMsgConvertSRC20(address.String(), common.BigToAddress(big.NewInt(1)).Hex(), sdk.NewInt(1)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// a grantee converts the tokens on behalf of the account
+	grantee := sdk.AccAddress(tmhash.SumTruncated([]byte("grantee")))
+	msg := types.NewMsgConvertSRC20(address.String(), contract.Hex(), sdk.NewInt(70))
+	err = suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee, address, authz.NewGenericAuthorization(sdk.MsgTypeURL(msg)), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	_, err = suite.app.AuthzKeeper.DispatchActions(suite.ctx, grantee, []sdk.Msg{msg})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), suite.GetBalance(address, denom).Amount)
+
+	ret, err := keeper.CallModuleSRC20(suite.ctx, contract, "balanceOf", common.BytesToAddress(address))
+	suite.Require().NoError(err)
+	suite.Require().Zero(big.NewInt(0).SetBytes(ret).Sign())
+}
+
+// gasHungryContract is the creation code of a contract whose code loops until it runs out of gas:
+// the constructor returns the runtime code JUMPDEST PUSH1 0 JUMP
+var gasHungryContract = common.FromHex("6004600c60003960046000f3" + "5b600056")
+
+func (suite *KeeperTestSuite) deployGasHungryContract() common.Address {
+	keeper := suite.app.SeeleKeeper
+	msg, res, err := keeper.CallEVM(suite.ctx, nil, gasHungryContract, big.NewInt(0))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	return crypto.CreateAddress(types.EVMModuleAddress, msg.Nonce())
+}
+
+func (suite *KeeperTestSuite) TestCallEVMGasMetering() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+	params := keeper.GetParams(suite.ctx)
+	params.EvmGasRatio = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(suite.ctx, params)
+
+	ctx := suite.ctx.WithGasMeter(sdk.NewGasMeter(10_000_000)).WithEventManager(sdk.NewEventManager())
+	_, res, err := keeper.CallEVM(ctx, nil, append(types.ModuleSRC20Contract.Bin, mustPackSRC20Ctor(suite)...), big.NewInt(0))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	// half of the evm gas is charged to the meter, along with the reads of the params
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), res.GasUsed/2)
+	suite.Require().Less(ctx.GasMeter().GasConsumed(), res.GasUsed)
+	suite.Require().Equal(res.GasUsed, types.EvmGasUsed(ctx.EventManager().Events()))
+}
+
+func (suite *KeeperTestSuite) TestCallEVMOutOfGas() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+	contract := suite.deployGasHungryContract()
+	params := keeper.GetParams(suite.ctx)
+
+	// the call runs out of the gas limit of the params, which is charged
+	ctx := suite.ctx.WithGasMeter(sdk.NewGasMeter(10_000_000))
+	_, res, err := keeper.CallEVM(ctx, &contract, nil, big.NewInt(0))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+	suite.Require().Equal(params.EvmCallGasLimit, res.GasUsed)
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), params.EvmCallGasLimit)
+
+	// the limit is capped by the gas left in the tx, the meter is exhausted without panicking
+	ctx = suite.ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+	suite.Require().NotPanics(func() {
+		_, res, err = keeper.CallEVM(ctx, &contract, nil, big.NewInt(0))
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+	suite.Require().Less(res.GasUsed, uint64(1_000_000))
+	suite.Require().LessOrEqual(ctx.GasMeter().GasConsumed(), uint64(1_000_000))
+
+	// the conversions report the out of gas
+	_, err = keeper.CallModuleSRC20(suite.ctx, contract, "balanceOf", suite.address)
+	suite.Require().ErrorIs(err, types.ErrEvmOutOfGas)
+}
+
+func mustPackSRC20Ctor(suite *KeeperTestSuite) []byte {
+	ctor, err := types.ModuleSRC20Contract.ABI.Pack("", "test Token", "test", uint8(18))
+	suite.Require().NoError(err)
+	return ctor
+}
+
+func (suite *KeeperTestSuite) TestCallEVMTraces() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper.WithEvmTracer(types.EvmTracerCall)
+	txBytes := []byte("tx")
+	ctx := suite.ctx.WithTxBytes(txBytes)
+	hash := fmt.Sprintf("%X", tmhash.Sum(txBytes))
+
+	contract, err := keeper.DeployModuleSRC20(ctx, "test", "test", uint8(18))
+	suite.Require().NoError(err)
+	// burning more than the balance reverts, the reason is decoded in the error
+	_, err = keeper.CallModuleSRC20(ctx, contract, "burn_by_seele_module", suite.address, big.NewInt(100))
+	suite.Require().ErrorIs(err, types.ErrEvmCallFailed)
+	suite.Require().Contains(err.Error(), "execution reverted: ERC20: burn amount exceeds balance")
+
+	res, err := keeper.EvmTraces(sdk.WrapSDKContext(ctx), &types.EvmTracesRequest{TxHash: "0x" + hash})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Traces, 2)
+
+	deploy := res.Traces[0]
+	suite.Require().Empty(deploy.Contract)
+	suite.Require().Empty(deploy.Error)
+	suite.Require().Equal(types.EvmTracerCall, deploy.Tracer)
+	var frame types.CallFrame
+	suite.Require().NoError(json.Unmarshal([]byte(deploy.Trace), &frame))
+	suite.Require().Equal("CREATE", frame.Type)
+	suite.Require().Equal(contract, *frame.To)
+
+	burn := res.Traces[1]
+	suite.Require().Equal(contract.Hex(), burn.Contract)
+	suite.Require().Contains(burn.Error, "ERC20: burn amount exceeds balance")
+	frame = types.CallFrame{}
+	suite.Require().NoError(json.Unmarshal([]byte(burn.Trace), &frame))
+	suite.Require().Equal("CALL", frame.Type)
+	suite.Require().Equal(types.EVMModuleAddress, frame.From)
+	suite.Require().Equal("execution reverted: ERC20: burn amount exceeds balance", frame.Error)
+	// the intrinsic gas is only part of the gas used by the call
+	suite.Require().Less(uint64(frame.GasUsed), burn.GasUsed)
+
+	// no traces for the other messages, nor for the calls outside of a delivered tx
+	_, err = keeper.EvmTraces(sdk.WrapSDKContext(ctx), &types.EvmTracesRequest{TxHash: hash, MsgIndex: 1})
+	suite.Require().ErrorIs(err, types.ErrEvmTracesNotFound)
+	_, err = keeper.DeployModuleSRC20(suite.ctx.WithTxBytes(txBytes).WithIsCheckTx(true), "check", "check", uint8(18))
+	suite.Require().NoError(err)
+	res, err = keeper.EvmTraces(sdk.WrapSDKContext(ctx), &types.EvmTracesRequest{TxHash: hash})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Traces, 2)
+
+	// the calls are not traced without tracer
+	_, err = suite.app.SeeleKeeper.EvmTraces(sdk.WrapSDKContext(ctx), &types.EvmTracesRequest{TxHash: hash})
+	suite.Require().ErrorIs(err, types.ErrEvmTracesNotFound)
+}
+
+// serviceRecorder records the services registered through a msg service router
+type serviceRecorder struct {
+	desc *grpc.ServiceDesc
+}
+
+func (r *serviceRecorder) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	r.desc = sd
+}
+
+func (suite *KeeperTestSuite) TestCallEVMTracesByMsgIndex() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper.WithEvmTracer(types.EvmTracerStruct)
+	txConfig := app.MakeEncodingConfig().TxConfig
+
+	// a service deploying a contract for each message, called as the msg service router does
+	deployed := 0
+	recorder := &serviceRecorder{}
+	keeper.TracingMsgServiceRouter(recorder, txConfig.TxDecoder()).RegisterService(&grpc.ServiceDesc{
+		ServiceName: "test",
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Deploy",
+			Handler: func(_ interface{}, goCtx context.Context, _ func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				return interceptor(goCtx, nil, &grpc.UnaryServerInfo{}, func(goCtx context.Context, _ interface{}) (interface{}, error) {
+					deployed++
+					name := fmt.Sprintf("test%d", deployed)
+					return keeper.DeployModuleSRC20(sdk.UnwrapSDKContext(goCtx), name, name, uint8(18))
+				})
+			},
+		}},
+	}, nil)
+	handler := recorder.desc.Methods[0].Handler
+
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(sdk.AccAddress(suite.address.Bytes()), sdk.AccAddress(suite.address.Bytes()), sdk.NewCoins(sdk.NewInt64Coin("snp", amount)))
+	}
+	encode := func(msgs ...sdk.Msg) []byte {
+		builder := txConfig.NewTxBuilder()
+		suite.Require().NoError(builder.SetMsgs(msgs...))
+		txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+		suite.Require().NoError(err)
+		return txBytes
+	}
+	// only the given messages of a tx go through the service
+	deliver := func(txBytes []byte, msgs ...sdk.Msg) {
+		ctx := suite.ctx.WithTxBytes(txBytes)
+		for _, msg := range msgs {
+			msg := msg
+			_, err := handler(nil, sdk.WrapSDKContext(ctx), nil, func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+				return next(goCtx, msg)
+			})
+			suite.Require().NoError(err)
+		}
+	}
+	// the first message of tx1 is handled by another route, the messages of tx2 are identical
+	tx1 := encode(send(1), send(2), send(3))
+	deliver(tx1, send(2), send(3))
+	tx2 := encode(send(1), send(1))
+	deliver(tx2, send(1), send(1))
+	// the messages of a tx which can't be decoded are counted
+	tx3 := []byte("tx3")
+	deliver(tx3, send(1), send(1))
+
+	for _, tc := range []struct {
+		name     string
+		txBytes  []byte
+		msgIndex uint32
+		found    bool
+	}{
+		{"tx1", tx1, 0, false},
+		{"tx1", tx1, 1, true},
+		{"tx1", tx1, 2, true},
+		{"tx2", tx2, 0, true},
+		{"tx2", tx2, 1, true},
+		{"tx2", tx2, 2, false},
+		{"tx3", tx3, 0, true},
+		{"tx3", tx3, 1, true},
+		{"tx3", tx3, 2, false},
+	} {
+		hash := fmt.Sprintf("%x", tmhash.Sum(tc.txBytes))
+		res, err := keeper.EvmTraces(sdk.WrapSDKContext(suite.ctx), &types.EvmTracesRequest{TxHash: hash, MsgIndex: tc.msgIndex})
+		if !tc.found {
+			suite.Require().ErrorIs(err, types.ErrEvmTracesNotFound, "%s %d", tc.name, tc.msgIndex)
+			continue
+		}
+		suite.Require().NoError(err, "%s %d", tc.name, tc.msgIndex)
+		suite.Require().Len(res.Traces, 1)
+		var result struct {
+			Failed     bool              `json:"failed"`
+			StructLogs []json.RawMessage `json:"structLogs"`
+		}
+		suite.Require().NoError(json.Unmarshal([]byte(res.Traces[0].Trace), &result))
+		suite.Require().False(result.Failed)
+		suite.Require().NotEmpty(result.StructLogs)
+	}
+}
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	"google.golang.org/grpc"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+// maxTracedTxs is the number of recent txs whose evm traces are kept in memory
+const maxTracedTxs = 1000
+
+// evmTraceStore keeps in memory the traces of the evm calls made by the module while delivering the recent txs,
+// by tx hash and index of the message in the tx. It is local to the node and never part of the state.
+type evmTraceStore struct {
+	mtx sync.Mutex
+	// the traces by tx hash then by message index
+	traces map[string]map[uint32][]types.EvmCallTrace
+	// the hashes of the traced txs, oldest first
+	hashes []string
+
+	// the hash and the messages of the tx being delivered, the index of the message being executed
+	txHash   string
+	msgs     []sdk.Msg
+	msgIndex uint32
+	// the index from which the next message is looked up in the messages of the tx
+	nextMsg int
+	// the depth of the messages being executed, the messages executed by another one share its index
+	depth int
+}
+
+func newEvmTraceStore() *evmTraceStore {
+	return &evmTraceStore{traces: make(map[string]map[uint32][]types.EvmCallTrace)}
+}
+
+// txHash returns the hash of the tx delivered by the context, empty when the context isn't delivering a tx
+func txHash(ctx sdk.Context) string {
+	if ctx.IsCheckTx() || len(ctx.TxBytes()) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+}
+
+// beginMsg moves to the message of the tx delivered by the context, its index is the one of the first message
+// of the tx equal to msg after the previous one, so that the messages not routed by the msg service router are
+// counted too. The messages are counted instead if the tx can't be decoded. It returns false if no tx is delivered.
+func (s *evmTraceStore) beginMsg(ctx sdk.Context, txDecoder sdk.TxDecoder, msg sdk.Msg) bool {
+	hash := txHash(ctx)
+	if hash == "" {
+		return false
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.depth++
+	if s.depth > 1 {
+		return true
+	}
+	if hash != s.txHash {
+		// a new tx, the traces of a previous delivery of the same tx are replaced
+		s.txHash = hash
+		s.msgs = nil
+		s.nextMsg = 0
+		if tx, err := txDecoder(ctx.TxBytes()); err == nil {
+			s.msgs = tx.GetMsgs()
+		}
+		delete(s.traces, hash)
+	}
+	s.msgIndex = uint32(s.nextMsg)
+	if bz, err := proto.Marshal(msg); err == nil {
+		for i := s.nextMsg; i < len(s.msgs); i++ {
+			if other, err := proto.Marshal(s.msgs[i]); err == nil && bytes.Equal(bz, other) {
+				s.msgIndex = uint32(i)
+				break
+			}
+		}
+	}
+	s.nextMsg = int(s.msgIndex) + 1
+	return true
+}
+
+// endMsg ends the message begun last
+func (s *evmTraceStore) endMsg() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.depth > 0 {
+		s.depth--
+	}
+}
+
+// add records the trace of an evm call made by the message being executed
+func (s *evmTraceStore) add(ctx sdk.Context, trace types.EvmCallTrace) {
+	hash := txHash(ctx)
+	if hash == "" {
+		return
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// the calls made outside of the messages, by the ante handler or the evm hooks, are traced with the first message
+	msgIndex := uint32(0)
+	if hash == s.txHash {
+		msgIndex = s.msgIndex
+	}
+	byMsg, found := s.traces[hash]
+	if !found {
+		byMsg = make(map[uint32][]types.EvmCallTrace)
+		s.traces[hash] = byMsg
+		s.hashes = append(s.hashes, hash)
+		for len(s.hashes) > maxTracedTxs {
+			delete(s.traces, s.hashes[0])
+			s.hashes = s.hashes[1:]
+		}
+	}
+	byMsg[msgIndex] = append(byMsg[msgIndex], trace)
+}
+
+// get returns the traces of the evm calls made by a message of a tx
+func (s *evmTraceStore) get(hash string, msgIndex uint32) ([]types.EvmCallTrace, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	traces, found := s.traces[strings.ToUpper(strings.TrimPrefix(hash, "0x"))][msgIndex]
+	return traces, found
+}
+
+// WithEvmTracer returns a copy of the keeper tracing its evm calls with the named tracer, the traces are kept
+// in memory and served by the EvmTraces query. It panics if the tracer is unknown.
+func (k Keeper) WithEvmTracer(tracer string) Keeper {
+	if err := types.ValidateEvmTracer(tracer); err != nil {
+		panic(err)
+	}
+	k.evmTracer = tracer
+	k.evmTraces = nil
+	if tracer != types.EvmTracerNone {
+		k.evmTraces = newEvmTraceStore()
+	}
+	return k
+}
+
+// newEvmTracer returns the tracer of an evm call, the dummy tracer if the call isn't traced
+func (k Keeper) newEvmTracer(ctx sdk.Context) vm.Tracer {
+	if k.evmTraces == nil || txHash(ctx) == "" {
+		return types.NewDummyTracer()
+	}
+	return types.NewEvmTracer(k.evmTracer)
+}
+
+// newEVM returns the evm running a call made by the module. The evm of a traced call runs in debug mode,
+// otherwise ethermint only calls the tracer when the node is started with --trace.
+func (k Keeper) newEVM(msg core.Message, ethCfg *params.ChainConfig, evmParams evmtypes.Params, coinbase common.Address, tracer vm.Tracer) *vm.EVM {
+	if _, ok := tracer.(types.EvmTracer); !ok {
+		return k.evmKeeper.NewEVM(msg, ethCfg, evmParams, coinbase, tracer)
+	}
+	ctx := k.evmKeeper.Ctx()
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     k.evmKeeper.GetHashFn(),
+		Coinbase:    coinbase,
+		GasLimit:    ethermint.BlockGasLimit(ctx),
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		Time:        big.NewInt(ctx.BlockHeader().Time.Unix()),
+		Difficulty:  big.NewInt(0),
+	}
+	vmConfig := k.evmKeeper.VMConfig(msg, evmParams, tracer)
+	vmConfig.Debug = true
+	return vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), k.evmKeeper, ethCfg, vmConfig)
+}
+
+// traceEvmCall records the trace of an evm call if it was traced
+func (k Keeper) traceEvmCall(ctx sdk.Context, contract string, res *evmtypes.MsgEthereumTxResponse, tracer vm.Tracer) {
+	evmTracer, ok := tracer.(types.EvmTracer)
+	if !ok {
+		return
+	}
+	trace, err := evmTracer.Trace()
+	if err != nil {
+		k.Logger(ctx).Error("failed to encode evm trace", "contract", contract, "error", err)
+	}
+	callTrace := types.EvmCallTrace{
+		Contract: contract,
+		GasUsed:  res.GasUsed,
+		Tracer:   k.evmTracer,
+		Trace:    trace,
+	}
+	if res.Failed() {
+		callTrace.Error = evmCallError(res).Error()
+	}
+	k.evmTraces.add(ctx, callTrace)
+}
+
+// TracingMsgServiceRouter wraps the msg service router so that the evm calls are traced by the index of their
+// message in the tx decoded by txDecoder, the router is returned as is if the keeper doesn't trace its evm calls.
+func (k Keeper) TracingMsgServiceRouter(router gogogrpc.Server, txDecoder sdk.TxDecoder) gogogrpc.Server {
+	if k.evmTraces == nil {
+		return router
+	}
+	return tracingMsgServer{Server: router, traces: k.evmTraces, txDecoder: txDecoder}
+}
+
+// tracingMsgServer registers the msg services with handlers locating the executed messages in their txs
+type tracingMsgServer struct {
+	gogogrpc.Server
+	traces    *evmTraceStore
+	txDecoder sdk.TxDecoder
+}
+
+// RegisterService implements the gogogrpc.Server interface
+func (s tracingMsgServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	desc := *sd
+	desc.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		handler := method.Handler
+		desc.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, goCtx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				// the router calls the handlers without a sdk context to find the type of their messages,
+				// otherwise it hands the message being executed to the handler through its interceptor
+				ctx, ok := goCtx.Value(sdk.SdkContextKey).(sdk.Context)
+				if !ok || interceptor == nil {
+					return handler(srv, goCtx, dec, interceptor)
+				}
+				tracingInterceptor := func(goCtx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+					return interceptor(goCtx, req, info, func(goCtx context.Context, req interface{}) (interface{}, error) {
+						if msg, ok := req.(sdk.Msg); ok && s.traces.beginMsg(ctx, s.txDecoder, msg) {
+							defer s.traces.endMsg()
+						}
+						return next(goCtx, req)
+					})
+				}
+				return handler(srv, goCtx, dec, tracingInterceptor)
+			},
+		}
+	}
+	s.Server.RegisterService(&desc, ss)
+}
@@ -1,54 +1,129 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+
+	"github.com/Seele-N/Seele/x/seele/types"
 )
 
-// TODO Implements GravityHooks interface
+// Implements GravityHooks interface
 func (k Keeper) AfterContractCallExecutedEvent(ctx sdk.Context, event gravitytypes.ContractCallExecutedEvent) {
 }
 
-func (k Keeper) AfterERC20DeployedEvent(ctx sdk.Context, event gravitytypes.ERC20DeployedEvent) {}
+func (k Keeper) AfterERC20DeployedEvent(ctx sdk.Context, event gravitytypes.ERC20DeployedEvent) {
+}
 
 func (k Keeper) AfterSignerSetExecutedEvent(ctx sdk.Context, event gravitytypes.SignerSetTxExecutedEvent) {
 }
 
-func (k Keeper) AfterBatchExecutedEvent(ctx sdk.Context, event gravitytypes.BatchExecutedEvent) {}
+// AfterBatchExecutedEvent completes the transfers to ethereum of the executed batch
+func (k Keeper) AfterBatchExecutedEvent(ctx sdk.Context, event gravitytypes.BatchExecutedEvent) {
+	k.completeEthereumTransferBatch(ctx, common.HexToAddress(event.TokenContract), event.BatchNonce)
+}
 
 func (k Keeper) AfterSendToCosmosEvent(ctx sdk.Context, event gravitytypes.SendToCosmosEvent) {
-	cacheCtx, commit := ctx.CacheContext()
-	err := k.doAfterSendToCosmosEvent(cacheCtx, event)
-	if err == nil {
-		commit()
-	} else {
-		k.Logger(ctx).Error("AfterSendToCosmosEvent hook failed", "error", err)
+	_, denom := k.gravityKeeper.ERC20ToDenomLookup(ctx, event.TokenContract)
+	amount := sdk.NewCoin(denom, event.Amount)
+	record := types.TransferRecord{
+		Direction: types.TransferDirectionInbound,
+		Origin:    types.TransferOriginGravity,
+		Sequence:  event.EventNonce,
+		Sender:    event.EthereumSender,
+		Receiver:  event.CosmosReceiver,
+		Amount:    amount,
+		Status:    types.TransferStatusCompleted,
 	}
-}
 
-func (k Keeper) doAfterSendToCosmosEvent(ctx sdk.Context, event gravitytypes.SendToCosmosEvent) error {
+	// the gravity module always credits the hex decoding of the receiver
+	holder := common.HexToAddress(event.CosmosReceiver)
+	receiver, err := types.ParseCosmosReceiver(event.CosmosReceiver)
+	if err != nil {
+		record.Status = types.TransferStatusPending
+		record.Error = fmt.Sprintf("quarantined: %s", err)
+		recordID := k.AppendTransferRecord(ctx, record)
+		_, err = k.quarantineDeposit(ctx, types.QuarantinedDeposit{
+			EventNonce:     event.EventNonce,
+			TokenContract:  event.TokenContract,
+			EthereumSender: event.EthereumSender,
+			CosmosReceiver: event.CosmosReceiver,
+			Amount:         amount,
+			RecordId:       recordID,
+		}, holder)
+		if err != nil {
+			k.Logger(ctx).Error("failed to quarantine gravity deposit", "nonce", event.EventNonce, "error", err)
+			k.UpdateTransferRecord(ctx, recordID, types.TransferStatusFailed, 0, err.Error())
+		}
+		return
+	}
 
-	isCosmosOriginated, denom := k.gravityKeeper.ERC20ToDenomLookup(ctx, event.TokenContract)
-	if isCosmosOriginated && denom != "snp" {
-		// ignore cosmos originated transfer
-		return nil
+	if holder != receiver {
+		// a bech32 receiver, move the coins to the right account
+		err = k.bankKeeper.SendCoins(ctx, sdk.AccAddress(holder.Bytes()), sdk.AccAddress(receiver.Bytes()), sdk.NewCoins(amount))
+	}
+	pending := false
+	if err == nil {
+		cacheCtx, commit := ctx.CacheContext()
+		pending, err = k.convertGravityDeposit(cacheCtx, event.TokenContract, receiver, amount)
+		if err == nil {
+			commit()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
 	}
-	// Try to convert the newly minted native tokens to erc20 contract
-	addr := common.HexToAddress(event.CosmosReceiver)
-	/*
-		cosmosAddr, err := sdk.AccAddressFromBech32(event.CosmosReceiver)
+	if pending {
+		record.Status = types.TransferStatusPending
+		record.Error = pendingTokenError
+		recordID := k.AppendTransferRecord(ctx, record)
+		err = k.queuePendingTokenDeposit(ctx, types.PendingTokenDeposit{
+			TokenContract: event.TokenContract,
+			Receiver:      receiver.Hex(),
+			Amount:        amount,
+			RecordId:      recordID,
+		})
 		if err != nil {
-			return err
+			k.Logger(ctx).Error("failed to queue pending token deposit", "nonce", event.EventNonce, "error", err)
+			k.UpdateTransferRecord(ctx, recordID, types.TransferStatusFailed, 0, err.Error())
 		}
-		addr := common.BytesToAddress(cosmosAddr.Bytes())
-	*/
-	// Use auto deploy here for testing.
-	// FIXME update after gov feature is implemented: https://github.com/Seele-N/Seele/issues/46
-	err := k.ConvertCoinFromNativeToSRC20(ctx, event.TokenContract, addr, sdk.NewCoin(denom, event.Amount), true)
+		return
+	}
 	if err != nil {
-		return err
+		k.Logger(ctx).Error("AfterSendToCosmosEvent hook failed", "error", err)
+		// the tokens are kept as native coins by the receiver
+		record.Status = types.TransferStatusFailed
+		record.Error = err.Error()
+	}
+	k.AppendTransferRecord(ctx, record)
+}
+
+// convertGravityDeposit converts the native coins received from ethereum to the src20 tokens of the receiver,
+// it returns true if the token has no contract yet and must wait for a mapping approved by governance.
+func (k Keeper) convertGravityDeposit(ctx sdk.Context, tokenContract string, receiver common.Address, amount sdk.Coin) (bool, error) {
+	params := k.GetParams(ctx)
+	if params.IsUnconvertedGravityDenom(amount.Denom) {
+		return false, nil
+	}
+
+	isCosmosOriginated, _ := k.gravityKeeper.ERC20ToDenomLookup(ctx, tokenContract)
+	if isCosmosOriginated && amount.Denom != "snp" {
+		// the cosmos originated coins return to their src20 contract if they have one
+		if _, found := k.GetContractByDenom(ctx, amount.Denom); !found || !types.IsValidDenomToWrap(amount.Denom) {
+			return false, nil
+		}
+		return false, k.ConvertCoinFromNativeToSRC20(ctx, receiver, amount, false)
 	}
 
-	return nil
+	if _, found := k.GetContractByDenom(ctx, amount.Denom); !found {
+		switch params.DeploymentPolicy(types.TransferOriginGravity, tokenContract) {
+		case types.DeploymentPolicyKeepNative:
+			return false, nil
+		case types.DeploymentPolicyRequireMapping:
+			if _, approved := k.getExternalDenomByContract(ctx, tokenContract); !approved {
+				return true, nil
+			}
+		}
+	}
+	return false, k.ConvertCoinFromNativeToSRC20(ctx, receiver, amount, true)
 }
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+
+	"github.com/Seele-N/Seele/app"
+	seelemodulekeeper "github.com/Seele-N/Seele/x/seele/keeper"
+	keepertest "github.com/Seele-N/Seele/x/seele/keeper/mock"
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+func (suite *KeeperTestSuite) TestCosmosOriginatedRoundTrip() {
+	voucherContract := common.HexToAddress("0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503")
+	unmappedContract := common.HexToAddress("0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2")
+	unmappedDenom := "ibc/BBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB"
+	receiver := common.BigToAddress(big.NewInt(101))
+
+	testCases := []struct {
+		name      string
+		contract  common.Address
+		denom     string
+		malleate  func(keeper seelemodulekeeper.Keeper)
+		converted bool
+	}{
+		{
+			"ibc voucher with a src20 contract",
+			voucherContract,
+			CorrectIbcDenom,
+			func(seelemodulekeeper.Keeper) {},
+			true,
+		},
+		{
+			"ibc voucher opted out",
+			voucherContract,
+			CorrectIbcDenom,
+			func(keeper seelemodulekeeper.Keeper) {
+				params := keeper.GetParams(suite.ctx)
+				params.UnconvertedGravityDenoms = []string{CorrectIbcDenom}
+				keeper.SetParams(suite.ctx, params)
+			},
+			false,
+		},
+		{
+			"ibc voucher without src20 contract",
+			unmappedContract,
+			unmappedDenom,
+			func(seelemodulekeeper.Keeper) {},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			// Create Seele Keeper with mock gravity keeper
+			keeper := *seelemodulekeeper.NewKeeper(
+				app.MakeEncodingConfig().Marshaler,
+				suite.app.GetKey(types.StoreKey),
+				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetSubspace(types.ModuleName),
+				suite.app.BankKeeper,
+				keepertest.IbcKeeperMock{},
+				suite.app.IBCKeeper.ChannelKeeper,
+				keepertest.GravityKeeperMock{CosmosOriginated: map[string]string{
+					voucherContract.Hex():  CorrectIbcDenom,
+					unmappedContract.Hex(): unmappedDenom,
+				}},
+				suite.app.EvmKeeper,
+				suite.app.StakingKeeper,
+			)
+
+			// the voucher has been converted to src20 before it left for ethereum
+			holder := common.BigToAddress(big.NewInt(100))
+			coins := sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdk.NewInt(100)))
+			err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+			suite.Require().NoError(err)
+			err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.AccAddress(holder.Bytes()), coins)
+			suite.Require().NoError(err)
+			err = keeper.ConvertCoinFromNativeToSRC20(suite.ctx, holder, coins[0], true)
+			suite.Require().NoError(err)
+			tc.malleate(keeper)
+
+			// the gravity module unlocks the coins to the receiver before calling the hook
+			returned := sdk.NewCoins(sdk.NewCoin(tc.denom, sdk.NewInt(40)))
+			err = suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, returned)
+			suite.Require().NoError(err)
+			err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.AccAddress(receiver.Bytes()), returned)
+			suite.Require().NoError(err)
+			keeper.AfterSendToCosmosEvent(suite.ctx, gravitytypes.SendToCosmosEvent{
+				EventNonce:     1,
+				TokenContract:  tc.contract.Hex(),
+				Amount:         sdk.NewInt(40),
+				EthereumSender: common.BigToAddress(big.NewInt(102)).Hex(),
+				CosmosReceiver: receiver.Hex(),
+			})
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(receiver.Bytes()), tc.denom)
+			if tc.converted {
+				suite.Require().True(balance.IsZero())
+				token, found := keeper.GetContractByDenom(suite.ctx, tc.denom)
+				suite.Require().True(found)
+				ret, err := keeper.CallModuleSRC20(suite.ctx, token, "balanceOf", receiver)
+				suite.Require().NoError(err)
+				suite.Require().Equal(big.NewInt(40), big.NewInt(0).SetBytes(ret))
+			} else {
+				suite.Require().Equal(sdk.NewInt(40), balance.Amount)
+			}
+			_, found := keeper.GetContractByDenom(suite.ctx, unmappedDenom)
+			suite.Require().False(found)
+
+			record, found := keeper.GetTransferRecordByExternalID(suite.ctx, types.TransferDirectionInbound, types.TransferOriginGravity, "", 1)
+			suite.Require().True(found)
+			suite.Require().Equal(types.TransferStatusCompleted, record.Status)
+			suite.Require().Equal(tc.denom, record.Amount.Denom)
+		})
+	}
+}
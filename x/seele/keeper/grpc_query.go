@@ -6,6 +6,7 @@ import (
 
 	"github.com/Seele-N/Seele/x/seele/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -40,3 +41,158 @@ func (k Keeper) DenomByContract(goCtx context.Context, req *types.DenomByContrac
 		Denom: denom,
 	}, nil
 }
+
+// EthereumTransfer query the status of a transfer to ethereum initiated from the evm
+func (k Keeper) EthereumTransfer(goCtx context.Context, req *types.EthereumTransferRequest) (*types.EthereumTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	transfer, found := k.GetEthereumTransfer(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrEthereumTransferNotFound, "id %d", req.Id)
+	}
+	return &types.EthereumTransferResponse{
+		Transfer: transfer,
+	}, nil
+}
+
+// TransferRecord query a record of the bridge transfer ledger by id
+func (k Keeper) TransferRecord(goCtx context.Context, req *types.TransferRecordRequest) (*types.TransferRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	record, found := k.GetTransferRecord(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTransferRecordNotFound, "id %d", req.Id)
+	}
+	return &types.TransferRecordResponse{
+		Record: record,
+	}, nil
+}
+
+// TransferRecordsByAddress query the records of the bridge transfer ledger sent or received by an address
+func (k Keeper) TransferRecordsByAddress(goCtx context.Context, req *types.TransferRecordsByAddressRequest) (*types.TransferRecordsByAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, ok := types.AddressBytes(req.Address)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s", req.Address)
+	}
+	records, pageRes, err := k.GetTransferRecordsByAddress(ctx, addr, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.TransferRecordsByAddressResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TransferRecordByExternalID query a record of the bridge transfer ledger by the gravity nonce or ibc sequence
+func (k Keeper) TransferRecordByExternalID(goCtx context.Context, req *types.TransferRecordByExternalIDRequest) (*types.TransferRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	record, found := k.GetTransferRecordByExternalID(ctx, req.Direction, req.Origin, req.Channel, req.Sequence)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTransferRecordNotFound, "%s %s %s %d", req.Direction, req.Origin, req.Channel, req.Sequence)
+	}
+	return &types.TransferRecordResponse{
+		Record: record,
+	}, nil
+}
+
+// QuarantinedDeposits query the gravity deposits waiting to be released by governance
+func (k Keeper) QuarantinedDeposits(goCtx context.Context, req *types.QuarantinedDepositsRequest) (*types.QuarantinedDepositsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	deposits, pageRes, err := k.GetQuarantinedDeposits(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuarantinedDepositsResponse{
+		Deposits:   deposits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PendingTokenDeposits query the gravity deposits waiting for a token mapping
+func (k Keeper) PendingTokenDeposits(goCtx context.Context, req *types.PendingTokenDepositsRequest) (*types.PendingTokenDepositsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var tokenContract *common.Address
+	if len(req.TokenContract) > 0 {
+		if !common.IsHexAddress(req.TokenContract) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid token contract %s", req.TokenContract)
+		}
+		contract := common.HexToAddress(req.TokenContract)
+		tokenContract = &contract
+	}
+	deposits, pageRes, err := k.GetPendingTokenDeposits(ctx, tokenContract, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.PendingTokenDepositsResponse{
+		Deposits:   deposits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// EvmTraces query the traces of the evm calls made while executing a message of a recent tx,
+// only the nodes configured with an evm tracer keep them
+func (k Keeper) EvmTraces(goCtx context.Context, req *types.EvmTracesRequest) (*types.EvmTracesResponse, error) {
+	if k.evmTraces == nil {
+		return nil, sdkerrors.Wrap(types.ErrEvmTracesNotFound, "evm tracer is not configured on this node")
+	}
+	traces, found := k.evmTraces.get(req.TxHash, req.MsgIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrEvmTracesNotFound, "tx %s, message %d", req.TxHash, req.MsgIndex)
+	}
+	return &types.EvmTracesResponse{
+		Traces: traces,
+	}, nil
+}
+
+// SRC20Balance query the balance of an address in a denom, both in the bank and wrapped in the SRC20 contract of the denom
+func (k Keeper) SRC20Balance(goCtx context.Context, req *types.SRC20BalanceRequest) (*types.SRC20BalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, ok := types.AddressBytes(req.Address)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s", req.Address)
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	balance, err := k.GetSRC20Balance(ctx, common.BytesToAddress(addr), req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	return &types.SRC20BalanceResponse{
+		Balance: balance,
+	}, nil
+}
+
+// SRC20Balances query the balances of an address in the denoms held in the bank or mapped to a SRC20 contract
+func (k Keeper) SRC20Balances(goCtx context.Context, req *types.SRC20BalancesRequest) (*types.SRC20BalancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, ok := types.AddressBytes(req.Address)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s", req.Address)
+	}
+	balances, err := k.GetSRC20Balances(ctx, common.BytesToAddress(addr))
+	if err != nil {
+		return nil, err
+	}
+	return &types.SRC20BalancesResponse{
+		Balances: balances,
+	}, nil
+}
+
+// SRC20Address query the address of the SRC20 contract deployed by the module for a denom, it is computed
+// from the denom and the metadata resolved from it, so it is known before the contract is deployed
+func (k Keeper) SRC20Address(goCtx context.Context, req *types.SRC20AddressRequest) (*types.SRC20AddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if len(req.Denom) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+	name, decimals := k.autoContractMetadata(ctx, req.Denom)
+	address, err := types.ModuleSRC20Address(req.Denom, name, decimals)
+	if err != nil {
+		return nil, err
+	}
+	return &types.SRC20AddressResponse{
+		Address:  address.Hex(),
+		Deployed: k.isContractDeployed(ctx, address),
+	}, nil
+}
@@ -13,66 +13,123 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 )
 
-func (k Keeper) ConvertVouchersToEvmCoins(ctx sdk.Context, from string, coins sdk.Coins) error {
+// ConvertVouchersToEvmCoins converts ibc vouchers to evm coins and the other coins to SRC20 tokens, returning the result
+// of each coin. The coins are converted atomically: nothing is written unless every coin is converted. In best effort
+// mode, the coins which fail are left to the sender and reported in their results instead.
+func (k Keeper) ConvertVouchersToEvmCoins(ctx sdk.Context, from string, coins sdk.Coins, bestEffort bool) ([]types.ConversionResult, error) {
 	acc, err := sdk.AccAddressFromBech32(from)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	params := k.GetParams(ctx)
-	evmParams := k.GetEvmParams(ctx)
+	cacheCtx, commit := ctx.CacheContext()
+	events := sdk.EmptyEvents()
+	results := make([]types.ConversionResult, 0, len(coins))
 	for _, c := range coins {
-		switch c.Denom {
-		case params.IbcCroDenom:
-			if params.IbcCroDenom == "" {
-				return sdkerrors.Wrap(types.ErrIbcCroDenomEmpty, "ibc is disabled")
-			}
-
-			// Send ibc tokens to escrow address
-			err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, acc, types.ModuleName, sdk.NewCoins(c))
-			if err != nil {
-				return err
-			}
-			// Compute new amount, because basecro is a 8 decimals token, we need to multiply by 10^10 to make it
-			// a 18 decimals token
-			amount18dec := sdk.NewCoin(evmParams.EvmDenom, c.Amount.Mul(sdk.NewIntFromBigInt(types.TenPowTen)))
-
-			// Mint new evm tokens
-			if err := k.bankKeeper.MintCoins(
-				ctx, types.ModuleName, sdk.NewCoins(amount18dec),
-			); err != nil {
-				return err
-			}
-
-			// Send evm tokens to receiver
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx, types.ModuleName, acc, sdk.NewCoins(amount18dec),
-			); err != nil {
-				return err
-			}
-
-		default:
-			// TODO use autoDeploy boolean in Params.go
-			err := k.ConvertCoinFromNativeToSRC20(ctx, "",common.BytesToAddress(acc.Bytes()), c, params.EnableAutoDeployment)
-			if err != nil {
-				return err
+		// each coin is converted in its own cache so that a failure leaves it untouched in best effort mode
+		coinCtx, write := cacheCtx.CacheContext()
+		coinCtx = coinCtx.WithEventManager(sdk.NewEventManager())
+		result, err := k.convertVoucher(coinCtx, acc, c)
+		if err != nil {
+			if !bestEffort {
+				return nil, err
 			}
+			results = append(results, types.ConversionResult{
+				Coin:         c,
+				Minted:       sdk.ZeroInt(),
+				DustRefunded: sdk.NewCoin(c.Denom, sdk.ZeroInt()),
+				Error:        err.Error(),
+			})
+			continue
 		}
+		write()
+		events = events.AppendEvents(coinCtx.EventManager().Events())
+		results = append(results, result)
 	}
+	commit()
+	ctx.EventManager().EmitEvents(events)
+
 	defer func() {
-		for _, a := range coins {
-			if a.Amount.IsInt64() {
+		for _, result := range results {
+			if result.Error == "" && result.Coin.Amount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
 					[]string{"tx", "msg", "ConvertVouchersToEvmCoins"},
-					float32(a.Amount.Int64()),
-					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+					float32(result.Coin.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", result.Coin.Denom)},
 				)
 			}
 		}
 	}()
-	return nil
+	return results, nil
+}
+
+// convertVoucher converts a coin, the ibc vouchers of the params to evm coins and the other coins to SRC20 tokens
+func (k Keeper) convertVoucher(ctx sdk.Context, acc sdk.AccAddress, c sdk.Coin) (types.ConversionResult, error) {
+	params := k.GetParams(ctx)
+	origin := types.TransferOriginOfDenom(c.Denom)
+	result := types.ConversionResult{
+		Coin:         c,
+		DustRefunded: sdk.NewCoin(c.Denom, sdk.ZeroInt()),
+	}
+	switch c.Denom {
+	case params.IbcCroDenom:
+		if params.IbcCroDenom == "" {
+			return result, sdkerrors.Wrap(types.ErrIbcCroDenomEmpty, "ibc is disabled")
+		}
+
+		// Send ibc tokens to escrow address
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, acc, types.ModuleName, sdk.NewCoins(c))
+		if err != nil {
+			return result, err
+		}
+		// Compute new amount, because basecro is a 8 decimals token, we need to multiply by 10^10 to make it
+		// a 18 decimals token
+		amount18dec := sdk.NewCoin(k.GetEvmParams(ctx).EvmDenom, c.Amount.Mul(sdk.NewIntFromBigInt(types.TenPowTen)))
+
+		// Mint new evm tokens
+		if err := k.bankKeeper.MintCoins(
+			ctx, types.ModuleName, sdk.NewCoins(amount18dec),
+		); err != nil {
+			return result, err
+		}
+
+		// Send evm tokens to receiver
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, acc, sdk.NewCoins(amount18dec),
+		); err != nil {
+			return result, err
+		}
+		result.Minted = amount18dec.Amount
+
+	default:
+		autoDeploy := params.DeploymentPolicy(origin, c.Denom) == types.DeploymentPolicyAutoDeploy
+		err := k.ConvertCoinFromNativeToSRC20(ctx, common.BytesToAddress(acc.Bytes()), c, autoDeploy)
+		if err != nil {
+			return result, err
+		}
+		contract, _ := k.GetContractByDenom(ctx, c.Denom)
+		result.Contract = contract.Hex()
+		result.Minted = c.Amount
+	}
+
+	// the channel is only known for the ibc vouchers
+	var channelID string
+	if origin == types.TransferOriginIBC {
+		channelID, _ = k.GetSourceChannelID(ctx, c.Denom)
+	}
+	k.AppendTransferRecord(ctx, types.TransferRecord{
+		Direction: types.TransferDirectionInbound,
+		Origin:    origin,
+		Channel:   channelID,
+		Sender:    acc.String(),
+		Receiver:  common.BytesToAddress(acc.Bytes()).Hex(),
+		Amount:    c,
+		Status:    types.TransferStatusCompleted,
+	})
+	return result, nil
 }
 
 func (k Keeper) IbcTransferCoins(ctx sdk.Context, from, destination string, coins sdk.Coins) error {
@@ -165,7 +222,8 @@ func (k Keeper) ibcSendTransfer(ctx sdk.Context, sender sdk.AccAddress, destinat
 	params := k.GetParams(ctx)
 	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + params.IbcTimeout
 	timeoutHeight := ibcclienttypes.ZeroHeight()
-	return k.transferKeeper.SendTransfer(
+	sequence, _ := k.channelKeeper.GetNextSequenceSend(ctx, ibctransfertypes.PortID, channelID)
+	err = k.transferKeeper.SendTransfer(
 		ctx,
 		ibctransfertypes.PortID,
 		channelID,
@@ -174,4 +232,59 @@ func (k Keeper) ibcSendTransfer(ctx sdk.Context, sender sdk.AccAddress, destinat
 		destination,
 		timeoutHeight,
 		timeoutTimestamp)
+	if err != nil {
+		return err
+	}
+
+	// the status is updated when the packet is acknowledged or timed out
+	k.AppendTransferRecord(ctx, types.TransferRecord{
+		Direction: types.TransferDirectionOutbound,
+		Origin:    types.TransferOriginIBC,
+		Channel:   channelID,
+		Sequence:  sequence,
+		Sender:    sender.String(),
+		Receiver:  destination,
+		Amount:    coin,
+		Status:    types.TransferStatusPending,
+	})
+	return nil
+}
+
+// OnTransferPacketReceived records an ibc transfer received by the transfer module in the transfer ledger,
+// the denom is the one credited to the receiver, the voucher of the destination channel or the unescrowed token.
+func (k Keeper) OnTransferPacketReceived(ctx sdk.Context, packet channeltypes.Packet, data ibctransfertypes.FungibleTokenPacketData) {
+	var denom string
+	if ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		voucherPrefix := ibctransfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denom = ibctransfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):]).IBCDenom()
+	} else {
+		prefixedDenom := ibctransfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+		denom = ibctransfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+	}
+
+	k.AppendTransferRecord(ctx, types.TransferRecord{
+		Direction: types.TransferDirectionInbound,
+		Origin:    types.TransferOriginIBC,
+		Channel:   packet.GetDestChannel(),
+		Sequence:  packet.GetSequence(),
+		Sender:    data.Sender,
+		Receiver:  data.Receiver,
+		Amount:    sdk.NewCoin(denom, sdk.NewIntFromUint64(data.Amount)),
+		Status:    types.TransferStatusCompleted,
+	})
+}
+
+// OnTransferPacketResult updates the transfer ledger record of an outgoing ibc transfer
+// once the packet is acknowledged or timed out, the tokens of a failed transfer are refunded
+// by the ibc transfer module.
+func (k Keeper) OnTransferPacketResult(ctx sdk.Context, channelID string, sequence uint64, success bool, errMsg string) {
+	record, found := k.GetTransferRecordByExternalID(ctx, types.TransferDirectionOutbound, types.TransferOriginIBC, channelID, sequence)
+	if !found {
+		return
+	}
+	status := types.TransferStatusCompleted
+	if !success {
+		status = types.TransferStatusRefunded
+	}
+	k.UpdateTransferRecord(ctx, record.Id, status, 0, errMsg)
 }
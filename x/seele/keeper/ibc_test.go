@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"math/big"
+	"time"
 
 	"github.com/Seele-N/Seele/app"
 	seelemodulekeeper "github.com/Seele-N/Seele/x/seele/keeper"
@@ -12,6 +13,7 @@ import (
 	"github.com/Seele-N/Seele/x/seele/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
 )
 
@@ -124,7 +126,7 @@ func (suite *KeeperTestSuite) TestConvertVouchersToEvmCoins() {
 			suite.SetupTest() // reset
 
 			tc.malleate()
-			err := suite.app.SeeleKeeper.ConvertVouchersToEvmCoins(suite.ctx, tc.from, tc.coin)
+			_, err := suite.app.SeeleKeeper.ConvertVouchersToEvmCoins(suite.ctx, tc.from, tc.coin, false)
 			if tc.expectedError != nil {
 				suite.Require().EqualError(err, tc.expectedError.Error())
 			} else {
@@ -135,6 +137,118 @@ func (suite *KeeperTestSuite) TestConvertVouchersToEvmCoins() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestConvertAuthorization() {
+	suite.SetupTest()
+
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	granter := sdk.AccAddress(privKey.PubKey().Address())
+	grantee := sdk.AccAddress(tmhash.SumTruncated([]byte("grantee")))
+	funds := sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdk.NewInt(100)))
+	suite.Require().NoError(suite.MintCoinsToModule(types.ModuleName, funds))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, granter, funds))
+
+	authorization := types.NewConvertAuthorization(sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdk.NewInt(50))))
+	err = suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee, granter, authorization, suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	convert := func(amount int64) error {
+		msg := types.NewMsgConvertVouchers(granter.String(), sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdk.NewInt(amount))))
+		_, err := suite.app.AuthzKeeper.DispatchActions(suite.ctx, grantee, []sdk.Msg{msg})
+		return err
+	}
+
+	// the grantee converts the vouchers of the granter within the spend limit
+	suite.Require().NoError(convert(30))
+	suite.Require().Equal(sdk.NewInt(70), suite.GetBalance(granter, CorrectIbcDenom).Amount)
+	suite.Require().Error(convert(21))
+	suite.Require().NoError(convert(20))
+	suite.Require().Equal(sdk.NewInt(50), suite.GetBalance(granter, CorrectIbcDenom).Amount)
+
+	// the exhausted authorization is deleted
+	granted, _ := suite.app.AuthzKeeper.GetCleanAuthorization(suite.ctx, grantee, granter, authorization.MsgTypeURL())
+	suite.Require().Nil(granted)
+}
+
+func (suite *KeeperTestSuite) TestConvertVouchersAtomically() {
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := sdk.AccAddress(privKey.PubKey().Address())
+
+	// the ibc seele vouchers are missing, the other coins can be converted
+	coins := sdk.NewCoins(
+		sdk.NewCoin(types.IbcCroDenomDefaultValue, sdk.NewInt(5)),
+		sdk.NewCoin(CorrectIbcDenom, sdk.NewInt(100)),
+	)
+	fund := func() {
+		funds := sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdk.NewInt(123)))
+		suite.Require().NoError(suite.MintCoinsToModule(types.ModuleName, funds))
+		suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, address, funds))
+	}
+
+	suite.Run("no coin is converted unless all of them are", func() {
+		suite.SetupTest()
+		fund()
+
+		_, err := suite.app.SeeleKeeper.ConvertVouchersToEvmCoins(suite.ctx, address.String(), coins, false)
+		suite.Require().Error(err)
+		suite.Require().Equal(sdk.NewInt(123), suite.GetBalance(address, CorrectIbcDenom).Amount)
+		_, found := suite.app.SeeleKeeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+		suite.Require().False(found)
+		records, _, err := suite.app.SeeleKeeper.GetTransferRecordsByAddress(suite.ctx, address, nil)
+		suite.Require().NoError(err)
+		suite.Require().Empty(records)
+	})
+
+	suite.Run("best effort mode converts the coins it can", func() {
+		suite.SetupTest()
+		fund()
+
+		msgServer := seelemodulekeeper.NewMsgServerImpl(suite.app.SeeleKeeper)
+		msg := types.NewMsgConvertVouchers(address.String(), coins)
+		msg.BestEffort = true
+		res, err := msgServer.ConvertVouchers(sdk.WrapSDKContext(suite.ctx), msg)
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Results, 2)
+
+		contract, found := suite.app.SeeleKeeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+		suite.Require().True(found)
+		for _, result := range res.Results {
+			switch result.Coin.Denom {
+			case CorrectIbcDenom:
+				suite.Require().Empty(result.Error)
+				suite.Require().Equal(contract.Hex(), result.Contract)
+				suite.Require().Equal(sdk.NewInt(100), result.Minted)
+				suite.Require().True(result.DustRefunded.IsZero())
+			default:
+				suite.Require().Contains(result.Error, "insufficient funds")
+				suite.Require().Empty(result.Contract)
+				suite.Require().True(result.Minted.IsZero())
+			}
+		}
+
+		suite.Require().Equal(sdk.NewInt(23), suite.GetBalance(address, CorrectIbcDenom).Amount)
+		ret, err := suite.app.SeeleKeeper.CallModuleSRC20(suite.ctx, contract, "balanceOf", common.BytesToAddress(address.Bytes()))
+		suite.Require().NoError(err)
+		suite.Require().Equal(big.NewInt(100), big.NewInt(0).SetBytes(ret))
+
+		// only the converted coin is recorded and reported in the events
+		records, _, err := suite.app.SeeleKeeper.GetTransferRecordsByAddress(suite.ctx, address, nil)
+		suite.Require().NoError(err)
+		suite.Require().Len(records, 1)
+		for _, event := range suite.ctx.EventManager().Events() {
+			if event.Type != types.EventTypeConvertVouchers {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == sdk.AttributeKeyAmount {
+					suite.Require().Equal(sdk.NewCoin(CorrectIbcDenom, sdk.NewInt(100)).String(), string(attr.Value))
+				}
+			}
+		}
+	})
+}
+
 func (suite *KeeperTestSuite) TestIbcTransferCoins() {
 
 	privKey, err := ethsecp256k1.GenerateKey()
@@ -192,7 +306,7 @@ func (suite *KeeperTestSuite) TestIbcTransferCoins() {
 			"to",
 			sdk.NewCoins(sdk.NewCoin(suite.evmParam.EvmDenom, sdk.NewInt(1230000000000))),
 			func() {},
-			errors.New("0aphoton is smaller than 1230000000000aphoton: insufficient funds"),
+			fmt.Errorf("0%[1]s is smaller than 1230000000000%[1]s: insufficient funds", suite.evmParam.EvmDenom),
 			func() {},
 		},
 		{
@@ -262,7 +376,8 @@ func (suite *KeeperTestSuite) TestIbcTransferCoins() {
 				suite.app.GetSubspace(types.ModuleName),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
-				suite.app.GravityKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
+				app.NewSeeleGravityKeeper(suite.app.GravityKeeper),
 				suite.app.EvmKeeper,
 				suite.app.StakingKeeper,
 			)
@@ -28,6 +28,8 @@ type (
 		bankKeeper types.BankKeeper
 		// ibc transfer operations
 		transferKeeper types.TransferKeeper
+		// ibc channel sequences
+		channelKeeper types.ChannelKeeper
 		// gravity bridge keeper
 		gravityKeeper types.GravityKeeper
 		// ethermint evm keeper
@@ -36,6 +38,10 @@ type (
 		// delegate operations with coins
 		stakingKeeper types.StakingKeeper
 
+		// the tracer of the evm calls and the traces of the recent txs, nil if the calls are not traced
+		evmTracer string
+		evmTraces *evmTraceStore
+
 		// this line is used by starport scaffolding # ibc/keeper/attribute
 	}
 )
@@ -47,6 +53,7 @@ func NewKeeper(
 	paramSpace paramtypes.Subspace,
 	bankKeeper types.BankKeeper,
 	transferKeeper types.TransferKeeper,
+	channelKeeper types.ChannelKeeper,
 	gravityKeeper types.GravityKeeper,
 	evmKeeper *evmkeeper.Keeper,
 	stakingKeeper types.StakingKeeper,
@@ -65,6 +72,7 @@ func NewKeeper(
 		paramSpace:     paramSpace,
 		bankKeeper:     bankKeeper,
 		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
 		gravityKeeper:  gravityKeeper,
 		evmKeeper:      evmKeeper,
 		stakingKeeper:  stakingKeeper,
@@ -72,6 +80,12 @@ func NewKeeper(
 	}
 }
 
+// WithTransferKeeper returns a copy of the keeper sending the ibc transfers through another transfer keeper
+func (k Keeper) WithTransferKeeper(transferKeeper types.TransferKeeper) Keeper {
+	k.transferKeeper = transferKeeper
+	return k
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -109,8 +123,8 @@ func (k Keeper) getAutoContractByDenom(ctx sdk.Context, denom string) (common.Ad
 	return common.BytesToAddress(bz), true
 }
 
-// GetContractByDenom find the corresponding contract for the denom,
-// external contract is taken in preference to auto-deployed one
+// GetContractByDenom find the corresponding auto-deployed contract for the denom, the external contracts
+// only approve the ethereum tokens of the gravity denoms and are not used by the conversions
 func (k Keeper) GetContractByDenom(ctx sdk.Context, denom string) (contract common.Address, found bool) {
 
 	contract, found = k.getAutoContractByDenom(ctx, denom)
@@ -9,7 +9,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
@@ -23,6 +22,8 @@ import (
 	ethermint "github.com/tharsis/ethermint/types"
 
 	"github.com/Seele-N/Seele/app"
+	mintxtypes "github.com/Seele-N/Seele/x/mintx/types"
+	"github.com/Seele-N/Seele/x/seele/types"
 )
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -105,11 +106,11 @@ func (suite *KeeperTestSuite) SetupTest() {
 }
 
 func (suite *KeeperTestSuite) MintCoins(address sdk.AccAddress, coins sdk.Coins) error {
-	err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins)
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, mintxtypes.ModuleName, coins)
 	if err != nil {
 		return err
 	}
-	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, address, coins)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, mintxtypes.ModuleName, address, coins)
 	if err != nil {
 		return err
 	}
@@ -133,11 +134,16 @@ func (suite *KeeperTestSuite) TestDenomContractMap() {
 	suite.Require().True(found)
 	suite.Require().Equal(autoContract, contract)
 
+	// the external contract is reported along with the auto-deployed one which is kept for the conversions
 	keeper.SetExternalContractForDenom(suite.ctx, denom, externalContract)
 
 	contract, found = keeper.GetContractByDenom(suite.ctx, denom)
 	suite.Require().True(found)
-	suite.Require().Equal(externalContract, contract)
+	suite.Require().Equal(autoContract, contract)
+	rsp, err := keeper.ContractByDenom(sdk.WrapSDKContext(suite.ctx), &types.ContractByDenomRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(externalContract.String(), rsp.Contract)
+	suite.Require().Equal(autoContract.String(), rsp.AutoContract)
 }
 
 func (suite *KeeperTestSuite) MintCoinsToModule(module string, coins sdk.Coins) error {
@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// GravityKeeperMock maps the ethereum contracts of the cosmos originated tokens to their denoms,
+// the other contracts are ethereum originated.
+type GravityKeeperMock struct {
+	CosmosOriginated map[string]string
+}
+
+func (g GravityKeeperMock) ERC20ToDenomLookup(ctx sdk.Context, tokenContract string) (bool, string) {
+	if denom, ok := g.CosmosOriginated[tokenContract]; ok {
+		return true, denom
+	}
+	return false, gravitytypes.NewERC20Token(0, tokenContract).GravityCoin().Denom
+}
+
+func (g GravityKeeperMock) DenomToERC20Lookup(ctx sdk.Context, denom string) (bool, common.Address, error) {
+	for contract, d := range g.CosmosOriginated {
+		if d == denom {
+			return true, common.HexToAddress(contract), nil
+		}
+	}
+	contract, err := gravitytypes.GravityDenomToERC20(denom)
+	return false, common.HexToAddress(contract), err
+}
+
+func (g GravityKeeperMock) IterateOutgoingTxsByType(ctx sdk.Context, prefixByte byte, cb func(key []byte, outgoing gravitytypes.OutgoingTx) (stop bool)) {
+}
+
+func (g GravityKeeperMock) SendToEthereum(goCtx context.Context, msg *gravitytypes.MsgSendToEthereum) (*gravitytypes.MsgSendToEthereumResponse, error) {
+	return &gravitytypes.MsgSendToEthereumResponse{}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Seele-N/Seele/x/seele/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -22,21 +23,29 @@ var _ types.MsgServer = msgServer{}
 
 func (k msgServer) ConvertVouchers(goCtx context.Context, msg *types.MsgConvertVouchers) (*types.MsgConvertVouchersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	err := k.ConvertVouchersToEvmCoins(ctx, msg.Address, msg.Coins)
+	results, err := k.ConvertVouchersToEvmCoins(ctx, msg.Address, msg.Coins, msg.BestEffort)
 	if err != nil {
 		return nil, err
 	}
 
+	// only the converted coins are reported in best effort mode
+	converted := sdk.NewCoins()
+	for _, result := range results {
+		if result.Error == "" {
+			converted = converted.Add(result.Coin)
+		}
+	}
+
 	// emit events
 	ctx.EventManager().EmitEvents(sdk.Events{
-		types.NewConvertVouchersEvent(msg.Address, msg.Coins),
+		types.NewConvertVouchersEvent(msg.Address, converted),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		)},
 	)
 
-	return &types.MsgConvertVouchersResponse{}, nil
+	return &types.MsgConvertVouchersResponse{Results: results, EvmGasUsed: types.EvmGasUsed(ctx.EventManager().Events())}, nil
 }
 
 func (k msgServer) TransferTokens(goCtx context.Context, msg *types.MsgTransferTokens) (*types.MsgTransferTokensResponse, error) {
@@ -54,20 +63,41 @@ func (k msgServer) TransferTokens(goCtx context.Context, msg *types.MsgTransferT
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		)},
 	)
-	return &types.MsgTransferTokensResponse{}, nil
+	return &types.MsgTransferTokensResponse{EvmGasUsed: types.EvmGasUsed(ctx.EventManager().Events())}, nil
 }
 
 // UpdateTokenMapping implements the grpc method
 func (k msgServer) UpdateTokenMapping(goCtx context.Context, msg *types.MsgUpdateTokenMapping) (*types.MsgUpdateTokenMappingResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	/*
-		admin := k.Keeper.GetParams(ctx).SeeleAdmin
-		// if admin is empty, no sender could be equal to it
-		if admin != msg.Sender {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg sender is authorized")
-		}
-	*/
+	admin := k.Keeper.GetParams(ctx).SeeleAdmin
+	// if admin is empty, no sender could be equal to it
+	if admin != msg.Sender {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender is not the seele admin")
+	}
 	// msg is already validated
-	k.Keeper.SetExternalContractForDenom(ctx, msg.Denom, common.HexToAddress(msg.Contract))
-	return &types.MsgUpdateTokenMappingResponse{}, nil
+	contract := common.HexToAddress(msg.Contract)
+	k.Keeper.SetExternalContractForDenom(ctx, msg.Denom, contract)
+	// the deposits waiting for the mapping are converted
+	k.Keeper.ResolvePendingTokenDeposits(ctx, contract, true, false)
+	return &types.MsgUpdateTokenMappingResponse{EvmGasUsed: types.EvmGasUsed(ctx.EventManager().Events())}, nil
+}
+
+// ConvertSRC20 implements the grpc method
+func (k msgServer) ConvertSRC20(goCtx context.Context, msg *types.MsgConvertSRC20) (*types.MsgConvertSRC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// msg is already validated
+	address, _ := sdk.AccAddressFromBech32(msg.Address)
+	coin, err := k.Keeper.ConvertSRC20ToNative(ctx, address, common.HexToAddress(msg.Contract), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		types.NewConvertSRC20Event(msg.Address, msg.Contract, coin),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		)},
+	)
+	return &types.MsgConvertSRC20Response{Coin: coin, EvmGasUsed: types.EvmGasUsed(ctx.EventManager().Events())}, nil
 }
@@ -44,7 +44,8 @@ func (suite *KeeperTestSuite) TestGetSourceChannelID() {
 				suite.app.GetSubspace(types.ModuleName),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
-				suite.app.GravityKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
+				app.NewSeeleGravityKeeper(suite.app.GravityKeeper),
 				suite.app.EvmKeeper,
 				suite.app.StakingKeeper,
 			)
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+const (
+	// pendingTokenError is the error reported in the transfer ledger while a deposit waits for a mapping
+	pendingTokenError = "waiting for a token mapping"
+	// maxResolvedPendingTokenDeposits is the maximum number of pending deposits resolved at once
+	maxResolvedPendingTokenDeposits = 100
+)
+
+// GetPendingTokenDeposits returns a page of the gravity deposits waiting for a token mapping,
+// a nil token contract returns the deposits of all the tokens.
+func (k Keeper) GetPendingTokenDeposits(
+	ctx sdk.Context,
+	tokenContract *common.Address,
+	pageReq *query.PageRequest,
+) ([]types.PendingTokenDeposit, *query.PageResponse, error) {
+	prefixKey := types.KeyPrefixPendingTokenDeposit
+	if tokenContract != nil {
+		prefixKey = types.PendingTokenDepositPrefix(*tokenContract)
+	}
+
+	var deposits []types.PendingTokenDeposit
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var deposit types.PendingTokenDeposit
+		if err := k.cdc.Unmarshal(value, &deposit); err != nil {
+			return err
+		}
+		deposits = append(deposits, deposit)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return deposits, pageRes, nil
+}
+
+// GetAllPendingTokenDeposits returns all the gravity deposits waiting for a token mapping ordered by token contract and id
+func (k Keeper) GetAllPendingTokenDeposits(ctx sdk.Context) (out []types.PendingTokenDeposit) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingTokenDeposit).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.PendingTokenDeposit
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		out = append(out, deposit)
+	}
+	return
+}
+
+// SetPendingTokenDeposit stores a gravity deposit waiting for a token mapping
+func (k Keeper) SetPendingTokenDeposit(ctx sdk.Context, deposit types.PendingTokenDeposit) {
+	key := types.PendingTokenDepositKey(common.HexToAddress(deposit.TokenContract), deposit.Id)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&deposit))
+}
+
+// GetLastPendingTokenDepositID returns the id of the last gravity deposit waiting for a token mapping
+func (k Keeper) GetLastPendingTokenDepositID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLastPendingTokenDepositID)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastPendingTokenDepositID sets the id of the last gravity deposit waiting for a token mapping
+func (k Keeper) SetLastPendingTokenDepositID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyLastPendingTokenDepositID, sdk.Uint64ToBigEndian(id))
+}
+
+// queuePendingTokenDeposit moves the coins of a deposit waiting for a token mapping from the receiver to the module account
+func (k Keeper) queuePendingTokenDeposit(ctx sdk.Context, deposit types.PendingTokenDeposit) error {
+	receiver := common.HexToAddress(deposit.Receiver)
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(receiver.Bytes()), types.ModuleName, sdk.NewCoins(deposit.Amount))
+	if err != nil {
+		return err
+	}
+
+	id := k.GetLastPendingTokenDepositID(ctx) + 1
+	k.SetLastPendingTokenDepositID(ctx, id)
+
+	deposit.Id = id
+	deposit.Height = ctx.BlockHeight()
+	k.SetPendingTokenDeposit(ctx, deposit)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTokenDepositPending,
+		sdk.NewAttribute(types.AttributeKeyDepositID, sdk.NewUint(id).String()),
+		sdk.NewAttribute(types.AttributeKeyEthereumTokenContract, deposit.TokenContract),
+		sdk.NewAttribute(types.AttributeKeyReceiver, deposit.Receiver),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.Amount.String()),
+	))
+	return nil
+}
+
+// ResolvePendingTokenDeposits approves to give the coins of the deposits waiting for a mapping of the token back to
+// their receivers, they are converted to src20 tokens unless releaseNative is set, deploying a new contract if
+// autoDeploy is set. At most maxResolvedPendingTokenDeposits deposits are resolved at once, the others are resolved
+// at the end of the next blocks.
+func (k Keeper) ResolvePendingTokenDeposits(ctx sdk.Context, tokenContract common.Address, autoDeploy, releaseNative bool) {
+	approval := types.PendingTokenApproval{
+		TokenContract: tokenContract.Hex(),
+		AutoDeploy:    autoDeploy,
+		ReleaseNative: releaseNative,
+	}
+	k.SetPendingTokenApproval(ctx, approval)
+	k.resolvePendingTokenDeposits(ctx, approval, maxResolvedPendingTokenDeposits)
+}
+
+// ProcessPendingTokenApprovals resolves the deposits of the approved tokens left by ResolvePendingTokenDeposits,
+// at most maxResolvedPendingTokenDeposits deposits are resolved per block.
+func (k Keeper) ProcessPendingTokenApprovals(ctx sdk.Context) {
+	budget := maxResolvedPendingTokenDeposits
+	for _, approval := range k.GetPendingTokenApprovals(ctx) {
+		budget -= k.resolvePendingTokenDeposits(ctx, approval, budget)
+		if budget <= 0 {
+			return
+		}
+	}
+}
+
+// GetPendingTokenApprovals returns the approved resolutions of the pending deposits ordered by token contract
+func (k Keeper) GetPendingTokenApprovals(ctx sdk.Context) (out []types.PendingTokenApproval) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingTokenApproval).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var approval types.PendingTokenApproval
+		k.cdc.MustUnmarshal(iter.Value(), &approval)
+		out = append(out, approval)
+	}
+	return
+}
+
+// SetPendingTokenApproval stores the approved resolution of the pending deposits of a token,
+// replacing the previous one if any
+func (k Keeper) SetPendingTokenApproval(ctx sdk.Context, approval types.PendingTokenApproval) {
+	key := types.PendingTokenApprovalKey(common.HexToAddress(approval.TokenContract))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&approval))
+}
+
+// resolvePendingTokenDeposits resolves at most limit deposits of the approved token and returns their number,
+// the approval is deleted once no deposit is left.
+func (k Keeper) resolvePendingTokenDeposits(ctx sdk.Context, approval types.PendingTokenApproval, limit int) int {
+	tokenContract := common.HexToAddress(approval.TokenContract)
+	store := ctx.KVStore(k.storeKey)
+	var deposits []types.PendingTokenDeposit
+	iter := prefix.NewStore(store, types.PendingTokenDepositPrefix(tokenContract)).Iterator(nil, nil)
+	for ; iter.Valid() && len(deposits) < limit; iter.Next() {
+		var deposit types.PendingTokenDeposit
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+	done := !iter.Valid()
+	iter.Close()
+
+	for _, deposit := range deposits {
+		// the coins have been taken from the receiver, so they can always be given back
+		receiver := common.HexToAddress(deposit.Receiver)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(receiver.Bytes()), sdk.NewCoins(deposit.Amount))
+		if err != nil {
+			k.Logger(ctx).Error("failed to release pending token deposit", "id", deposit.Id, "error", err)
+			continue
+		}
+		store.Delete(types.PendingTokenDepositKey(tokenContract, deposit.Id))
+
+		status, errMsg := types.TransferStatusCompleted, ""
+		if !approval.ReleaseNative {
+			cacheCtx, commit := ctx.CacheContext()
+			err = k.ConvertCoinFromNativeToSRC20(cacheCtx, receiver, deposit.Amount, approval.AutoDeploy)
+			if err == nil {
+				commit()
+				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			} else {
+				// the receiver keeps the native coins
+				status, errMsg = types.TransferStatusFailed, err.Error()
+			}
+		}
+		k.UpdateTransferRecord(ctx, deposit.RecordId, status, 0, errMsg)
+	}
+
+	if done {
+		store.Delete(types.PendingTokenApprovalKey(tokenContract))
+	}
+	if len(deposits) > 0 {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePendingTokenResolved,
+			sdk.NewAttribute(types.AttributeKeyEthereumTokenContract, tokenContract.Hex()),
+		))
+	}
+	return len(deposits)
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+
+	seelekeeper "github.com/Seele-N/Seele/x/seele/keeper"
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+func (suite *KeeperTestSuite) TestDeploymentPolicies() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+
+	erc20 := common.HexToAddress("0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503")
+	blacklisted := common.HexToAddress("0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2")
+	receiver := common.BigToAddress(big.NewInt(101))
+	moduleAddress := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	params := keeper.GetParams(suite.ctx)
+	params.DeploymentPolicies = []types.OriginDeploymentPolicy{{
+		Origin:    types.TransferOriginGravity,
+		Policy:    types.DeploymentPolicyRequireMapping,
+		Blacklist: []string{blacklisted.Hex()},
+	}}
+	keeper.SetParams(suite.ctx, params)
+
+	// the gravity module credits the receiver before calling the hook
+	sendToCosmos := func(nonce uint64, token common.Address) {
+		coins := sdk.NewCoins(sdk.NewCoin("gravity"+token.Hex(), sdk.NewInt(100)))
+		err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+		suite.Require().NoError(err)
+		err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.AccAddress(receiver.Bytes()), coins)
+		suite.Require().NoError(err)
+		keeper.AfterSendToCosmosEvent(suite.ctx, gravitytypes.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  token.Hex(),
+			Amount:         sdk.NewInt(100),
+			EthereumSender: common.BigToAddress(big.NewInt(102)).Hex(),
+			CosmosReceiver: receiver.Hex(),
+		})
+	}
+
+	// blacklisted tokens are left native
+	sendToCosmos(1, blacklisted)
+	_, found := keeper.GetContractByDenom(suite.ctx, "gravity"+blacklisted.Hex())
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(receiver.Bytes()), "gravity"+blacklisted.Hex()).Amount)
+
+	// the other tokens wait for a mapping
+	denom := "gravity" + erc20.Hex()
+	sendToCosmos(2, erc20)
+	sendToCosmos(3, erc20)
+	_, found = keeper.GetContractByDenom(suite.ctx, denom)
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.NewInt(200), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddress, denom).Amount)
+	rsp, err := keeper.PendingTokenDeposits(sdk.WrapSDKContext(suite.ctx), &types.PendingTokenDepositsRequest{
+		TokenContract: erc20.Hex(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(rsp.Deposits, 2)
+	record, _ := keeper.GetTransferRecordByExternalID(suite.ctx, types.TransferDirectionInbound, types.TransferOriginGravity, "", 2)
+	suite.Require().Equal(types.TransferStatusPending, record.Status)
+
+	// the backlog is converted once the mapping is approved
+	admin := sdk.AccAddress(common.BigToAddress(big.NewInt(1)).Bytes())
+	params = keeper.GetParams(suite.ctx)
+	params.SeeleAdmin = admin.String()
+	keeper.SetParams(suite.ctx, params)
+	msgServer := seelekeeper.NewMsgServerImpl(keeper)
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	updateRsp, err := msgServer.UpdateTokenMapping(sdk.WrapSDKContext(ctx), types.NewMsgUpdateTokenMapping(admin.String(), denom, erc20.Hex()))
+	suite.Require().NoError(err)
+	// the events of the conversions are emitted and accounted in the response
+	suite.Require().Positive(updateRsp.EvmGasUsed)
+	suite.Require().Equal(types.EvmGasUsed(ctx.EventManager().Events()), updateRsp.EvmGasUsed)
+
+	token, found := keeper.GetContractByDenom(suite.ctx, denom)
+	suite.Require().True(found)
+	ret, err := keeper.CallModuleSRC20(suite.ctx, token, "balanceOf", receiver)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(200), big.NewInt(0).SetBytes(ret))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddress, denom).IsZero())
+	record, _ = keeper.GetTransferRecordByExternalID(suite.ctx, types.TransferDirectionInbound, types.TransferOriginGravity, "", 2)
+	suite.Require().Equal(types.TransferStatusCompleted, record.Status)
+	rsp, err = keeper.PendingTokenDeposits(sdk.WrapSDKContext(suite.ctx), &types.PendingTokenDepositsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.Deposits)
+
+	// the approved token is converted directly
+	sendToCosmos(4, erc20)
+	ret, err = keeper.CallModuleSRC20(suite.ctx, token, "balanceOf", receiver)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(300), big.NewInt(0).SetBytes(ret))
+}
+
+func (suite *KeeperTestSuite) TestReleasePendingTokenDeposits() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+
+	erc20 := common.HexToAddress("0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503")
+	denom := "gravity" + erc20.Hex()
+	receiver := common.BigToAddress(big.NewInt(101))
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))
+
+	params := keeper.GetParams(suite.ctx)
+	params.DeploymentPolicies = []types.OriginDeploymentPolicy{{
+		Origin:    types.TransferOriginGravity,
+		Policy:    types.DeploymentPolicyAutoDeploy,
+		Whitelist: []string{common.BigToAddress(big.NewInt(1)).Hex()},
+	}}
+	keeper.SetParams(suite.ctx, params)
+
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.AccAddress(receiver.Bytes()), coins)
+	suite.Require().NoError(err)
+	// not in the whitelist
+	keeper.AfterSendToCosmosEvent(suite.ctx, gravitytypes.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  erc20.Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: common.BigToAddress(big.NewInt(102)).Hex(),
+		CosmosReceiver: receiver.Hex(),
+	})
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(receiver.Bytes()), denom).IsZero())
+
+	keeper.ResolvePendingTokenDeposits(suite.ctx, erc20, true, true)
+	_, found := keeper.GetContractByDenom(suite.ctx, denom)
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(receiver.Bytes()), denom).Amount)
+	record, _ := keeper.GetTransferRecordByExternalID(suite.ctx, types.TransferDirectionInbound, types.TransferOriginGravity, "", 1)
+	suite.Require().Equal(types.TransferStatusCompleted, record.Status)
+}
+
+func (suite *KeeperTestSuite) TestResolvePendingTokenDepositsInBatches() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+
+	erc20 := common.HexToAddress("0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503")
+	denom := "gravity" + erc20.Hex()
+	receiver := common.BigToAddress(big.NewInt(101))
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(150)))
+
+	params := keeper.GetParams(suite.ctx)
+	params.DeploymentPolicies = []types.OriginDeploymentPolicy{{
+		Origin:    types.TransferOriginGravity,
+		Policy:    types.DeploymentPolicyAutoDeploy,
+		Whitelist: []string{common.BigToAddress(big.NewInt(1)).Hex()},
+	}}
+	keeper.SetParams(suite.ctx, params)
+
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.AccAddress(receiver.Bytes()), coins)
+	suite.Require().NoError(err)
+	for nonce := uint64(1); nonce <= 150; nonce++ {
+		keeper.AfterSendToCosmosEvent(suite.ctx, gravitytypes.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  erc20.Hex(),
+			Amount:         sdk.NewInt(1),
+			EthereumSender: common.BigToAddress(big.NewInt(102)).Hex(),
+			CosmosReceiver: receiver.Hex(),
+		})
+	}
+	balance := func() sdk.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(receiver.Bytes()), denom).Amount
+	}
+	suite.Require().True(balance().IsZero())
+
+	// the first 100 deposits are released at once, the approval is kept for the others
+	keeper.ResolvePendingTokenDeposits(suite.ctx, erc20, true, true)
+	suite.Require().Equal(sdk.NewInt(100), balance())
+	suite.Require().Equal([]types.PendingTokenApproval{{TokenContract: erc20.Hex(), AutoDeploy: true, ReleaseNative: true}},
+		keeper.GetPendingTokenApprovals(suite.ctx))
+
+	// the others are released at the end of the block
+	keeper.ProcessPendingTokenApprovals(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(150), balance())
+	suite.Require().Empty(keeper.GetPendingTokenApprovals(suite.ctx))
+	rsp, err := keeper.PendingTokenDeposits(sdk.WrapSDKContext(suite.ctx), &types.PendingTokenDepositsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.Deposits)
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+// GetQuarantinedDeposit returns the quarantined gravity deposit with the given id
+func (k Keeper) GetQuarantinedDeposit(ctx sdk.Context, id uint64) (types.QuarantinedDeposit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.QuarantinedDepositKey(id))
+	if len(bz) == 0 {
+		return types.QuarantinedDeposit{}, false
+	}
+	var deposit types.QuarantinedDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// GetAllQuarantinedDeposits returns all the quarantined gravity deposits ordered by id
+func (k Keeper) GetAllQuarantinedDeposits(ctx sdk.Context) (out []types.QuarantinedDeposit) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuarantinedDeposit).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.QuarantinedDeposit
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		out = append(out, deposit)
+	}
+	return
+}
+
+// SetQuarantinedDeposit stores a quarantined gravity deposit
+func (k Keeper) SetQuarantinedDeposit(ctx sdk.Context, deposit types.QuarantinedDeposit) {
+	ctx.KVStore(k.storeKey).Set(types.QuarantinedDepositKey(deposit.Id), k.cdc.MustMarshal(&deposit))
+}
+
+// GetLastQuarantinedDepositID returns the id of the last quarantined gravity deposit
+func (k Keeper) GetLastQuarantinedDepositID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLastQuarantinedDepositID)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastQuarantinedDepositID sets the id of the last quarantined gravity deposit
+func (k Keeper) SetLastQuarantinedDepositID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyLastQuarantinedDepositID, sdk.Uint64ToBigEndian(id))
+}
+
+// GetQuarantinedDeposits returns a page of the quarantined gravity deposits
+func (k Keeper) GetQuarantinedDeposits(ctx sdk.Context, pageReq *query.PageRequest) ([]types.QuarantinedDeposit, *query.PageResponse, error) {
+	var deposits []types.QuarantinedDeposit
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuarantinedDeposit)
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var deposit types.QuarantinedDeposit
+		if err := k.cdc.Unmarshal(value, &deposit); err != nil {
+			return err
+		}
+		deposits = append(deposits, deposit)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return deposits, pageRes, nil
+}
+
+// quarantineDeposit moves the coins minted by the gravity module for an unparseable receiver to the module account,
+// they are kept there until governance releases them.
+func (k Keeper) quarantineDeposit(ctx sdk.Context, deposit types.QuarantinedDeposit, holder common.Address) (types.QuarantinedDeposit, error) {
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(holder.Bytes()), types.ModuleName, sdk.NewCoins(deposit.Amount))
+	if err != nil {
+		return deposit, err
+	}
+
+	id := k.GetLastQuarantinedDepositID(ctx) + 1
+	k.SetLastQuarantinedDepositID(ctx, id)
+
+	deposit.Id = id
+	deposit.Height = ctx.BlockHeight()
+	k.SetQuarantinedDeposit(ctx, deposit)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDepositQuarantined,
+		sdk.NewAttribute(types.AttributeKeyDepositID, sdk.NewUint(id).String()),
+		sdk.NewAttribute(types.AttributeKeyReceiver, deposit.CosmosReceiver),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.Amount.String()),
+	))
+	return deposit, nil
+}
+
+// ReleaseQuarantinedDeposit sends the coins of a quarantined deposit to the receiver,
+// they are converted to src20 tokens if possible like any other gravity deposit.
+func (k Keeper) ReleaseQuarantinedDeposit(ctx sdk.Context, id uint64, receiver common.Address) error {
+	deposit, found := k.GetQuarantinedDeposit(ctx, id)
+	if !found {
+		return types.ErrQuarantinedDepositNotFound
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(receiver.Bytes()), sdk.NewCoins(deposit.Amount))
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.QuarantinedDepositKey(id))
+
+	cacheCtx, commit := ctx.CacheContext()
+	pending, err := k.convertGravityDeposit(cacheCtx, deposit.TokenContract, receiver, deposit.Amount)
+	switch {
+	case err != nil:
+		// the receiver keeps the native coins
+		k.UpdateTransferRecord(ctx, deposit.RecordId, types.TransferStatusFailed, 0, err.Error())
+	case pending:
+		err = k.queuePendingTokenDeposit(ctx, types.PendingTokenDeposit{
+			TokenContract: deposit.TokenContract,
+			Receiver:      receiver.Hex(),
+			Amount:        deposit.Amount,
+			RecordId:      deposit.RecordId,
+		})
+		if err != nil {
+			return err
+		}
+		k.UpdateTransferRecord(ctx, deposit.RecordId, types.TransferStatusPending, 0, pendingTokenError)
+	default:
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		k.UpdateTransferRecord(ctx, deposit.RecordId, types.TransferStatusCompleted, 0, "")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeQuarantinedDepositReleased,
+		sdk.NewAttribute(types.AttributeKeyDepositID, sdk.NewUint(id).String()),
+		sdk.NewAttribute(types.AttributeKeyReceiver, receiver.Hex()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.Amount.String()),
+	))
+	return nil
+}
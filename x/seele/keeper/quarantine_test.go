@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+
+	"github.com/Seele-N/Seele/x/seele"
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+func (suite *KeeperTestSuite) TestSendToCosmosReceivers() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+
+	erc20 := common.HexToAddress("0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503")
+	denom := "gravity" + erc20.Hex()
+	receiver := common.BigToAddress(big.NewInt(101))
+	bech32Receiver, err := bech32.ConvertAndEncode("seele", receiver.Bytes())
+	suite.Require().NoError(err)
+
+	// the gravity module credits the hex decoding of the receiver before calling the hook
+	sendToCosmos := func(nonce uint64, cosmosReceiver string) sdk.Events {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))
+		err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+		suite.Require().NoError(err)
+		err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.AccAddress(common.HexToAddress(cosmosReceiver).Bytes()), coins)
+		suite.Require().NoError(err)
+		ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+		keeper.AfterSendToCosmosEvent(ctx, gravitytypes.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  erc20.Hex(),
+			Amount:         sdk.NewInt(100),
+			EthereumSender: common.BigToAddress(big.NewInt(102)).Hex(),
+			CosmosReceiver: cosmosReceiver,
+		})
+		return ctx.EventManager().Events()
+	}
+	balanceOf := func(addr common.Address) *big.Int {
+		token, found := keeper.GetContractByDenom(suite.ctx, denom)
+		suite.Require().True(found)
+		ret, err := keeper.CallModuleSRC20(suite.ctx, token, "balanceOf", addr)
+		suite.Require().NoError(err)
+		return big.NewInt(0).SetBytes(ret)
+	}
+
+	// hex and bech32 receivers, the events of the conversion are emitted
+	events := sendToCosmos(1, receiver.Hex())
+	suite.Require().Equal(big.NewInt(100), balanceOf(receiver))
+	suite.Require().Positive(types.EvmGasUsed(events))
+	sendToCosmos(2, bech32Receiver)
+	suite.Require().Equal(big.NewInt(200), balanceOf(receiver))
+	record, found := keeper.GetTransferRecordByExternalID(suite.ctx, types.TransferDirectionInbound, types.TransferOriginGravity, "", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(types.TransferStatusCompleted, record.Status)
+
+	// an unparseable receiver is quarantined
+	sendToCosmos(3, "cosmos1invalid")
+	rsp, err := keeper.QuarantinedDeposits(sdk.WrapSDKContext(suite.ctx), &types.QuarantinedDepositsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(rsp.Deposits, 1)
+	deposit := rsp.Deposits[0]
+	suite.Require().Equal(uint64(3), deposit.EventNonce)
+	suite.Require().Equal(sdk.NewCoin(denom, sdk.NewInt(100)), deposit.Amount)
+	moduleAddress := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddress, denom).Amount)
+	record, _ = keeper.GetTransferRecord(suite.ctx, deposit.RecordId)
+	suite.Require().Equal(types.TransferStatusPending, record.Status)
+
+	// it's released by governance
+	handler := seele.NewTokenMappingChangeProposalHandler(keeper)
+	proposal := types.NewQuarantinedDepositReleaseProposal("release", "release", deposit.Id, bech32Receiver)
+	suite.Require().NoError(proposal.ValidateBasic())
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(handler(ctx, proposal))
+	suite.Require().Equal(big.NewInt(300), balanceOf(receiver))
+	suite.Require().Positive(types.EvmGasUsed(ctx.EventManager().Events()))
+	_, found = keeper.GetQuarantinedDeposit(suite.ctx, deposit.Id)
+	suite.Require().False(found)
+	record, _ = keeper.GetTransferRecord(suite.ctx, deposit.RecordId)
+	suite.Require().Equal(types.TransferStatusCompleted, record.Status)
+
+	suite.Require().Error(handler(suite.ctx, proposal))
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+// GetSRC20Balance returns the balance of an address in a denom, combining the bank balance and the balance wrapped
+// in the SRC20 contract of the denom with the metadata of the contract. The contract is only called if the denom is mapped.
+func (k Keeper) GetSRC20Balance(ctx sdk.Context, address common.Address, denom string) (types.SRC20Balance, error) {
+	balance := types.SRC20Balance{
+		Denom:        denom,
+		BankBalance:  k.bankKeeper.GetBalance(ctx, sdk.AccAddress(address.Bytes()), denom),
+		Src20Balance: sdk.ZeroInt(),
+		TotalSupply:  sdk.ZeroInt(),
+	}
+	contract, found := k.GetContractByDenom(ctx, denom)
+	if !found {
+		return balance, nil
+	}
+	balance.Contract = contract.Hex()
+
+	var src20Balance, totalSupply *big.Int
+	if err := k.staticCallSRC20Output(ctx, contract, &src20Balance, "balanceOf", address); err != nil {
+		return types.SRC20Balance{}, err
+	}
+	if err := k.staticCallSRC20Output(ctx, contract, &totalSupply, "totalSupply"); err != nil {
+		return types.SRC20Balance{}, err
+	}
+	if err := k.staticCallSRC20Output(ctx, contract, &balance.Name, "name"); err != nil {
+		return types.SRC20Balance{}, err
+	}
+	if err := k.staticCallSRC20Output(ctx, contract, &balance.Symbol, "symbol"); err != nil {
+		return types.SRC20Balance{}, err
+	}
+	var decimals uint8
+	if err := k.staticCallSRC20Output(ctx, contract, &decimals, "decimals"); err != nil {
+		return types.SRC20Balance{}, err
+	}
+	balance.Src20Balance = sdk.NewIntFromBigInt(src20Balance)
+	balance.TotalSupply = sdk.NewIntFromBigInt(totalSupply)
+	balance.Decimals = uint32(decimals)
+	return balance, nil
+}
+
+// GetSRC20Balances returns the balances of an address in the denoms held in the bank or mapped to a contract,
+// sorted by denom
+func (k Keeper) GetSRC20Balances(ctx sdk.Context, address common.Address) ([]types.SRC20Balance, error) {
+	denoms := make(map[string]bool)
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, sdk.AccAddress(address.Bytes())) {
+		denoms[coin.Denom] = true
+	}
+	for _, mapping := range k.GetExternalContracts(ctx) {
+		denoms[mapping.Denom] = true
+	}
+	for _, mapping := range k.GetAutoContracts(ctx) {
+		denoms[mapping.Denom] = true
+	}
+	sorted := make([]string, 0, len(denoms))
+	for denom := range denoms {
+		sorted = append(sorted, denom)
+	}
+	sort.Strings(sorted)
+
+	balances := make([]types.SRC20Balance, 0, len(sorted))
+	for _, denom := range sorted {
+		balance, err := k.GetSRC20Balance(ctx, address, denom)
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, balance)
+	}
+	return balances, nil
+}
+
+// staticCallSRC20Output calls a read-only method of a SRC20 contract returning a single output, copied to out
+func (k Keeper) staticCallSRC20Output(ctx sdk.Context, contract common.Address, out interface{}, method string, args ...interface{}) error {
+	outputs, err := k.StaticCallModuleSRC20(ctx, contract, method, args...)
+	if err != nil {
+		return err
+	}
+	if err := types.ModuleSRC20Contract.ABI.Methods[method].Outputs.Copy(out, outputs); err != nil {
+		return fmt.Errorf("invalid output of contract %s, %s: %w", contract.Hex(), method, err)
+	}
+	return nil
+}
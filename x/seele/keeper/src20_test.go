@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+func (suite *KeeperTestSuite) TestStaticCallEVM() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+
+	contract, err := keeper.DeployModuleSRC20(suite.ctx, "test", "test", uint8(18))
+	suite.Require().NoError(err)
+	nonce := suite.app.EvmKeeper.GetNonce(types.EVMModuleAddress)
+
+	outputs, err := keeper.StaticCallModuleSRC20(suite.ctx, contract, "symbol")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]interface{}{"test"}, outputs)
+
+	// a state changing method fails as a static call, leaving the state untouched
+	_, err = keeper.StaticCallModuleSRC20(suite.ctx, contract, "mint_by_seele_module", suite.address, big.NewInt(100))
+	suite.Require().ErrorIs(err, types.ErrEvmCallFailed)
+	outputs, err = keeper.StaticCallModuleSRC20(suite.ctx, contract, "totalSupply")
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), outputs[0].(*big.Int).Int64())
+
+	// the module nonce is untouched and the gas used is charged to the meter
+	suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(types.EVMModuleAddress))
+	ctx := suite.ctx.WithGasMeter(sdk.NewGasMeter(10_000_000))
+	res, err := keeper.StaticCallEVM(ctx, contract, types.ModuleSRC20Contract.ABI.Methods["name"].ID)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed())
+	suite.Require().Positive(res.GasUsed)
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), res.GasUsed)
+}
+
+func (suite *KeeperTestSuite) TestSRC20Balances() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+	cosmosAddress := sdk.AccAddress(suite.address.Bytes())
+
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	contract, err := keeper.DeployModuleSRC20(suite.ctx, "test", "test", uint8(6))
+	suite.Require().NoError(err)
+	keeper.SetAutoContractForDenom(suite.ctx, denom, contract)
+
+	// 40 coins in the bank, 60 wrapped in the contract
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(40)), sdk.NewCoin("other", sdk.NewInt(5)))
+	suite.Require().NoError(suite.MintCoinsToModule(types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, cosmosAddress, coins))
+	_, err = keeper.CallModuleSRC20(suite.ctx, contract, "mint_by_seele_module", suite.address, big.NewInt(60))
+	suite.Require().NoError(err)
+
+	expected := types.SRC20Balance{
+		Denom:        denom,
+		BankBalance:  sdk.NewCoin(denom, sdk.NewInt(40)),
+		Contract:     contract.Hex(),
+		Src20Balance: sdk.NewInt(60),
+		TotalSupply:  sdk.NewInt(60),
+		Name:         "test Token",
+		Symbol:       "test",
+		Decimals:     6,
+	}
+	for _, address := range []string{cosmosAddress.String(), suite.address.Hex()} {
+		res, err := keeper.SRC20Balance(sdk.WrapSDKContext(suite.ctx), &types.SRC20BalanceRequest{Address: address, Denom: denom})
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, res.Balance)
+	}
+
+	// the denoms without contract only have a bank balance
+	other := types.SRC20Balance{
+		Denom:        "other",
+		BankBalance:  sdk.NewCoin("other", sdk.NewInt(5)),
+		Src20Balance: sdk.ZeroInt(),
+		TotalSupply:  sdk.ZeroInt(),
+	}
+	res, err := keeper.SRC20Balances(sdk.WrapSDKContext(suite.ctx), &types.SRC20BalancesRequest{Address: suite.address.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SRC20Balance{expected, other}, res.Balances)
+
+	// the mapped denoms are listed for any address
+	res, err = keeper.SRC20Balances(sdk.WrapSDKContext(suite.ctx), &types.SRC20BalancesRequest{Address: common.Address{1}.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Balances, 1)
+	suite.Require().True(res.Balances[0].Src20Balance.IsZero())
+	suite.Require().True(res.Balances[0].BankBalance.IsZero())
+
+	_, err = keeper.SRC20Balances(sdk.WrapSDKContext(suite.ctx), &types.SRC20BalancesRequest{Address: "invalid"})
+	suite.Require().Error(err)
+
+	// the queries leave the context of the shared evm keeper untouched
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+	queryCtx := sdk.WrapSDKContext(suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1))
+	_, err = keeper.SRC20Balances(queryCtx, &types.SRC20BalancesRequest{Address: suite.address.Hex()})
+	suite.Require().NoError(err)
+	_, err = keeper.SRC20Address(queryCtx, &types.SRC20AddressRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ctx.BlockHeight(), suite.app.EvmKeeper.Ctx().BlockHeight())
+}
@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+// isFinalTransferStatus returns if the transfer won't change anymore
+func isFinalTransferStatus(status types.TransferStatus) bool {
+	return status == types.TransferStatusCompleted ||
+		status == types.TransferStatusRefunded ||
+		status == types.TransferStatusFailed
+}
+
+// GetTransferRecord returns the record of the transfer ledger with the given id
+func (k Keeper) GetTransferRecord(ctx sdk.Context, id uint64) (types.TransferRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TransferRecordKey(id))
+	if len(bz) == 0 {
+		return types.TransferRecord{}, false
+	}
+	var record types.TransferRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetTransferRecordByExternalID returns the record of the transfer ledger with the given gravity nonce or ibc sequence
+func (k Keeper) GetTransferRecordByExternalID(
+	ctx sdk.Context,
+	direction types.TransferDirection,
+	origin types.TransferOrigin,
+	channel string,
+	sequence uint64,
+) (types.TransferRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TransferRecordByExternalIDKey(direction, origin, channel, sequence))
+	if len(bz) == 0 {
+		return types.TransferRecord{}, false
+	}
+	return k.GetTransferRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTransferRecordsByAddress returns a page of the records sent or received by the address
+func (k Keeper) GetTransferRecordsByAddress(
+	ctx sdk.Context,
+	addr []byte,
+	pageReq *query.PageRequest,
+) ([]types.TransferRecord, *query.PageResponse, error) {
+	var records []types.TransferRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferRecordByAddressPrefix(addr))
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		record, found := k.GetTransferRecord(ctx, sdk.BigEndianToUint64(key))
+		if found {
+			records = append(records, record)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return records, pageRes, nil
+}
+
+// GetTransferRecords returns all the records of the transfer ledger ordered by id
+func (k Keeper) GetTransferRecords(ctx sdk.Context) (out []types.TransferRecord) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTransferRecord).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.TransferRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		out = append(out, record)
+	}
+	return
+}
+
+// GetLastTransferRecordID returns the id of the last record of the transfer ledger
+func (k Keeper) GetLastTransferRecordID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLastTransferRecordID)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTransferRecordID sets the id of the last record of the transfer ledger
+func (k Keeper) SetLastTransferRecordID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyLastTransferRecordID, sdk.Uint64ToBigEndian(id))
+}
+
+// AppendTransferRecord adds a new record to the transfer ledger and returns its id
+func (k Keeper) AppendTransferRecord(ctx sdk.Context, record types.TransferRecord) uint64 {
+	record.Id = k.GetLastTransferRecordID(ctx) + 1
+	k.SetLastTransferRecordID(ctx, record.Id)
+	record.CreatedHeight = ctx.BlockHeight()
+	k.setTransferRecord(ctx, record)
+	return record.Id
+}
+
+// ImportTransferRecord stores a record exported in genesis with its heights, its indexes and its place in the
+// prune queue are rebuilt.
+func (k Keeper) ImportTransferRecord(ctx sdk.Context, record types.TransferRecord) {
+	k.indexTransferRecord(ctx, record)
+}
+
+// UpdateTransferRecord updates the status and the external id of a record of the transfer ledger,
+// a zero sequence keeps the existing one.
+func (k Keeper) UpdateTransferRecord(ctx sdk.Context, id uint64, status types.TransferStatus, sequence uint64, errMsg string) {
+	record, found := k.GetTransferRecord(ctx, id)
+	if !found {
+		// pruned already
+		return
+	}
+	if sequence == 0 {
+		sequence = record.Sequence
+	}
+	if record.Status == status && record.Sequence == sequence && record.Error == errMsg {
+		return
+	}
+	// the indexes of the previous external id and height are replaced
+	k.unindexTransferRecord(ctx, record)
+	record.Status, record.Sequence, record.Error = status, sequence, errMsg
+	k.setTransferRecord(ctx, record)
+}
+
+// setTransferRecord stores the record at the current height and emits an event reporting its status
+func (k Keeper) setTransferRecord(ctx sdk.Context, record types.TransferRecord) {
+	record.UpdatedHeight = ctx.BlockHeight()
+	k.indexTransferRecord(ctx, record)
+	ctx.EventManager().EmitEvent(types.NewTransferRecordEvent(record))
+}
+
+// indexTransferRecord stores the record and maintains the address and external id indexes and the prune queue
+func (k Keeper) indexTransferRecord(ctx sdk.Context, record types.TransferRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TransferRecordKey(record.Id), k.cdc.MustMarshal(&record))
+	for _, address := range []string{record.Sender, record.Receiver} {
+		if addr, ok := types.AddressBytes(address); ok {
+			store.Set(types.TransferRecordByAddressKey(addr, record.Id), []byte{})
+		}
+	}
+	if record.Sequence != 0 {
+		store.Set(
+			types.TransferRecordByExternalIDKey(record.Direction, record.Origin, record.Channel, record.Sequence),
+			sdk.Uint64ToBigEndian(record.Id),
+		)
+	}
+	if isFinalTransferStatus(record.Status) {
+		store.Set(types.TransferRecordPruneQueueKey(record.UpdatedHeight, record.Id), []byte{})
+	}
+}
+
+// unindexTransferRecord removes the external id index and the prune queue entry of the record
+func (k Keeper) unindexTransferRecord(ctx sdk.Context, record types.TransferRecord) {
+	store := ctx.KVStore(k.storeKey)
+	if record.Sequence != 0 {
+		store.Delete(types.TransferRecordByExternalIDKey(record.Direction, record.Origin, record.Channel, record.Sequence))
+	}
+	if isFinalTransferStatus(record.Status) {
+		store.Delete(types.TransferRecordPruneQueueKey(record.UpdatedHeight, record.Id))
+	}
+}
+
+// deleteTransferRecord removes the record and its indexes from the transfer ledger
+func (k Keeper) deleteTransferRecord(ctx sdk.Context, record types.TransferRecord) {
+	store := ctx.KVStore(k.storeKey)
+	for _, address := range []string{record.Sender, record.Receiver} {
+		if addr, ok := types.AddressBytes(address); ok {
+			store.Delete(types.TransferRecordByAddressKey(addr, record.Id))
+		}
+	}
+	if record.Sequence != 0 {
+		store.Delete(types.TransferRecordByExternalIDKey(record.Direction, record.Origin, record.Channel, record.Sequence))
+	}
+	store.Delete(types.TransferRecordKey(record.Id))
+}
+
+// PruneTransferRecords removes the finalized records older than the retention period from the transfer ledger
+func (k Keeper) PruneTransferRecords(ctx sdk.Context) {
+	retention := k.GetParams(ctx).TransferRecordRetention
+	if retention == 0 || uint64(ctx.BlockHeight()) <= retention {
+		return
+	}
+	cutoff := ctx.BlockHeight() - int64(retention)
+
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iter := store.Iterator(types.KeyPrefixTransferRecordPruneQueue, types.TransferRecordPruneQueueKey(cutoff, 0))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		id := sdk.BigEndianToUint64(key[len(key)-8:])
+		record, found := k.GetTransferRecord(ctx, id)
+		if !found {
+			continue
+		}
+		height := int64(sdk.BigEndianToUint64(key[1 : len(key)-8]))
+		// the record may have been updated after it was queued
+		if record.UpdatedHeight == height {
+			k.deleteTransferRecord(ctx, record)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+func (suite *KeeperTestSuite) TestTransferRecords() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+
+	address := common.BigToAddress(big.NewInt(1))
+	cosmosAddress := sdk.AccAddress(address.Bytes())
+	amount := sdk.NewCoin("snp", sdk.NewInt(1))
+
+	for i := uint64(1); i <= 3; i++ {
+		id := keeper.AppendTransferRecord(suite.ctx, types.TransferRecord{
+			Direction: types.TransferDirectionOutbound,
+			Origin:    types.TransferOriginIBC,
+			Channel:   "channel-0",
+			Sequence:  i,
+			Sender:    cosmosAddress.String(),
+			Receiver:  "destination",
+			Amount:    amount,
+			Status:    types.TransferStatusPending,
+		})
+		suite.Require().Equal(i, id)
+	}
+	keeper.AppendTransferRecord(suite.ctx, types.TransferRecord{
+		Direction: types.TransferDirectionInbound,
+		Origin:    types.TransferOriginGravity,
+		Sequence:  1,
+		Sender:    common.BigToAddress(big.NewInt(2)).Hex(),
+		Receiver:  address.Hex(),
+		Amount:    amount,
+		Status:    types.TransferStatusCompleted,
+	})
+
+	// the same account is found with the bech32 and the hex address
+	for _, addr := range []string{cosmosAddress.String(), address.Hex()} {
+		rsp, err := keeper.TransferRecordsByAddress(sdk.WrapSDKContext(suite.ctx), &types.TransferRecordsByAddressRequest{
+			Address: addr,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(rsp.Records, 4)
+	}
+
+	rsp, err := keeper.TransferRecordsByAddress(sdk.WrapSDKContext(suite.ctx), &types.TransferRecordsByAddressRequest{
+		Address:    address.Hex(),
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(rsp.Records, 3)
+	suite.Require().Equal(uint64(4), rsp.Pagination.Total)
+
+	_, err = keeper.TransferRecordsByAddress(sdk.WrapSDKContext(suite.ctx), &types.TransferRecordsByAddressRequest{
+		Address: "invalid",
+	})
+	suite.Require().Error(err)
+
+	record, found := keeper.GetTransferRecordByExternalID(suite.ctx, types.TransferDirectionInbound, types.TransferOriginGravity, "", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(4), record.Id)
+
+	// ibc acknowledgement and timeout
+	keeper.OnTransferPacketResult(suite.ctx, "channel-0", 1, true, "")
+	keeper.OnTransferPacketResult(suite.ctx, "channel-0", 2, false, "packet timed out")
+	record, _ = keeper.GetTransferRecord(suite.ctx, 1)
+	suite.Require().Equal(types.TransferStatusCompleted, record.Status)
+	record, _ = keeper.GetTransferRecord(suite.ctx, 2)
+	suite.Require().Equal(types.TransferStatusRefunded, record.Status)
+	suite.Require().Equal("packet timed out", record.Error)
+
+	// pruning is disabled by default
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 100)
+	keeper.PruneTransferRecords(ctx)
+	_, found = keeper.GetTransferRecord(ctx, 1)
+	suite.Require().True(found)
+
+	params := keeper.GetParams(ctx)
+	params.TransferRecordRetention = 10
+	keeper.SetParams(ctx, params)
+	keeper.PruneTransferRecords(ctx)
+
+	// only the pending record is kept
+	for id := uint64(1); id <= 4; id++ {
+		_, found = keeper.GetTransferRecord(ctx, id)
+		suite.Require().Equal(id == 3, found)
+	}
+	_, found = keeper.GetTransferRecordByExternalID(ctx, types.TransferDirectionInbound, types.TransferOriginGravity, "", 1)
+	suite.Require().False(found)
+	records, _, err := keeper.GetTransferRecordsByAddress(ctx, address.Bytes(), nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 1)
+}
+
+func (suite *KeeperTestSuite) TestOnTransferPacketReceived() {
+	suite.SetupTest()
+	keeper := suite.app.SeeleKeeper
+
+	packet := channeltypes.Packet{
+		Sequence:           3,
+		SourcePort:         ibctransfertypes.PortID,
+		SourceChannel:      "channel-7",
+		DestinationPort:    ibctransfertypes.PortID,
+		DestinationChannel: "channel-0",
+	}
+	receiver := sdk.AccAddress(common.BigToAddress(big.NewInt(2)).Bytes())
+
+	// a token of the sender chain is received as a voucher of the destination channel
+	keeper.OnTransferPacketReceived(suite.ctx, packet, ibctransfertypes.NewFungibleTokenPacketData("basecro", 5, "sender", receiver.String()))
+	record, found := keeper.GetTransferRecordByExternalID(suite.ctx, types.TransferDirectionInbound, types.TransferOriginIBC, "channel-0", 3)
+	suite.Require().True(found)
+	voucher := ibctransfertypes.ParseDenomTrace("transfer/channel-0/basecro").IBCDenom()
+	suite.Require().Equal(sdk.NewInt64Coin(voucher, 5), record.Amount)
+	suite.Require().Equal(types.TransferStatusCompleted, record.Status)
+	suite.Require().Equal(receiver.String(), record.Receiver)
+
+	// a token of this chain coming back is unescrowed
+	packet.Sequence = 4
+	keeper.OnTransferPacketReceived(suite.ctx, packet, ibctransfertypes.NewFungibleTokenPacketData("transfer/channel-7/basetcro", 6, "sender", receiver.String()))
+	record, found = keeper.GetTransferRecordByExternalID(suite.ctx, types.TransferDirectionInbound, types.TransferOriginIBC, "channel-0", 4)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt64Coin("basetcro", 6), record.Amount)
+
+	records, _, err := keeper.GetTransferRecordsByAddress(suite.ctx, receiver, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 2)
+}
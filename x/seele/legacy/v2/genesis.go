@@ -0,0 +1,36 @@
+package v2
+
+import (
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+// MigrateGenesis migrates the genesis state from v1 to v2, the v1 state is decoded with the v2 types since
+// v2 only added fields. The migration includes:
+//
+// - Setting the params added since v1 to their default values.
+//
+// The token mappings, the predeployed denoms, the ethereum transfers, the records of the transfer ledger,
+// the quarantined and the pending deposits and the pending approvals are kept along with their last ids.
+func MigrateGenesis(oldState types.GenesisState) *types.GenesisState {
+	params := types.DefaultParams()
+	params.IbcCroDenom = oldState.Params.IbcCroDenom
+	params.IbcTimeout = oldState.Params.IbcTimeout
+	params.SeeleAdmin = oldState.Params.SeeleAdmin
+	params.EnableAutoDeployment = oldState.Params.EnableAutoDeployment
+
+	return &types.GenesisState{
+		Params:                    params,
+		ExternalContracts:         oldState.ExternalContracts,
+		AutoContracts:             oldState.AutoContracts,
+		PredeployedDenoms:         oldState.PredeployedDenoms,
+		EthereumTransfers:         oldState.EthereumTransfers,
+		LastEthereumTransferId:    oldState.LastEthereumTransferId,
+		TransferRecords:           oldState.TransferRecords,
+		LastTransferRecordId:      oldState.LastTransferRecordId,
+		QuarantinedDeposits:       oldState.QuarantinedDeposits,
+		LastQuarantinedDepositId:  oldState.LastQuarantinedDepositId,
+		PendingTokenDeposits:      oldState.PendingTokenDeposits,
+		LastPendingTokenDepositId: oldState.LastPendingTokenDepositId,
+		PendingTokenApprovals:     oldState.PendingTokenApprovals,
+	}
+}
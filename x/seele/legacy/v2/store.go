@@ -0,0 +1,23 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The migration includes:
+//
+// - Setting the params added since v1 to their default values.
+//
+// The stores of the transfers, the records and the deposits are new and start empty.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !paramSpace.Has(ctx, pair.Key) {
+			paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	return nil
+}
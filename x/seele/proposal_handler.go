@@ -11,6 +11,7 @@ import (
 )
 
 // NewTokenMappingChangeProposalHandler creates a new governance Handler for a TokenMappingChangeProposal
+// a PendingTokenApprovalProposal and a QuarantinedDepositReleaseProposal
 func NewTokenMappingChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
@@ -22,8 +23,19 @@ func NewTokenMappingChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 				// update the mapping
 				contract := common.HexToAddress(c.Contract)
 				k.SetExternalContractForDenom(ctx, c.Denom, contract)
+				// the deposits waiting for the mapping are converted
+				k.ResolvePendingTokenDeposits(ctx, contract, true, false)
 			}
 			return nil
+		case *types.PendingTokenApprovalProposal:
+			k.ResolvePendingTokenDeposits(ctx, common.HexToAddress(c.TokenContract), true, c.ReleaseNative)
+			return nil
+		case *types.QuarantinedDepositReleaseProposal:
+			receiver, err := types.ParseCosmosReceiver(c.Receiver)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			return k.ReleaseQuarantinedDeposit(ctx, c.Id, receiver)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized seele proposal content type: %T", c)
 		}
@@ -0,0 +1,81 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding seele type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixDenomToExternalContract),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefixDenomToAutoContract),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefixContractNameToContractAddress):
+			return fmt.Sprintf("%s\n%s", common.BytesToAddress(kvA.Value).Hex(), common.BytesToAddress(kvB.Value).Hex())
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixContractToDenom),
+			bytes.HasPrefix(kvA.Key, types.KeyprefixExternalContractToDenom):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixEthereumTransfer):
+			var transferA, transferB types.EthereumTransfer
+			cdc.MustUnmarshal(kvA.Value, &transferA)
+			cdc.MustUnmarshal(kvB.Value, &transferB)
+			return fmt.Sprintf("%v\n%v", transferA, transferB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixTransferRecord):
+			var recordA, recordB types.TransferRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixQuarantinedDeposit):
+			var depositA, depositB types.QuarantinedDeposit
+			cdc.MustUnmarshal(kvA.Value, &depositA)
+			cdc.MustUnmarshal(kvB.Value, &depositB)
+			return fmt.Sprintf("%v\n%v", depositA, depositB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixPendingTokenDeposit):
+			var depositA, depositB types.PendingTokenDeposit
+			cdc.MustUnmarshal(kvA.Value, &depositA)
+			cdc.MustUnmarshal(kvB.Value, &depositB)
+			return fmt.Sprintf("%v\n%v", depositA, depositB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixPendingTokenApproval):
+			var approvalA, approvalB types.PendingTokenApproval
+			cdc.MustUnmarshal(kvA.Value, &approvalA)
+			cdc.MustUnmarshal(kvB.Value, &approvalB)
+			return fmt.Sprintf("%v\n%v", approvalA, approvalB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixPendingEthereumTransfer):
+			return fmt.Sprintf("%d %d\n%d %d", sdk.BigEndianToUint64(kvA.Value[:8]), sdk.BigEndianToUint64(kvA.Value[8:]),
+				sdk.BigEndianToUint64(kvB.Value[:8]), sdk.BigEndianToUint64(kvB.Value[8:]))
+
+		// the indexes and the queues only hold the key
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixEthereumTransferQueue),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefixTransferRecordByAddress),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefixTransferRecordPruneQueue):
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixTransferRecordByExternalID),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefixBatchedEthereumTransfer),
+			bytes.Equal(kvA.Key, types.KeyLastEthereumTransferID),
+			bytes.Equal(kvA.Key, types.KeyLastTransferRecordID),
+			bytes.Equal(kvA.Key, types.KeyLastQuarantinedDepositID),
+			bytes.Equal(kvA.Key, types.KeyLastPendingTokenDepositID):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid seele key prefix %X", kvA.Key))
+		}
+	}
+}
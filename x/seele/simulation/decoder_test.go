@@ -0,0 +1,74 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seele-N/Seele/x/seele/simulation"
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	denom := "ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0865"
+	contract := common.HexToAddress("0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503")
+	record := types.TransferRecord{Id: 1, Direction: types.TransferDirectionInbound, Amount: sdk.NewInt64Coin(denom, 10)}
+	transfer := types.EthereumTransfer{Id: 2, Sender: "sender", Amount: sdk.NewInt64Coin(denom, 5), BridgeFee: sdk.NewInt64Coin(denom, 1)}
+	quarantined := types.QuarantinedDeposit{Id: 3, EventNonce: 7, Amount: sdk.NewInt64Coin(denom, 3)}
+	pending := types.PendingTokenDeposit{Id: 4, TokenContract: contract.Hex(), Amount: sdk.NewInt64Coin(denom, 4)}
+	approval := types.PendingTokenApproval{TokenContract: contract.Hex(), AutoDeploy: true}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.DenomToExternalContractKey(denom), Value: contract.Bytes()},
+			{Key: types.ExternalContractToDenomKey(contract.Hex()), Value: []byte(denom)},
+			{Key: types.TransferRecordKey(record.Id), Value: cdc.MustMarshal(&record)},
+			{Key: types.EthereumTransferKey(transfer.Id), Value: cdc.MustMarshal(&transfer)},
+			{Key: types.QuarantinedDepositKey(quarantined.Id), Value: cdc.MustMarshal(&quarantined)},
+			{Key: types.PendingTokenDepositKey(contract, pending.Id), Value: cdc.MustMarshal(&pending)},
+			{Key: types.PendingTokenApprovalKey(contract), Value: cdc.MustMarshal(&approval)},
+			{Key: types.EthereumTransferQueueKey(2), Value: []byte{}},
+			{Key: types.PendingEthereumTransferKey(contract, 8), Value: append(sdk.Uint64ToBigEndian(2), sdk.Uint64ToBigEndian(5)...)},
+			{Key: types.KeyLastTransferRecordID, Value: sdk.Uint64ToBigEndian(1)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"DenomToExternalContract", fmt.Sprintf("%s\n%s", contract.Hex(), contract.Hex())},
+		{"ExternalContractToDenom", fmt.Sprintf("%s\n%s", denom, denom)},
+		{"TransferRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"EthereumTransfer", fmt.Sprintf("%v\n%v", transfer, transfer)},
+		{"QuarantinedDeposit", fmt.Sprintf("%v\n%v", quarantined, quarantined)},
+		{"PendingTokenDeposit", fmt.Sprintf("%v\n%v", pending, pending)},
+		{"PendingTokenApproval", fmt.Sprintf("%v\n%v", approval, approval)},
+		{"EthereumTransferQueue", fmt.Sprintf("%X\n%X", types.EthereumTransferQueueKey(2), types.EthereumTransferQueueKey(2))},
+		{"PendingEthereumTransfer", "2 5\n2 5"},
+		{"LastTransferRecordID", "1\n1"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+// Simulation parameter constants
+const (
+	IbcCroDenom             = "ibc_cro_denom"
+	IbcTimeout              = "ibc_timeout"
+	TransferRecordRetention = "transfer_record_retention"
+	ExternalContracts       = "external_contracts"
+	AutoContracts           = "auto_contracts"
+)
+
+// GenIbcDenom randomized ibc voucher denom received on one of the first channels
+func GenIbcDenom(r *rand.Rand) string {
+	trace := ibctransfertypes.DenomTrace{
+		Path:      fmt.Sprintf("%s/channel-%d", ibctransfertypes.PortID, r.Intn(10)),
+		BaseDenom: "base" + simtypes.RandStringOfLength(r, 5),
+	}
+	return trace.IBCDenom()
+}
+
+// GenIbcTimeout randomized IbcTimeout, between one minute and one day
+func GenIbcTimeout(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 60, 86400)) * 1e9
+}
+
+// GenTransferRecordRetention randomized TransferRecordRetention, the records are kept forever half of the time
+func GenTransferRecordRetention(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// GenContractAddress randomized contract address
+func GenContractAddress(r *rand.Rand) common.Address {
+	return common.BytesToAddress(simtypes.RandomAccounts(r, 1)[0].Address)
+}
+
+// GenTokenMappings randomized mappings of ibc denoms to contracts
+func GenTokenMappings(r *rand.Rand) []types.TokenMapping {
+	var mappings []types.TokenMapping
+	n := r.Intn(4)
+	for i := 0; i < n; i++ {
+		mappings = append(mappings, types.TokenMapping{
+			Denom:    GenIbcDenom(r),
+			Contract: GenContractAddress(r).Hex(),
+		})
+	}
+	return mappings
+}
+
+// RandomizedGenState generates a random GenesisState for seele. The accounts are funded with the
+// ibc voucher of the params so that the conversions can be simulated.
+func RandomizedGenState(simState *module.SimulationState) {
+	var ibcCroDenom string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, IbcCroDenom, &ibcCroDenom, simState.Rand,
+		func(r *rand.Rand) { ibcCroDenom = GenIbcDenom(r) },
+	)
+
+	var ibcTimeout uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, IbcTimeout, &ibcTimeout, simState.Rand,
+		func(r *rand.Rand) { ibcTimeout = GenIbcTimeout(r) },
+	)
+
+	var transferRecordRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TransferRecordRetention, &transferRecordRetention, simState.Rand,
+		func(r *rand.Rand) { transferRecordRetention = GenTransferRecordRetention(r) },
+	)
+
+	var externalContracts []types.TokenMapping
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ExternalContracts, &externalContracts, simState.Rand,
+		func(r *rand.Rand) { externalContracts = GenTokenMappings(r) },
+	)
+
+	var autoContracts []types.TokenMapping
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoContracts, &autoContracts, simState.Rand,
+		func(r *rand.Rand) { autoContracts = GenTokenMappings(r) },
+	)
+
+	params := types.DefaultParams()
+	params.IbcCroDenom = ibcCroDenom
+	params.IbcTimeout = ibcTimeout
+	params.TransferRecordRetention = transferRecordRetention
+	// the token mappings are updated by the admin
+	if len(simState.Accounts) > 0 {
+		params.SeeleAdmin = simState.Accounts[simState.Rand.Intn(len(simState.Accounts))].Address.String()
+	}
+
+	seeleGenesis := types.GenesisState{
+		Params:            params,
+		ExternalContracts: externalContracts,
+		AutoContracts:     autoContracts,
+	}
+
+	bz, err := json.MarshalIndent(&seeleGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated seele parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&seeleGenesis)
+
+	fundIbcVouchers(simState, ibcCroDenom)
+}
+
+// fundIbcVouchers adds ibc vouchers to the balances of some accounts in the bank genesis
+func fundIbcVouchers(simState *module.SimulationState, denom string) {
+	bankStateBz, ok := simState.GenState[banktypes.ModuleName]
+	if !ok {
+		return
+	}
+	var bankState banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(bankStateBz, &bankState)
+
+	funded := make(map[string]bool)
+	for _, acc := range simState.Accounts {
+		if simState.Rand.Intn(2) == 0 {
+			funded[acc.Address.String()] = true
+		}
+	}
+
+	total := sdk.ZeroInt()
+	for i, balance := range bankState.Balances {
+		if !funded[balance.Address] {
+			continue
+		}
+		amount := sdk.NewInt(int64(simtypes.RandIntBetween(simState.Rand, 1, 1_000_000_000)))
+		bankState.Balances[i].Coins = balance.Coins.Add(sdk.NewCoin(denom, amount))
+		total = total.Add(amount)
+	}
+	if !total.IsPositive() {
+		return
+	}
+	if !bankState.Supply.Empty() {
+		bankState.Supply = bankState.Supply.Add(sdk.NewCoin(denom, total))
+	}
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankState)
+}
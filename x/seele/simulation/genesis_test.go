@@ -0,0 +1,52 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Seele-N/Seele/x/seele/simulation"
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+// TestRandomizedGenState checks the randomized genesis is valid and the vouchers are added to the bank supply.
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	r := rand.New(rand.NewSource(1))
+	accounts := simtypes.RandomAccounts(r, 10)
+
+	var balances []banktypes.Balance
+	for _, acc := range accounts {
+		balances = append(balances, banktypes.Balance{Address: acc.Address.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))})
+	}
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultParams(), balances, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), nil)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		Accounts:     accounts,
+		InitialStake: 100,
+		GenState:     map[string]json.RawMessage{banktypes.ModuleName: cdc.MustMarshalJSON(bankGenesis)},
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var seeleGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &seeleGenesis)
+	require.NoError(t, seeleGenesis.Validate())
+	require.True(t, types.IsValidIBCDenom(seeleGenesis.Params.IbcCroDenom))
+
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], bankGenesis)
+	require.NoError(t, bankGenesis.Validate())
+	require.True(t, bankGenesis.Supply.AmountOf(seeleGenesis.Params.IbcCroDenom).IsPositive())
+}
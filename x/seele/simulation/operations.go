@@ -0,0 +1,217 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/Seele-N/Seele/x/seele/keeper"
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgConvertVouchers    = "op_weight_msg_convert_vouchers"
+	OpWeightMsgTransferTokens     = "op_weight_msg_transfer_tokens"
+	OpWeightMsgUpdateTokenMapping = "op_weight_msg_update_token_mapping"
+)
+
+// Default simulation operation weights
+const (
+	DefaultWeightMsgConvertVouchers    = 50
+	DefaultWeightMsgTransferTokens     = 30
+	DefaultWeightMsgUpdateTokenMapping = 10
+)
+
+// MockTransferKeeper accepts all the ibc transfers without sending any packet,
+// the vouchers are all traced to the first channel of the transfer port.
+type MockTransferKeeper struct{}
+
+var _ types.TransferKeeper = MockTransferKeeper{}
+
+// SendTransfer implements the TransferKeeper interface
+func (MockTransferKeeper) SendTransfer(_ sdk.Context, _, _ string, _ sdk.Coin, _ sdk.AccAddress, _ string, _ clienttypes.Height, _ uint64) error {
+	return nil
+}
+
+// GetDenomTrace implements the TransferKeeper interface
+func (MockTransferKeeper) GetDenomTrace(_ sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool) {
+	return ibctransfertypes.DenomTrace{
+		Path:      ibctransfertypes.PortID + "/channel-0",
+		BaseDenom: strings.ToLower(denomTraceHash.String()),
+	}, true
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgConvertVouchers, weightMsgTransferTokens, weightMsgUpdateTokenMapping int
+	appParams.GetOrGenerate(cdc, OpWeightMsgConvertVouchers, &weightMsgConvertVouchers, nil,
+		func(_ *rand.Rand) {
+			weightMsgConvertVouchers = DefaultWeightMsgConvertVouchers
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgTransferTokens, &weightMsgTransferTokens, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferTokens = DefaultWeightMsgTransferTokens
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateTokenMapping, &weightMsgUpdateTokenMapping, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateTokenMapping = DefaultWeightMsgUpdateTokenMapping
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgConvertVouchers,
+			SimulateMsgConvertVouchers(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgTransferTokens,
+			SimulateMsgTransferTokens(ak, bk, k.WithTransferKeeper(MockTransferKeeper{})),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateTokenMapping,
+			SimulateMsgUpdateTokenMapping(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgConvertVouchers generates a MsgConvertVouchers converting a random amount
+// of the ibc vouchers of the params to evm coins.
+func SimulateMsgConvertVouchers(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom := k.GetParams(ctx).IbcCroDenom
+		simAccount, balance := randomAccountWithBalance(r, ctx, bk, accs, denom, sdk.OneInt())
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertVouchers, "no ibc vouchers to convert"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertVouchers, "unable to generate amount"), nil, err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+
+		msg := types.NewMsgConvertVouchers(simAccount.Address.String(), coins)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: coins,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgTransferTokens executes a MsgTransferTokens sending a random amount of evm coins back through ibc.
+// The message is executed directly against a keeper using a mocked transfer keeper as the simulation has no ibc channel.
+func SimulateMsgTransferTokens(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		evmDenom := k.GetEvmParams(ctx).EvmDenom
+		tenPowTen := sdk.NewIntFromBigInt(types.TenPowTen)
+		simAccount, balance := randomAccountWithBalance(r, ctx, bk, accs, evmDenom, tenPowTen)
+
+		// the evm coins are sent back as vouchers of 8 decimals, limited by the vouchers escrowed by the module
+		escrowed := bk.GetBalance(ctx, ak.GetModuleAccount(ctx, types.ModuleName).GetAddress(), params.IbcCroDenom).Amount
+		maxVouchers := sdk.MinInt(balance.Quo(tenPowTen), escrowed)
+		if !maxVouchers.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferTokens, "no evm coins to transfer"), nil, nil
+		}
+		vouchers, err := simtypes.RandPositiveInt(r, maxVouchers)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferTokens, "unable to generate amount"), nil, err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(evmDenom, vouchers.Mul(tenPowTen)))
+
+		msg := types.NewMsgTransferTokens(simAccount.Address.String(), simtypes.RandomAccounts(r, 1)[0].Address.String(), coins)
+		if err := msg.ValidateBasic(); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "invalid transfer"), nil, err
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := keeper.NewMsgServerImpl(k).TransferTokens(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), fmt.Sprintf("transfer failed: %s", err)), nil, err
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+	}
+}
+
+// SimulateMsgUpdateTokenMapping generates a MsgUpdateTokenMapping of the seele admin mapping a random ibc denom
+// to a random contract
+func SimulateMsgUpdateTokenMapping(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, err := sdk.AccAddressFromBech32(k.GetParams(ctx).SeeleAdmin)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateTokenMapping, "no seele admin"), nil, nil
+		}
+		simAccount, found := simtypes.FindAccount(accs, admin)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateTokenMapping, "seele admin is not a simulation account"), nil, nil
+		}
+
+		msg := types.NewMsgUpdateTokenMapping(simAccount.Address.String(), GenIbcDenom(r), GenContractAddress(r).Hex())
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomAccountWithBalance returns a random account holding at least the min amount of the denom,
+// the balance is zero if no account does.
+func randomAccountWithBalance(
+	r *rand.Rand, ctx sdk.Context, bk types.BankKeeper, accs []simtypes.Account, denom string, min sdk.Int,
+) (simtypes.Account, sdk.Int) {
+	offset := r.Intn(len(accs))
+	for i := range accs {
+		acc := accs[(offset+i)%len(accs)]
+		balance := bk.SpendableCoins(ctx, acc.Address).AmountOf(denom)
+		if balance.GTE(min) {
+			return acc, balance
+		}
+	}
+	return accs[offset], sdk.ZeroInt()
+}
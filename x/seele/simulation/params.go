@@ -0,0 +1,30 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyIbcTimeout),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenIbcTimeout(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyTransferRecordRetention),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenTransferRecordRetention(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization = &ConvertAuthorization{}
+	_ authz.Authorization = &BridgeTransferAuthorization{}
+)
+
+// NewConvertAuthorization creates a new ConvertAuthorization object.
+func NewConvertAuthorization(spendLimit sdk.Coins) *ConvertAuthorization {
+	return &ConvertAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ConvertAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgConvertVouchers{})
+}
+
+// Accept implements Authorization.Accept. All the coins of the message are deducted from the limit,
+// including the ones a best effort conversion fails to convert.
+func (a ConvertAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mConvert, ok := msg.(*MsgConvertVouchers)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+	limitLeft, isNegative := a.SpendLimit.SafeSub(mConvert.Coins)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+	}
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &ConvertAuthorization{SpendLimit: limitLeft}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ConvertAuthorization) ValidateBasic() error {
+	return validateSpendLimit(a.SpendLimit)
+}
+
+// NewBridgeTransferAuthorization creates a new BridgeTransferAuthorization object.
+func NewBridgeTransferAuthorization(spendLimit sdk.Coins, allowedDestinations []string) *BridgeTransferAuthorization {
+	return &BridgeTransferAuthorization{
+		SpendLimit:          spendLimit,
+		AllowedDestinations: allowedDestinations,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a BridgeTransferAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgTransferTokens{})
+}
+
+// Accept implements Authorization.Accept.
+func (a BridgeTransferAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mTransfer, ok := msg.(*MsgTransferTokens)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+	if !a.isAllowedDestination(mTransfer.To) {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "destination %s is not allowed", mTransfer.To)
+	}
+	limitLeft, isNegative := a.SpendLimit.SafeSub(mTransfer.Coins)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+	}
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &BridgeTransferAuthorization{
+		SpendLimit:          limitLeft,
+		AllowedDestinations: a.AllowedDestinations,
+	}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a BridgeTransferAuthorization) ValidateBasic() error {
+	if err := validateSpendLimit(a.SpendLimit); err != nil {
+		return err
+	}
+	seen := make(map[string]bool)
+	for _, destination := range a.AllowedDestinations {
+		if destination == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "allowed destination cannot be empty")
+		}
+		if seen[destination] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicated allowed destination %s", destination)
+		}
+		seen[destination] = true
+	}
+	return nil
+}
+
+func (a BridgeTransferAuthorization) isAllowedDestination(destination string) bool {
+	if len(a.AllowedDestinations) == 0 {
+		return true
+	}
+	for _, allowed := range a.AllowedDestinations {
+		if allowed == destination {
+			return true
+		}
+	}
+	return false
+}
+
+func validateSpendLimit(spendLimit sdk.Coins) error {
+	if spendLimit.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit cannot be empty")
+	}
+	if !spendLimit.IsValid() || !spendLimit.IsAllPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit %s", spendLimit)
+	}
+	return nil
+}
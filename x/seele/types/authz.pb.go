@@ -0,0 +1,580 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: seele/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ConvertAuthorization allows the grantee to convert up to spend_limit ibc vouchers
+// of the granter to evm coins or SRC20 tokens.
+type ConvertAuthorization struct {
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *ConvertAuthorization) Reset()         { *m = ConvertAuthorization{} }
+func (m *ConvertAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ConvertAuthorization) ProtoMessage()    {}
+func (*ConvertAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11f1283728dae87e, []int{0}
+}
+func (m *ConvertAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvertAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertAuthorization.Merge(m, src)
+}
+func (m *ConvertAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvertAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertAuthorization proto.InternalMessageInfo
+
+func (m *ConvertAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+// BridgeTransferAuthorization allows the grantee to transfer up to spend_limit evm coins
+// of the granter through ibc, to one of the allowed destinations.
+type BridgeTransferAuthorization struct {
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// the receivers on the destination chain, any receiver is allowed when empty
+	AllowedDestinations []string `protobuf:"bytes,2,rep,name=allowed_destinations,json=allowedDestinations,proto3" json:"allowed_destinations,omitempty"`
+}
+
+func (m *BridgeTransferAuthorization) Reset()         { *m = BridgeTransferAuthorization{} }
+func (m *BridgeTransferAuthorization) String() string { return proto.CompactTextString(m) }
+func (*BridgeTransferAuthorization) ProtoMessage()    {}
+func (*BridgeTransferAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11f1283728dae87e, []int{1}
+}
+func (m *BridgeTransferAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeTransferAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeTransferAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeTransferAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeTransferAuthorization.Merge(m, src)
+}
+func (m *BridgeTransferAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeTransferAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeTransferAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeTransferAuthorization proto.InternalMessageInfo
+
+func (m *BridgeTransferAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *BridgeTransferAuthorization) GetAllowedDestinations() []string {
+	if m != nil {
+		return m.AllowedDestinations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ConvertAuthorization)(nil), "seele.ConvertAuthorization")
+	proto.RegisterType((*BridgeTransferAuthorization)(nil), "seele.BridgeTransferAuthorization")
+}
+
+func init() { proto.RegisterFile("seele/authz.proto", fileDescriptor_11f1283728dae87e) }
+
+var fileDescriptor_11f1283728dae87e = []byte{
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x91, 0xb1, 0x4e, 0x02, 0x31,
+	0x18, 0xc7, 0xef, 0x24, 0x9a, 0x58, 0xc2, 0x00, 0x32, 0x00, 0x26, 0x85, 0xb0, 0xc8, 0xc2, 0x55,
+	0x74, 0x73, 0x13, 0x1c, 0x9d, 0x88, 0x93, 0x0b, 0xe9, 0x71, 0x9f, 0x47, 0xe3, 0xd1, 0x8f, 0xb4,
+	0x05, 0x95, 0xa7, 0x70, 0xf3, 0x1d, 0x9c, 0x7d, 0x08, 0x46, 0x74, 0x72, 0x52, 0x03, 0x2f, 0x62,
+	0xae, 0xed, 0xa0, 0x89, 0xbb, 0x53, 0xfb, 0x7d, 0xbf, 0xf4, 0x9f, 0x5f, 0xf3, 0x27, 0x65, 0x0d,
+	0x90, 0x01, 0xe3, 0x73, 0x33, 0x59, 0x46, 0x33, 0x85, 0x06, 0x2b, 0xbb, 0x76, 0xd5, 0xa8, 0xa6,
+	0x98, 0xa2, 0xdd, 0xb0, 0xfc, 0xe6, 0x60, 0xa3, 0x3e, 0x46, 0x3d, 0x45, 0x3d, 0x72, 0xc0, 0x0d,
+	0x1e, 0x51, 0x37, 0xb1, 0x98, 0x6b, 0x60, 0x8b, 0x5e, 0x0c, 0x86, 0xf7, 0xd8, 0x18, 0x85, 0x74,
+	0xbc, 0xfd, 0x14, 0x92, 0xea, 0x00, 0xe5, 0x02, 0x94, 0x39, 0x9f, 0x9b, 0x09, 0x2a, 0xb1, 0xe4,
+	0x46, 0xa0, 0xac, 0x64, 0xa4, 0xa8, 0x67, 0x20, 0x93, 0x51, 0x26, 0xa6, 0xc2, 0xd4, 0xc2, 0x56,
+	0xa1, 0x53, 0x3c, 0xa9, 0x47, 0x3e, 0x3c, 0x8f, 0x8b, 0x7c, 0x5c, 0x34, 0x40, 0x21, 0xfb, 0xc7,
+	0xab, 0x8f, 0x66, 0xf0, 0xfc, 0xd9, 0xec, 0xa4, 0xc2, 0x4c, 0xe6, 0x71, 0x34, 0xc6, 0xa9, 0x37,
+	0xf1, 0x47, 0x57, 0x27, 0xb7, 0xcc, 0x3c, 0xcc, 0x40, 0xdb, 0x07, 0x7a, 0x48, 0x6c, 0xfe, 0x65,
+	0x1e, 0x7f, 0x56, 0x7e, 0x7b, 0xe9, 0x96, 0x7e, 0x09, 0xb4, 0x5f, 0x43, 0x72, 0xd8, 0x57, 0x22,
+	0x49, 0xe1, 0x4a, 0x71, 0xa9, 0x6f, 0x40, 0xfd, 0xa3, 0x60, 0xa5, 0x47, 0xaa, 0x3c, 0xcb, 0xf0,
+	0x0e, 0x92, 0x51, 0x02, 0xda, 0x08, 0x69, 0x25, 0x74, 0x6d, 0xa7, 0x55, 0xe8, 0xec, 0x0f, 0x0f,
+	0x3c, 0xbb, 0xf8, 0x81, 0xfe, 0xf8, 0x53, 0xff, 0x68, 0xb5, 0xa1, 0xe1, 0x7a, 0x43, 0xc3, 0xaf,
+	0x0d, 0x0d, 0x1f, 0xb7, 0x34, 0x58, 0x6f, 0x69, 0xf0, 0xbe, 0xa5, 0xc1, 0x75, 0xe9, 0x9e, 0xb9,
+	0xd2, 0xad, 0x40, 0xbc, 0x67, 0xdb, 0x39, 0xfd, 0x1e, 0x00, 0x5e, 0x9c, 0xcc, 0xae, 0x0a, 0x02,
+	0x00, 0x00,
+}
+
+func (m *ConvertAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvertAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeTransferAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeTransferAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeTransferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDestinations) > 0 {
+		for iNdEx := len(m.AllowedDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDestinations[iNdEx])
+			copy(dAtA[i:], m.AllowedDestinations[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedDestinations[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConvertAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeTransferAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedDestinations) > 0 {
+		for _, s := range m.AllowedDestinations {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConvertAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvertAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvertAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeTransferAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeTransferAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeTransferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDestinations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDestinations = append(m.AllowedDestinations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
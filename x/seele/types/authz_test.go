@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAuthorization(t *testing.T) {
+	address := sdk.AccAddress(common.HexToAddress("0x3A5b8a1C4e6D0f2B9c7E1d3F5a7B9c1D3e5F7a9B").Bytes()).String()
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	auth := types.NewConvertAuthorization(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "/seele.MsgConvertVouchers", auth.MsgTypeURL())
+
+	// the limit is decreased by the converted coins
+	resp, err := auth.Accept(sdk.Context{}, types.NewMsgConvertVouchers(address, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(60)))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, types.NewConvertAuthorization(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(40)))), resp.Updated)
+
+	// the limit can't be exceeded, neither in amount nor in denom
+	_, err = auth.Accept(sdk.Context{}, types.NewMsgConvertVouchers(address, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(101)))))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	_, err = auth.Accept(sdk.Context{}, types.NewMsgConvertVouchers(address, sdk.NewCoins(sdk.NewCoin("snp", sdk.NewInt(1)))))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// the authorization is deleted once exhausted
+	resp, err = auth.Accept(sdk.Context{}, types.NewMsgConvertVouchers(address, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	_, err = auth.Accept(sdk.Context{}, types.NewMsgTransferTokens(address, address, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1)))))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	require.Error(t, types.NewConvertAuthorization(sdk.NewCoins()).ValidateBasic())
+	require.Error(t, types.NewConvertAuthorization(sdk.Coins{sdk.Coin{Denom: denom, Amount: sdk.ZeroInt()}}).ValidateBasic())
+}
+
+func TestBridgeTransferAuthorization(t *testing.T) {
+	address := sdk.AccAddress(common.HexToAddress("0x3A5b8a1C4e6D0f2B9c7E1d3F5a7B9c1D3e5F7a9B").Bytes()).String()
+	limit := sdk.NewCoins(sdk.NewCoin("snp", sdk.NewInt(100)))
+	destinations := []string{"cosmos1allowed", "cosmos1other"}
+	auth := types.NewBridgeTransferAuthorization(limit, destinations)
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "/seele.MsgTransferTokens", auth.MsgTypeURL())
+
+	// the destinations are kept in the updated authorization
+	resp, err := auth.Accept(sdk.Context{}, types.NewMsgTransferTokens(address, "cosmos1allowed", sdk.NewCoins(sdk.NewCoin("snp", sdk.NewInt(30)))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.Equal(t, types.NewBridgeTransferAuthorization(sdk.NewCoins(sdk.NewCoin("snp", sdk.NewInt(70))), destinations), resp.Updated)
+
+	_, err = auth.Accept(sdk.Context{}, types.NewMsgTransferTokens(address, "cosmos1unknown", sdk.NewCoins(sdk.NewCoin("snp", sdk.NewInt(30)))))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = auth.Accept(sdk.Context{}, types.NewMsgTransferTokens(address, "cosmos1allowed", sdk.NewCoins(sdk.NewCoin("snp", sdk.NewInt(101)))))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// any destination is allowed without restriction
+	resp, err = types.NewBridgeTransferAuthorization(limit, nil).Accept(sdk.Context{}, types.NewMsgTransferTokens(address, "cosmos1unknown", limit))
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+
+	require.Error(t, types.NewBridgeTransferAuthorization(limit, []string{""}).ValidateBasic())
+	require.Error(t, types.NewBridgeTransferAuthorization(limit, []string{"cosmos1allowed", "cosmos1allowed"}).ValidateBasic())
+	require.Error(t, types.NewBridgeTransferAuthorization(nil, destinations).ValidateBasic())
+}
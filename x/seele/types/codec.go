@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	// this line is used by starport scaffolding # 1
 )
@@ -12,6 +13,8 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	// this line is used by starport scaffolding # 2
 	cdc.RegisterConcrete(&TokenMappingChangeProposal{}, "seele/TokenMappingChangeProposal", nil)
+	cdc.RegisterConcrete(&PendingTokenApprovalProposal{}, "seele/PendingTokenApprovalProposal", nil)
+	cdc.RegisterConcrete(&QuarantinedDepositReleaseProposal{}, "seele/QuarantinedDepositReleaseProposal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -19,12 +22,21 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&TokenMappingChangeProposal{},
+		&PendingTokenApprovalProposal{},
+		&QuarantinedDepositReleaseProposal{},
 	)
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgConvertVouchers{},
 		&MsgTransferTokens{},
 		&MsgUpdateTokenMapping{},
+		&MsgConvertSRC20{},
+	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&ConvertAuthorization{},
+		&BridgeTransferAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -10,6 +10,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 // ByteString is a byte array that serializes to hex
@@ -60,21 +61,34 @@ var (
 	EVMModuleAddress common.Address
 )
 
+// ModuleSRC20InitCode returns the creation code of the ModuleSRC20 contract named after name
+func ModuleSRC20InitCode(name string, decimals uint8) ([]byte, error) {
+	ctor, err := ModuleSRC20Contract.ABI.Pack("", name+" Token", name, decimals)
+	if err != nil {
+		return nil, err
+	}
+	initCode := make([]byte, 0, len(ModuleSRC20Contract.Bin)+len(ctor))
+	initCode = append(initCode, ModuleSRC20Contract.Bin...)
+	return append(initCode, ctor...), nil
+}
+
+// ModuleSRC20Salt returns the CREATE2 salt of the ModuleSRC20 contract of a denom
+func ModuleSRC20Salt(denom string) common.Hash {
+	return crypto.Keccak256Hash([]byte(denom))
+}
+
+// ModuleSRC20Address returns the address of the ModuleSRC20 contract of a denom deployed by the module with CREATE2,
+// it only depends on the denom, the name and the decimals so it is identical on every network
+func ModuleSRC20Address(denom, name string, decimals uint8) (common.Address, error) {
+	initCode, err := ModuleSRC20InitCode(name, decimals)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.CreateAddress2(EVMModuleAddress, ModuleSRC20Salt(denom), crypto.Keccak256(initCode)), nil
+}
+
 func init() {
 	EVMModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(EVMModuleName).Bytes())
-	/*
-		fmt.Printf("seele-evm module address:%s\n", EVMModuleAddress.String())
-		add := crypto.CreateAddress(EVMModuleAddress, 0)
-		fmt.Printf("noce=0;seele-evm module contract address:%s\n", add.String())
-		add = crypto.CreateAddress(EVMModuleAddress, 1)
-		fmt.Printf("noce=1;seele-evm module contract address:%s\n", add.String())
-		add = crypto.CreateAddress(EVMModuleAddress, 2)
-		fmt.Printf("noce=2;seele-evm module contract address:%s\n", add.String())
-		add = crypto.CreateAddress(EVMModuleAddress, 3)
-		fmt.Printf("noce=3;seele-evm module contract address:%s\n", add.String())
-		add = crypto.CreateAddress(EVMModuleAddress, 4)
-		fmt.Printf("noce=4;seele-evm module contract address:%s\n", add.String())
-	*/
 
 	err := json.Unmarshal(seeleERC20JSON, &ModuleSRC20Contract)
 	if err != nil {
@@ -10,12 +10,26 @@ const (
 	codeErrIbcCroDenomEmpty = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
 	codeErrIbcCroDenomInvalid
 	codeErrContractAddressInvalid
+	codeErrEthereumTransferNotFound
+	codeErrBridgeContractNotSet
+	codeErrTransferRecordNotFound
+	codeErrQuarantinedDepositNotFound
+	codeErrEvmOutOfGas
+	codeErrEvmCallFailed
+	codeErrEvmTracesNotFound
 )
 
 // x/seele module sentinel errors
 var (
-	ErrIbcCroDenomEmpty       = sdkerrors.Register(ModuleName, codeErrIbcCroDenomEmpty, "ibc seele denom is not set")
-	ErrIbcCroDenomInvalid     = sdkerrors.Register(ModuleName, codeErrIbcCroDenomInvalid, "ibc seele denom is invalid")
-	ErrContractAddressInvalid = sdkerrors.Register(ModuleName, codeErrContractAddressInvalid, "contract address invalid")
+	ErrIbcCroDenomEmpty           = sdkerrors.Register(ModuleName, codeErrIbcCroDenomEmpty, "ibc seele denom is not set")
+	ErrIbcCroDenomInvalid         = sdkerrors.Register(ModuleName, codeErrIbcCroDenomInvalid, "ibc seele denom is invalid")
+	ErrContractAddressInvalid     = sdkerrors.Register(ModuleName, codeErrContractAddressInvalid, "contract address invalid")
+	ErrEthereumTransferNotFound   = sdkerrors.Register(ModuleName, codeErrEthereumTransferNotFound, "ethereum transfer not found")
+	ErrBridgeContractNotSet       = sdkerrors.Register(ModuleName, codeErrBridgeContractNotSet, "gravity bridge contract is not set")
+	ErrTransferRecordNotFound     = sdkerrors.Register(ModuleName, codeErrTransferRecordNotFound, "transfer record not found")
+	ErrQuarantinedDepositNotFound = sdkerrors.Register(ModuleName, codeErrQuarantinedDepositNotFound, "quarantined deposit not found")
+	ErrEvmOutOfGas                = sdkerrors.Register(ModuleName, codeErrEvmOutOfGas, "evm call out of gas")
+	ErrEvmCallFailed              = sdkerrors.Register(ModuleName, codeErrEvmCallFailed, "evm call failed")
+	ErrEvmTracesNotFound          = sdkerrors.Register(ModuleName, codeErrEvmTracesNotFound, "evm traces not found")
 	// this line is used by starport scaffolding # ibc/errors
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,11 +15,34 @@ const (
 	AttributeKeyAmount                = "amount"
 	AttributeKeyReceiver              = "receiver"
 	AttributeKeyEthereumTokenContract = "ethereum_token_contract"
+	AttributeKeyEthereumRecipient     = "ethereum_recipient"
+	AttributeKeyBridgeFee             = "bridge_fee"
+	AttributeKeyTransferID            = "transfer_id"
+	AttributeKeyOutgoingTxID          = "outgoing_tx_id"
+	AttributeKeyBatchNonce            = "batch_nonce"
+	AttributeKeyStatus                = "status"
+	AttributeKeyRecordID              = "record_id"
+	AttributeKeyDirection             = "direction"
+	AttributeKeyOrigin                = "origin"
+	AttributeKeySequence              = "sequence"
+	AttributeKeyDepositID             = "deposit_id"
+	AttributeKeyDenom                 = "denom"
+	AttributeKeyContract              = "contract"
+	AttributeKeyGasUsed               = "gas_used"
+	AttributeKeyGasCharged            = "gas_charged"
 
 	// events
 	EventTypeConvertVouchers             = "convert_vouchers"
 	EventTypeTransferTokens              = "transfer_tokens"
 	EventTypeEthereumSendToCosmosHandled = "ethereum_send_to_cosmos_handled"
+	EventTypeEthereumTransfer            = "ethereum_transfer"
+	EventTypeTransferRecord              = "transfer_record"
+	EventTypeDepositQuarantined          = "deposit_quarantined"
+	EventTypeQuarantinedDepositReleased  = "quarantined_deposit_released"
+	EventTypeTokenDepositPending         = "token_deposit_pending"
+	EventTypePendingTokenResolved        = "pending_token_resolved"
+	EventTypeEvmCall                     = "evm_call"
+	EventTypeConvertSRC20                = "convert_src20"
 )
 
 // NewConvertVouchersEvent constructs a new voucher convert sdk.Event
@@ -40,3 +64,70 @@ func NewTransferTokensEvent(sender string, recipient string, amount fmt.Stringer
 		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
 	)
 }
+
+// NewEthereumTransferEvent constructs a new sdk.Event reporting the status of a transfer to ethereum
+func NewEthereumTransferEvent(transfer EthereumTransfer) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeEthereumTransfer,
+		sdk.NewAttribute(AttributeKeyTransferID, fmt.Sprint(transfer.Id)),
+		sdk.NewAttribute(AttributeKeySender, transfer.Sender),
+		sdk.NewAttribute(AttributeKeyEthereumRecipient, transfer.EthereumRecipient),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, transfer.Amount.String()),
+		sdk.NewAttribute(AttributeKeyBridgeFee, transfer.BridgeFee.String()),
+		sdk.NewAttribute(AttributeKeyOutgoingTxID, fmt.Sprint(transfer.OutgoingTxId)),
+		sdk.NewAttribute(AttributeKeyBatchNonce, fmt.Sprint(transfer.BatchNonce)),
+		sdk.NewAttribute(AttributeKeyStatus, transfer.Status.String()),
+	)
+}
+
+// NewTransferRecordEvent constructs a new sdk.Event reporting the status of a record of the transfer ledger
+func NewTransferRecordEvent(record TransferRecord) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeTransferRecord,
+		sdk.NewAttribute(AttributeKeyRecordID, fmt.Sprint(record.Id)),
+		sdk.NewAttribute(AttributeKeyDirection, record.Direction.String()),
+		sdk.NewAttribute(AttributeKeyOrigin, record.Origin.String()),
+		sdk.NewAttribute(AttributeKeySequence, fmt.Sprint(record.Sequence)),
+		sdk.NewAttribute(AttributeKeyStatus, record.Status.String()),
+	)
+}
+
+// NewConvertSRC20Event constructs a new sdk.Event reporting the conversion of SRC20 tokens to native coins
+func NewConvertSRC20Event(sender string, contract string, amount fmt.Stringer) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeConvertSRC20,
+		sdk.NewAttribute(AttributeKeySender, sender),
+		sdk.NewAttribute(AttributeKeyContract, contract),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewEvmCallEvent constructs a new sdk.Event reporting the gas of an evm call made by the module,
+// the contract is empty for a contract creation
+func NewEvmCallEvent(contract string, gasUsed, gasCharged uint64) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeEvmCall,
+		sdk.NewAttribute(AttributeKeyContract, contract),
+		sdk.NewAttribute(AttributeKeyGasUsed, fmt.Sprint(gasUsed)),
+		sdk.NewAttribute(AttributeKeyGasCharged, fmt.Sprint(gasCharged)),
+	)
+}
+
+// EvmGasUsed returns the evm gas used by the calls of the module reported in the events
+func EvmGasUsed(events sdk.Events) uint64 {
+	var gasUsed uint64
+	for _, event := range events {
+		if event.Type != EventTypeEvmCall {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) != AttributeKeyGasUsed {
+				continue
+			}
+			if v, err := strconv.ParseUint(string(attr.Value), 10, 64); err == nil {
+				gasUsed += v
+			}
+		}
+	}
+	return gasUsed
+}
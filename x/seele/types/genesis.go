@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 // this line is used by starport scaffolding # ibc/genesistype/import
 
@@ -22,5 +28,88 @@ func (gs GenesisState) Validate() error {
 
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	seen := make(map[string]bool)
+	for _, denom := range gs.PredeployedDenoms {
+		if !IsValidDenomToWrap(denom) {
+			return fmt.Errorf("invalid denom to predeploy: %s", denom)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicated denom to predeploy: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	ids := make(map[uint64]bool)
+	outgoingTxs := make(map[string]bool)
+	for _, transfer := range gs.EthereumTransfers {
+		if err := transfer.Validate(); err != nil {
+			return err
+		}
+		if ids[transfer.Id] || transfer.Id > gs.LastEthereumTransferId {
+			return fmt.Errorf("duplicated ethereum transfer id %d or greater than the last id %d", transfer.Id, gs.LastEthereumTransferId)
+		}
+		ids[transfer.Id] = true
+		if transfer.OutgoingTxId != 0 {
+			key := fmt.Sprintf("%s/%d", common.HexToAddress(transfer.Erc20Contract).Hex(), transfer.OutgoingTxId)
+			if outgoingTxs[key] {
+				return fmt.Errorf("duplicated outgoing transaction %d of ethereum transfer %d", transfer.OutgoingTxId, transfer.Id)
+			}
+			outgoingTxs[key] = true
+		}
+	}
+
+	ids = make(map[uint64]bool)
+	externalIDs := make(map[string]bool)
+	for _, record := range gs.TransferRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if ids[record.Id] || record.Id > gs.LastTransferRecordId {
+			return fmt.Errorf("duplicated transfer record id %d or greater than the last id %d", record.Id, gs.LastTransferRecordId)
+		}
+		ids[record.Id] = true
+		if record.Sequence != 0 {
+			key := string(TransferRecordByExternalIDKey(record.Direction, record.Origin, record.Channel, record.Sequence))
+			if externalIDs[key] {
+				return fmt.Errorf("duplicated external id %d of transfer record %d", record.Sequence, record.Id)
+			}
+			externalIDs[key] = true
+		}
+	}
+
+	ids = make(map[uint64]bool)
+	for _, deposit := range gs.QuarantinedDeposits {
+		if err := deposit.Validate(); err != nil {
+			return err
+		}
+		if ids[deposit.Id] || deposit.Id > gs.LastQuarantinedDepositId {
+			return fmt.Errorf("duplicated quarantined deposit id %d or greater than the last id %d", deposit.Id, gs.LastQuarantinedDepositId)
+		}
+		ids[deposit.Id] = true
+	}
+
+	ids = make(map[uint64]bool)
+	for _, deposit := range gs.PendingTokenDeposits {
+		if err := deposit.Validate(); err != nil {
+			return err
+		}
+		if ids[deposit.Id] || deposit.Id > gs.LastPendingTokenDepositId {
+			return fmt.Errorf("duplicated pending token deposit id %d or greater than the last id %d", deposit.Id, gs.LastPendingTokenDepositId)
+		}
+		ids[deposit.Id] = true
+	}
+
+	tokens := make(map[common.Address]bool)
+	for _, approval := range gs.PendingTokenApprovals {
+		if !common.IsHexAddress(approval.TokenContract) {
+			return fmt.Errorf("invalid token contract %s of pending token approval", approval.TokenContract)
+		}
+		token := common.HexToAddress(approval.TokenContract)
+		if tokens[token] {
+			return fmt.Errorf("duplicated pending token approval of %s", approval.TokenContract)
+		}
+		tokens[token] = true
+	}
+
 	return gs.Params.Validate()
 }
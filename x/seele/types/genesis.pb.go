@@ -29,13 +29,30 @@ type GenesisState struct {
 	Params            Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	ExternalContracts []TokenMapping `protobuf:"bytes,2,rep,name=external_contracts,json=externalContracts,proto3" json:"external_contracts"`
 	AutoContracts     []TokenMapping `protobuf:"bytes,3,rep,name=auto_contracts,json=autoContracts,proto3" json:"auto_contracts"`
+	// the denoms whose module SRC20 contract is deployed at genesis when they have no contract,
+	// along with the system contracts depending on them
+	PredeployedDenoms []string `protobuf:"bytes,4,rep,name=predeployed_denoms,json=predeployedDenoms,proto3" json:"predeployed_denoms,omitempty"`
+	// the transfers to ethereum initiated from the evm, the queued and the pending transfers are indexed again
+	// from their status
+	EthereumTransfers      []EthereumTransfer `protobuf:"bytes,5,rep,name=ethereum_transfers,json=ethereumTransfers,proto3" json:"ethereum_transfers"`
+	LastEthereumTransferId uint64             `protobuf:"varint,6,opt,name=last_ethereum_transfer_id,json=lastEthereumTransferId,proto3" json:"last_ethereum_transfer_id,omitempty"`
+	// the records of the transfer ledger, their indexes and their place in the prune queue are rebuilt
+	TransferRecords      []TransferRecord `protobuf:"bytes,7,rep,name=transfer_records,json=transferRecords,proto3" json:"transfer_records"`
+	LastTransferRecordId uint64           `protobuf:"varint,8,opt,name=last_transfer_record_id,json=lastTransferRecordId,proto3" json:"last_transfer_record_id,omitempty"`
+	// the gravity deposits held by the module until they are released by governance
+	QuarantinedDeposits      []QuarantinedDeposit `protobuf:"bytes,9,rep,name=quarantined_deposits,json=quarantinedDeposits,proto3" json:"quarantined_deposits"`
+	LastQuarantinedDepositId uint64               `protobuf:"varint,10,opt,name=last_quarantined_deposit_id,json=lastQuarantinedDepositId,proto3" json:"last_quarantined_deposit_id,omitempty"`
+	// the gravity deposits waiting for a token mapping and the approved resolutions not completed yet
+	PendingTokenDeposits      []PendingTokenDeposit  `protobuf:"bytes,11,rep,name=pending_token_deposits,json=pendingTokenDeposits,proto3" json:"pending_token_deposits"`
+	LastPendingTokenDepositId uint64                 `protobuf:"varint,12,opt,name=last_pending_token_deposit_id,json=lastPendingTokenDepositId,proto3" json:"last_pending_token_deposit_id,omitempty"`
+	PendingTokenApprovals     []PendingTokenApproval `protobuf:"bytes,13,rep,name=pending_token_approvals,json=pendingTokenApprovals,proto3" json:"pending_token_approvals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_997c9bf6ad78cc99, []int{0}
+	return fileDescriptor_cf26f6be6bf50716, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -85,31 +102,116 @@ func (m *GenesisState) GetAutoContracts() []TokenMapping {
 	return nil
 }
 
+func (m *GenesisState) GetPredeployedDenoms() []string {
+	if m != nil {
+		return m.PredeployedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEthereumTransfers() []EthereumTransfer {
+	if m != nil {
+		return m.EthereumTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastEthereumTransferId() uint64 {
+	if m != nil {
+		return m.LastEthereumTransferId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTransferRecords() []TransferRecord {
+	if m != nil {
+		return m.TransferRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTransferRecordId() uint64 {
+	if m != nil {
+		return m.LastTransferRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetQuarantinedDeposits() []QuarantinedDeposit {
+	if m != nil {
+		return m.QuarantinedDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastQuarantinedDepositId() uint64 {
+	if m != nil {
+		return m.LastQuarantinedDepositId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingTokenDeposits() []PendingTokenDeposit {
+	if m != nil {
+		return m.PendingTokenDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastPendingTokenDepositId() uint64 {
+	if m != nil {
+		return m.LastPendingTokenDepositId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingTokenApprovals() []PendingTokenApproval {
+	if m != nil {
+		return m.PendingTokenApprovals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "seele.GenesisState")
 }
 
-func init() { proto.RegisterFile("seele/genesis.proto", fileDescriptor_997c9bf6ad78cc99) }
+func init() { proto.RegisterFile("seele/genesis.proto", fileDescriptor_cf26f6be6bf50716) }
 
-var fileDescriptor_997c9bf6ad78cc99 = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x49, 0x2e, 0xca, 0xcf,
-	0xcb, 0x2f, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x62, 0x83, 0x88, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x85, 0xf4, 0x41, 0x2c, 0x88,
-	0xac, 0x94, 0x30, 0x54, 0x0f, 0x84, 0x82, 0x08, 0x2a, 0x9d, 0x62, 0xe4, 0xe2, 0x71, 0x87, 0x18,
-	0x12, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xa4, 0xc3, 0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c,
-	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xc4, 0xa7, 0x07, 0x55, 0x1f, 0x00, 0x16, 0x75, 0x62, 0x39,
-	0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x46, 0xc8, 0x93, 0x4b, 0x28, 0xb5, 0xa2, 0x24, 0xb5, 0x28,
-	0x2f, 0x31, 0x27, 0x3e, 0x39, 0x3f, 0xaf, 0xa4, 0x28, 0x31, 0xb9, 0xa4, 0x58, 0x82, 0x49, 0x81,
-	0x59, 0x83, 0xdb, 0x48, 0x04, 0xa6, 0x33, 0x24, 0x3f, 0x3b, 0x35, 0xcf, 0x37, 0xb1, 0xa0, 0x20,
-	0x33, 0x2f, 0x1d, 0xaa, 0x5f, 0x10, 0xa6, 0xcb, 0x19, 0xa6, 0x49, 0xc8, 0x91, 0x8b, 0x2f, 0xb1,
-	0xb4, 0x24, 0x1f, 0xc9, 0x18, 0x66, 0x82, 0xc6, 0xf0, 0x82, 0x74, 0xc0, 0x8d, 0x70, 0xf2, 0x3e,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xc3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xa2, 0xca, 0x82, 0x92, 0x7c, 0xdd, 0xfc, 0xa2, 0x74,
-	0xdd, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x68, 0x80, 0xe8, 0x57, 0xc0, 0x18, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0xe0, 0x00, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd9, 0xac, 0xa2, 0x1e,
-	0x6b, 0x01, 0x00, 0x00,
+var fileDescriptor_cf26f6be6bf50716 = []byte{
+	// 502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0xd6, 0x75, 0xcc, 0x5d, 0x81, 0xba, 0xdd, 0x9a, 0x75, 0x22, 0x54, 0x5c, 0xa8,
+	0x84, 0x58, 0xa5, 0x21, 0x0e, 0x1c, 0x90, 0x60, 0x8c, 0x97, 0x4a, 0x20, 0x41, 0x99, 0x90, 0xe0,
+	0x12, 0x99, 0xfa, 0x21, 0x44, 0xa4, 0xb6, 0x67, 0xbb, 0x68, 0xfb, 0x02, 0x9c, 0xf9, 0x58, 0x3b,
+	0xee, 0xc8, 0x09, 0xa1, 0xf6, 0x8b, 0x20, 0x3f, 0x71, 0xd7, 0xb5, 0x8d, 0xc4, 0x25, 0x8a, 0x9e,
+	0xff, 0x8b, 0x7f, 0x89, 0x6d, 0xd2, 0x30, 0x00, 0x19, 0xf4, 0x12, 0x10, 0x60, 0x52, 0xb3, 0xaf,
+	0xb4, 0xb4, 0x92, 0xae, 0xe3, 0xb0, 0xdd, 0x4c, 0x64, 0x22, 0x71, 0xd2, 0x73, 0x6f, 0xb9, 0xd8,
+	0xae, 0xe7, 0x09, 0x7c, 0xe6, 0xa3, 0xbb, 0x3f, 0x37, 0xc8, 0xd6, 0xab, 0xbc, 0xe1, 0x83, 0x65,
+	0x16, 0xe8, 0x7d, 0x52, 0x51, 0x4c, 0xb3, 0x91, 0x09, 0x83, 0x4e, 0xd0, 0xad, 0x1e, 0xd4, 0xf6,
+	0x73, 0xfb, 0x3b, 0x1c, 0x1e, 0x96, 0xcf, 0xff, 0xdc, 0x29, 0x0d, 0xbc, 0x85, 0xbe, 0x26, 0x14,
+	0x4e, 0x2d, 0x68, 0xc1, 0xb2, 0x78, 0x28, 0x85, 0xd5, 0x6c, 0x68, 0x4d, 0x78, 0xad, 0xb3, 0xd6,
+	0xad, 0x1e, 0x34, 0x7c, 0xf0, 0x58, 0x7e, 0x07, 0xf1, 0x96, 0x29, 0x95, 0x8a, 0xc4, 0xc7, 0xeb,
+	0xb3, 0xd0, 0xf3, 0x59, 0x86, 0x3e, 0x25, 0x37, 0xd8, 0xd8, 0xca, 0x2b, 0x2d, 0x6b, 0xff, 0x6b,
+	0xa9, 0xb9, 0xc0, 0xbc, 0xe1, 0x01, 0xa1, 0x4a, 0x03, 0x07, 0x95, 0xc9, 0x33, 0xe0, 0x31, 0x07,
+	0x21, 0x47, 0x26, 0x2c, 0x77, 0xd6, 0xba, 0x9b, 0x83, 0xfa, 0x15, 0xe5, 0x08, 0x05, 0xfa, 0x86,
+	0x50, 0xb0, 0xdf, 0x40, 0xc3, 0x78, 0x14, 0x5b, 0xcd, 0x84, 0xf9, 0x0a, 0xda, 0x84, 0xeb, 0xb8,
+	0x68, 0xcb, 0x2f, 0xfa, 0xc2, 0x1b, 0x8e, 0xbd, 0x7e, 0x89, 0xbf, 0x34, 0x37, 0xf4, 0x31, 0xd9,
+	0xcd, 0x98, 0xb1, 0xf1, 0x4a, 0x65, 0x9c, 0xf2, 0xb0, 0xd2, 0x09, 0xba, 0xe5, 0xc1, 0x8e, 0x33,
+	0x2c, 0x37, 0xf6, 0x39, 0x7d, 0x49, 0x6e, 0x5d, 0x9a, 0x35, 0x0c, 0xa5, 0xe6, 0x26, 0xdc, 0x40,
+	0x8c, 0xed, 0xd9, 0xb7, 0x7b, 0x79, 0x80, 0xaa, 0x87, 0xb8, 0x69, 0x17, 0xa6, 0x86, 0x3e, 0x22,
+	0x2d, 0x44, 0x58, 0x2a, 0x73, 0x00, 0xd7, 0x11, 0xa0, 0xe9, 0xe4, 0xc5, 0xae, 0x3e, 0xa7, 0x03,
+	0xd2, 0x3c, 0x19, 0x33, 0xcd, 0x84, 0x4d, 0x05, 0xfe, 0x36, 0x25, 0x4d, 0x6a, 0x4d, 0xb8, 0x89,
+	0x08, 0xbb, 0x1e, 0xe1, 0xfd, 0xdc, 0x72, 0x94, 0x3b, 0x3c, 0x46, 0xe3, 0x64, 0x45, 0x31, 0xf4,
+	0x09, 0xd9, 0x43, 0x94, 0x82, 0x62, 0x87, 0x43, 0x10, 0x27, 0x74, 0x96, 0xd5, 0xde, 0x3e, 0xa7,
+	0x1f, 0xc9, 0x8e, 0x02, 0xc1, 0x53, 0x91, 0xc4, 0xd6, 0x6d, 0xfb, 0x1c, 0xaa, 0x8a, 0x50, 0xed,
+	0xd9, 0x91, 0xcc, 0x4d, 0x78, 0x34, 0x16, 0xa9, 0x9a, 0x6a, 0x55, 0x72, 0x67, 0xec, 0x36, 0x62,
+	0x15, 0x96, 0x3b, 0xb0, 0x2d, 0x04, 0xc3, 0x9d, 0x2c, 0xe8, 0xee, 0x73, 0xfa, 0x89, 0xb4, 0x16,
+	0xc3, 0x4c, 0x29, 0x2d, 0x7f, 0xb0, 0xcc, 0x84, 0x35, 0x44, 0xdb, 0x2b, 0x40, 0x7b, 0xe6, 0x3d,
+	0x9e, 0x6d, 0x5b, 0x15, 0x68, 0xe6, 0xf0, 0xde, 0xf9, 0x24, 0x0a, 0x2e, 0x26, 0x51, 0xf0, 0x77,
+	0x12, 0x05, 0xbf, 0xa6, 0x51, 0xe9, 0x62, 0x1a, 0x95, 0x7e, 0x4f, 0xa3, 0xd2, 0xe7, 0xda, 0x69,
+	0x7e, 0x63, 0x7b, 0xf6, 0x4c, 0x81, 0xf9, 0x52, 0xc1, 0x8b, 0xfb, 0xf0, 0xdf, 0x00, 0x30, 0x12,
+	0x2b, 0x3c, 0xff, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +234,105 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingTokenApprovals) > 0 {
+		for iNdEx := len(m.PendingTokenApprovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTokenApprovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.LastPendingTokenDepositId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastPendingTokenDepositId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.PendingTokenDeposits) > 0 {
+		for iNdEx := len(m.PendingTokenDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTokenDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LastQuarantinedDepositId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastQuarantinedDepositId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for iNdEx := len(m.QuarantinedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.LastTransferRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTransferRecordId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TransferRecords) > 0 {
+		for iNdEx := len(m.TransferRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.LastEthereumTransferId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEthereumTransferId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EthereumTransfers) > 0 {
+		for iNdEx := len(m.EthereumTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PredeployedDenoms) > 0 {
+		for iNdEx := len(m.PredeployedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PredeployedDenoms[iNdEx])
+			copy(dAtA[i:], m.PredeployedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PredeployedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.AutoContracts) > 0 {
 		for iNdEx := len(m.AutoContracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -204,6 +405,54 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PredeployedDenoms) > 0 {
+		for _, s := range m.PredeployedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumTransfers) > 0 {
+		for _, e := range m.EthereumTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastEthereumTransferId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastEthereumTransferId))
+	}
+	if len(m.TransferRecords) > 0 {
+		for _, e := range m.TransferRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTransferRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTransferRecordId))
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for _, e := range m.QuarantinedDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastQuarantinedDepositId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastQuarantinedDepositId))
+	}
+	if len(m.PendingTokenDeposits) > 0 {
+		for _, e := range m.PendingTokenDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastPendingTokenDepositId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastPendingTokenDepositId))
+	}
+	if len(m.PendingTokenApprovals) > 0 {
+		for _, e := range m.PendingTokenApprovals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -343,6 +592,284 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredeployedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredeployedDenoms = append(m.PredeployedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumTransfers = append(m.EthereumTransfers, EthereumTransfer{})
+			if err := m.EthereumTransfers[len(m.EthereumTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEthereumTransferId", wireType)
+			}
+			m.LastEthereumTransferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEthereumTransferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferRecords = append(m.TransferRecords, TransferRecord{})
+			if err := m.TransferRecords[len(m.TransferRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransferRecordId", wireType)
+			}
+			m.LastTransferRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTransferRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuarantinedDeposits = append(m.QuarantinedDeposits, QuarantinedDeposit{})
+			if err := m.QuarantinedDeposits[len(m.QuarantinedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastQuarantinedDepositId", wireType)
+			}
+			m.LastQuarantinedDepositId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastQuarantinedDepositId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTokenDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTokenDeposits = append(m.PendingTokenDeposits, PendingTokenDeposit{})
+			if err := m.PendingTokenDeposits[len(m.PendingTokenDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPendingTokenDepositId", wireType)
+			}
+			m.LastPendingTokenDepositId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPendingTokenDepositId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTokenApprovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTokenApprovals = append(m.PendingTokenApprovals, PendingTokenApproval{})
+			if err := m.PendingTokenApprovals[len(m.PendingTokenApprovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
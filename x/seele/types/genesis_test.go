@@ -1,8 +1,10 @@
 package types
 
 import (
-	"github.com/stretchr/testify/require"
 	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisStateValidate(t *testing.T) {
@@ -18,6 +20,139 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"ethereum transfer id greater than the last id",
+			GenesisState{
+				Params: DefaultParams(),
+				EthereumTransfers: []EthereumTransfer{
+					{
+						Id:                2,
+						Sender:            "0x0000000000000000000000000000000000000065",
+						BridgeContract:    "0x0000000000000000000000000000000000000064",
+						TokenContract:     "0x0000000000000000000000000000000000000066",
+						EthereumRecipient: "0x0000000000000000000000000000000000000067",
+						Amount:            sdk.NewInt64Coin("snp", 90),
+						BridgeFee:         sdk.NewInt64Coin("snp", 10),
+						Status:            EthereumTransferStatusQueued,
+					},
+				},
+				LastEthereumTransferId: 1,
+			},
+			true,
+		},
+		{
+			"batched ethereum transfer without batch nonce",
+			GenesisState{
+				Params: DefaultParams(),
+				EthereumTransfers: []EthereumTransfer{
+					{
+						Id:                1,
+						Sender:            "0x0000000000000000000000000000000000000065",
+						BridgeContract:    "0x0000000000000000000000000000000000000064",
+						TokenContract:     "0x0000000000000000000000000000000000000066",
+						EthereumRecipient: "0x0000000000000000000000000000000000000067",
+						Erc20Contract:     "0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503",
+						Amount:            sdk.NewInt64Coin("snp", 90),
+						BridgeFee:         sdk.NewInt64Coin("snp", 10),
+						Status:            EthereumTransferStatusBatched,
+						OutgoingTxId:      5,
+					},
+				},
+				LastEthereumTransferId: 1,
+			},
+			true,
+		},
+		{
+			"duplicated external id of transfer records",
+			GenesisState{
+				Params: DefaultParams(),
+				TransferRecords: []TransferRecord{
+					{
+						Id:        1,
+						Direction: TransferDirectionInbound,
+						Origin:    TransferOriginIBC,
+						Channel:   "channel-0",
+						Sequence:  3,
+						Amount:    sdk.NewInt64Coin("snp", 1),
+						Status:    TransferStatusCompleted,
+					},
+					{
+						Id:        2,
+						Direction: TransferDirectionInbound,
+						Origin:    TransferOriginIBC,
+						Channel:   "channel-0",
+						Sequence:  3,
+						Amount:    sdk.NewInt64Coin("snp", 1),
+						Status:    TransferStatusCompleted,
+					},
+				},
+				LastTransferRecordId: 2,
+			},
+			true,
+		},
+		{
+			"valid quarantined deposit",
+			GenesisState{
+				Params: DefaultParams(),
+				QuarantinedDeposits: []QuarantinedDeposit{
+					{
+						Id:             1,
+						TokenContract:  "0x0000000000000000000000000000000000000065",
+						EthereumSender: "0x0000000000000000000000000000000000000066",
+						CosmosReceiver: "invalid",
+						Amount:         sdk.NewInt64Coin("snp", 1),
+					},
+				},
+				LastQuarantinedDepositId: 1,
+			},
+			false,
+		},
+		{
+			"quarantined deposit id greater than the last id",
+			GenesisState{
+				Params: DefaultParams(),
+				QuarantinedDeposits: []QuarantinedDeposit{
+					{
+						Id:             2,
+						TokenContract:  "0x0000000000000000000000000000000000000065",
+						EthereumSender: "0x0000000000000000000000000000000000000066",
+						Amount:         sdk.NewInt64Coin("snp", 1),
+					},
+				},
+				LastQuarantinedDepositId: 1,
+			},
+			true,
+		},
+		{
+			"valid pending token deposit and approval",
+			GenesisState{
+				Params: DefaultParams(),
+				PendingTokenDeposits: []PendingTokenDeposit{
+					{
+						Id:            1,
+						TokenContract: "0x0000000000000000000000000000000000000065",
+						Receiver:      "0x0000000000000000000000000000000000000066",
+						Amount:        sdk.NewInt64Coin("snp", 1),
+					},
+				},
+				LastPendingTokenDepositId: 1,
+				PendingTokenApprovals: []PendingTokenApproval{
+					{TokenContract: "0x0000000000000000000000000000000000000065", AutoDeploy: true},
+				},
+			},
+			false,
+		},
+		{
+			"duplicated pending token approval",
+			GenesisState{
+				Params: DefaultParams(),
+				PendingTokenApprovals: []PendingTokenApproval{
+					{TokenContract: "0x0000000000000000000000000000000000000065", AutoDeploy: true},
+					{TokenContract: "0x0000000000000000000000000000000000000065", ReleaseNative: true},
+				},
+			},
+			true,
+		},
 		{
 			"valid invalid IBC param",
 			GenesisState{
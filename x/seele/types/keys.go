@@ -1,5 +1,14 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "seele"
@@ -26,6 +35,21 @@ const (
 	prefixContractToDenom
 	prefixContractNameToContractAddress
 	prefixExternalContractToDenom
+	prefixEthereumTransfer
+	prefixEthereumTransferQueue
+	prefixPendingEthereumTransfer
+	prefixLastEthereumTransferID
+	prefixTransferRecord
+	prefixTransferRecordByAddress
+	prefixTransferRecordByExternalID
+	prefixTransferRecordPruneQueue
+	prefixLastTransferRecordID
+	prefixQuarantinedDeposit
+	prefixLastQuarantinedDepositID
+	prefixPendingTokenDeposit
+	prefixLastPendingTokenDepositID
+	prefixBatchedEthereumTransfer
+	prefixPendingTokenApproval
 )
 
 // KVStore key prefixes
@@ -35,6 +59,21 @@ var (
 	KeyPrefixContractToDenom               = []byte{prefixContractToDenom}
 	KeyPrefixContractNameToContractAddress = []byte{prefixContractNameToContractAddress}
 	KeyprefixExternalContractToDenom       = []byte{prefixExternalContractToDenom}
+	KeyPrefixEthereumTransfer              = []byte{prefixEthereumTransfer}
+	KeyPrefixEthereumTransferQueue         = []byte{prefixEthereumTransferQueue}
+	KeyPrefixPendingEthereumTransfer       = []byte{prefixPendingEthereumTransfer}
+	KeyLastEthereumTransferID              = []byte{prefixLastEthereumTransferID}
+	KeyPrefixTransferRecord                = []byte{prefixTransferRecord}
+	KeyPrefixTransferRecordByAddress       = []byte{prefixTransferRecordByAddress}
+	KeyPrefixTransferRecordByExternalID    = []byte{prefixTransferRecordByExternalID}
+	KeyPrefixTransferRecordPruneQueue      = []byte{prefixTransferRecordPruneQueue}
+	KeyLastTransferRecordID                = []byte{prefixLastTransferRecordID}
+	KeyPrefixQuarantinedDeposit            = []byte{prefixQuarantinedDeposit}
+	KeyLastQuarantinedDepositID            = []byte{prefixLastQuarantinedDepositID}
+	KeyPrefixPendingTokenDeposit           = []byte{prefixPendingTokenDeposit}
+	KeyLastPendingTokenDepositID           = []byte{prefixLastPendingTokenDepositID}
+	KeyPrefixBatchedEthereumTransfer       = []byte{prefixBatchedEthereumTransfer}
+	KeyPrefixPendingTokenApproval          = []byte{prefixPendingTokenApproval}
 )
 
 // this line is used by starport scaffolding # ibc/keys/port
@@ -63,3 +102,174 @@ func ContractToDenomKey(contract []byte) []byte {
 func ContractNameToContractAddressKey(contractname string) []byte {
 	return append(KeyPrefixContractNameToContractAddress, contractname...)
 }
+
+// EthereumTransferKey defines the store key for a transfer to ethereum
+func EthereumTransferKey(id uint64) []byte {
+	return append(KeyPrefixEthereumTransfer, sdk.Uint64ToBigEndian(id)...)
+}
+
+// EthereumTransferQueueKey defines the store key for a transfer waiting to be submitted to the gravity module
+func EthereumTransferQueueKey(id uint64) []byte {
+	return append(KeyPrefixEthereumTransferQueue, sdk.Uint64ToBigEndian(id)...)
+}
+
+// PendingEthereumTransferKey defines the store key for a transfer waiting to be executed on ethereum,
+// indexed by the erc20 contract and the id of the outgoing transaction in the gravity pool
+func PendingEthereumTransferKey(erc20Contract common.Address, outgoingTxID uint64) []byte {
+	return append(append(KeyPrefixPendingEthereumTransfer, erc20Contract.Bytes()...), sdk.Uint64ToBigEndian(outgoingTxID)...)
+}
+
+// BatchedEthereumTransferPrefix defines the store prefix of the batched transfers of an erc20 contract
+func BatchedEthereumTransferPrefix(erc20Contract common.Address) []byte {
+	return append(KeyPrefixBatchedEthereumTransfer, erc20Contract.Bytes()...)
+}
+
+// BatchedEthereumTransferKey defines the store key for a transfer in a gravity batch, ordered by batch nonce
+func BatchedEthereumTransferKey(erc20Contract common.Address, batchNonce, outgoingTxID uint64) []byte {
+	key := append(BatchedEthereumTransferPrefix(erc20Contract), sdk.Uint64ToBigEndian(batchNonce)...)
+	return append(key, sdk.Uint64ToBigEndian(outgoingTxID)...)
+}
+
+// TransferRecordKey defines the store key for a record of the transfer ledger
+func TransferRecordKey(id uint64) []byte {
+	return append(KeyPrefixTransferRecord, sdk.Uint64ToBigEndian(id)...)
+}
+
+// TransferRecordByAddressPrefix defines the store prefix for the records sent or received by an address
+func TransferRecordByAddressPrefix(addr []byte) []byte {
+	return append(append(KeyPrefixTransferRecordByAddress, byte(len(addr))), addr...)
+}
+
+// TransferRecordByAddressKey defines the store key for the address index of the transfer ledger
+func TransferRecordByAddressKey(addr []byte, id uint64) []byte {
+	return append(TransferRecordByAddressPrefix(addr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// TransferRecordByExternalIDKey defines the store key for the external id index of the transfer ledger
+func TransferRecordByExternalIDKey(direction TransferDirection, origin TransferOrigin, channel string, sequence uint64) []byte {
+	key := append(KeyPrefixTransferRecordByExternalID, byte(direction), byte(origin), byte(len(channel)))
+	key = append(key, channel...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// TransferRecordPruneQueueKey defines the store key for a finalized record waiting to be pruned
+func TransferRecordPruneQueueKey(height int64, id uint64) []byte {
+	return append(append(KeyPrefixTransferRecordPruneQueue, sdk.Uint64ToBigEndian(uint64(height))...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// QuarantinedDepositKey defines the store key for a quarantined gravity deposit
+func QuarantinedDepositKey(id uint64) []byte {
+	return append(KeyPrefixQuarantinedDeposit, sdk.Uint64ToBigEndian(id)...)
+}
+
+// PendingTokenDepositPrefix defines the store prefix of the deposits of an ethereum token waiting for a mapping
+func PendingTokenDepositPrefix(tokenContract common.Address) []byte {
+	return append(KeyPrefixPendingTokenDeposit, tokenContract.Bytes()...)
+}
+
+// PendingTokenDepositKey defines the store key for a deposit of an ethereum token waiting for a mapping
+func PendingTokenDepositKey(tokenContract common.Address, id uint64) []byte {
+	return append(PendingTokenDepositPrefix(tokenContract), sdk.Uint64ToBigEndian(id)...)
+}
+
+// PendingTokenApprovalKey defines the store key for the approved resolution of the pending deposits of an ethereum token
+func PendingTokenApprovalKey(tokenContract common.Address) []byte {
+	return append(KeyPrefixPendingTokenApproval, tokenContract.Bytes()...)
+}
+
+// DescribeStoreKey decodes a key of the seele store to a human readable description, used by the debug commands
+func DescribeStoreKey(key []byte) (string, error) {
+	if len(key) == 0 {
+		return "", errors.New("empty store key")
+	}
+	rest := key[1:]
+	switch key[0] {
+	case prefixDenomToExternalContract:
+		return fmt.Sprintf("DenomToExternalContract denom=%s", rest), nil
+	case prefixDenomToAutoContract:
+		return fmt.Sprintf("DenomToAutoContract denom=%s", rest), nil
+	case prefixContractToDenom:
+		return fmt.Sprintf("ContractToDenom contract=%s", common.BytesToAddress(rest).Hex()), nil
+	case prefixContractNameToContractAddress:
+		return fmt.Sprintf("ContractNameToContractAddress name=%s", rest), nil
+	case prefixExternalContractToDenom:
+		return fmt.Sprintf("ExternalContractToDenom contract=%s", rest), nil
+	case prefixEthereumTransfer:
+		return describeIDKey("EthereumTransfer", rest)
+	case prefixEthereumTransferQueue:
+		return describeIDKey("EthereumTransferQueue", rest)
+	case prefixPendingEthereumTransfer:
+		if len(rest) != common.AddressLength+8 {
+			return "", fmt.Errorf("invalid pending ethereum transfer key %X", key)
+		}
+		return fmt.Sprintf("PendingEthereumTransfer erc20_contract=%s outgoing_tx_id=%d",
+			common.BytesToAddress(rest[:common.AddressLength]).Hex(), sdk.BigEndianToUint64(rest[common.AddressLength:])), nil
+	case prefixBatchedEthereumTransfer:
+		if len(rest) != common.AddressLength+16 {
+			return "", fmt.Errorf("invalid batched ethereum transfer key %X", key)
+		}
+		return fmt.Sprintf("BatchedEthereumTransfer erc20_contract=%s batch_nonce=%d outgoing_tx_id=%d",
+			common.BytesToAddress(rest[:common.AddressLength]).Hex(), sdk.BigEndianToUint64(rest[common.AddressLength:common.AddressLength+8]),
+			sdk.BigEndianToUint64(rest[common.AddressLength+8:])), nil
+	case prefixTransferRecord:
+		return describeIDKey("TransferRecord", rest)
+	case prefixQuarantinedDeposit:
+		return describeIDKey("QuarantinedDeposit", rest)
+	case prefixLastEthereumTransferID, prefixLastTransferRecordID, prefixLastQuarantinedDepositID, prefixLastPendingTokenDepositID:
+		if len(rest) != 0 {
+			return "", fmt.Errorf("unexpected suffix %X of a last id key", rest)
+		}
+		return lastIDKeyNames[key[0]], nil
+	case prefixTransferRecordByAddress:
+		if len(rest) == 0 || len(rest) < 1+int(rest[0]) {
+			return "", fmt.Errorf("invalid transfer record address index key %X", key)
+		}
+		addr, id := rest[1:1+int(rest[0])], rest[1+int(rest[0]):]
+		if len(id) == 0 {
+			return fmt.Sprintf("TransferRecordByAddress address=%s", sdk.AccAddress(addr)), nil
+		}
+		return describeIDKey(fmt.Sprintf("TransferRecordByAddress address=%s", sdk.AccAddress(addr)), id)
+	case prefixTransferRecordByExternalID:
+		if len(rest) < 3 || len(rest) != 3+int(rest[2])+8 {
+			return "", fmt.Errorf("invalid transfer record external id index key %X", key)
+		}
+		channel := rest[3 : 3+int(rest[2])]
+		return fmt.Sprintf("TransferRecordByExternalID direction=%s origin=%s channel=%s sequence=%d",
+			TransferDirection(rest[0]), TransferOrigin(rest[1]), channel, sdk.BigEndianToUint64(rest[3+int(rest[2]):])), nil
+	case prefixTransferRecordPruneQueue:
+		if len(rest) != 16 {
+			return "", fmt.Errorf("invalid transfer record prune queue key %X", key)
+		}
+		return fmt.Sprintf("TransferRecordPruneQueue height=%d id=%d", sdk.BigEndianToUint64(rest[:8]), sdk.BigEndianToUint64(rest[8:])), nil
+	case prefixPendingTokenDeposit:
+		if len(rest) < common.AddressLength {
+			return "", fmt.Errorf("invalid pending token deposit key %X", key)
+		}
+		name := fmt.Sprintf("PendingTokenDeposit token_contract=%s", common.BytesToAddress(rest[:common.AddressLength]).Hex())
+		if len(rest) == common.AddressLength {
+			return name, nil
+		}
+		return describeIDKey(name, rest[common.AddressLength:])
+	case prefixPendingTokenApproval:
+		if len(rest) != common.AddressLength {
+			return "", fmt.Errorf("invalid pending token approval key %X", key)
+		}
+		return fmt.Sprintf("PendingTokenApproval token_contract=%s", common.BytesToAddress(rest).Hex()), nil
+	default:
+		return "", fmt.Errorf("unknown seele store key prefix %X", key[0])
+	}
+}
+
+var lastIDKeyNames = map[byte]string{
+	prefixLastEthereumTransferID:    "LastEthereumTransferID",
+	prefixLastTransferRecordID:      "LastTransferRecordID",
+	prefixLastQuarantinedDepositID:  "LastQuarantinedDepositID",
+	prefixLastPendingTokenDepositID: "LastPendingTokenDepositID",
+}
+
+func describeIDKey(name string, bz []byte) (string, error) {
+	if len(bz) != 8 {
+		return "", fmt.Errorf("invalid id %X of a %s key", bz, strings.Fields(name)[0])
+	}
+	return fmt.Sprintf("%s id=%d", name, sdk.BigEndianToUint64(bz)), nil
+}
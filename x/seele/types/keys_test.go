@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeStoreKey(t *testing.T) {
+	contract := common.HexToAddress("0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067")
+	addr := []byte("store key address")
+	testCases := []struct {
+		key      []byte
+		expected string
+	}{
+		{types.DenomToAutoContractKey("snp"), "DenomToAutoContract denom=snp"},
+		{types.ContractToDenomKey(contract.Bytes()), "ContractToDenom contract=" + contract.Hex()},
+		{types.EthereumTransferQueueKey(7), "EthereumTransferQueue id=7"},
+		{types.KeyLastTransferRecordID, "LastTransferRecordID"},
+		{types.PendingEthereumTransferKey(contract, 5), "PendingEthereumTransfer erc20_contract=" + contract.Hex() + " outgoing_tx_id=5"},
+		{
+			types.BatchedEthereumTransferKey(contract, 6, 5),
+			"BatchedEthereumTransfer erc20_contract=" + contract.Hex() + " batch_nonce=6 outgoing_tx_id=5",
+		},
+		{types.TransferRecordByAddressKey(addr, 3), "TransferRecordByAddress address=" + sdk.AccAddress(addr).String() + " id=3"},
+		{
+			types.TransferRecordByExternalIDKey(types.TransferDirectionOutbound, types.TransferOriginGravity, "", 9),
+			"TransferRecordByExternalID direction=TRANSFER_DIRECTION_OUTBOUND origin=TRANSFER_ORIGIN_GRAVITY channel= sequence=9",
+		},
+		{types.TransferRecordPruneQueueKey(100, 4), "TransferRecordPruneQueue height=100 id=4"},
+		{types.PendingTokenDepositKey(contract, 2), "PendingTokenDeposit token_contract=" + contract.Hex() + " id=2"},
+		{types.PendingTokenApprovalKey(contract), "PendingTokenApproval token_contract=" + contract.Hex()},
+	}
+	for _, tc := range testCases {
+		description, err := types.DescribeStoreKey(tc.key)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, description)
+	}
+
+	for _, key := range [][]byte{nil, {0xff}, types.TransferRecordKey(1)[:5], append(types.KeyLastTransferRecordID, 1)} {
+		_, err := types.DescribeStoreKey(key)
+		require.Error(t, err)
+	}
+}
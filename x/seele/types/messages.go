@@ -10,6 +10,8 @@ const (
 	TypeMsgConvertVouchers    = "ConvertVouchers"
 	TypeMsgTransferTokens     = "TransferTokens"
 	TypeMsgUpdateTokenMapping = "UpdateTokenMapping"
+
+	TypeMsgConvertSRC20 = "ConvertSRC20"
 )
 
 var _ sdk.Msg = &MsgConvertVouchers{}
@@ -169,3 +171,57 @@ func (msg *MsgUpdateTokenMapping) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
+
+var _ sdk.Msg = &MsgConvertSRC20{}
+
+// NewMsgConvertSRC20 ...
+func NewMsgConvertSRC20(address string, contract string, amount sdk.Int) *MsgConvertSRC20 {
+	return &MsgConvertSRC20{
+		Address:  address,
+		Contract: contract,
+		Amount:   amount,
+	}
+}
+
+// GetSigners ...
+func (msg *MsgConvertSRC20) GetSigners() []sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{address}
+}
+
+// ValidateBasic ...
+func (msg *MsgConvertSRC20) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+
+	if !common.IsHexAddress(msg.Contract) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid contract address (%s)", msg.Contract)
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be positive (%s)", msg.Amount)
+	}
+
+	return nil
+}
+
+// Route ...
+func (msg MsgConvertSRC20) Route() string {
+	return RouterKey
+}
+
+// Type ...
+func (msg MsgConvertSRC20) Type() string {
+	return TypeMsgConvertSRC20
+}
+
+// GetSignBytes ...
+func (msg *MsgConvertSRC20) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
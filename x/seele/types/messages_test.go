@@ -6,6 +6,8 @@ import (
 
 	"github.com/Seele-N/Seele/app"
 	"github.com/Seele-N/Seele/x/seele/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,27 +21,73 @@ func TestValidateMsgUpdateTokenMapping(t *testing.T) {
 	}{
 		{
 			"valid gravity denom",
-			types.NewMsgUpdateTokenMapping("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"),
+			types.NewMsgUpdateTokenMapping("seele1qdtamvw93dfs5350emkazvtf8q032nfy4ggaq0", "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"),
 			true,
 		},
 		{
 			"valid ibc denom",
-			types.NewMsgUpdateTokenMapping("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "ibc/0000000000000000000000000000000000000000000000000000000000000000", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"),
+			types.NewMsgUpdateTokenMapping("seele1qdtamvw93dfs5350emkazvtf8q032nfy4ggaq0", "ibc/0000000000000000000000000000000000000000000000000000000000000000", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"),
 			true,
 		},
 		{
 			"invalid sender",
-			types.NewMsgUpdateTokenMapping("crc12luku6uxehhak02py4r", "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"),
+			types.NewMsgUpdateTokenMapping("seele1qdtamvw93dfs5350", "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"),
 			false,
 		},
 		{
 			"invalid denom",
-			types.NewMsgUpdateTokenMapping("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "aaa", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"),
+			types.NewMsgUpdateTokenMapping("seele1qdtamvw93dfs5350emkazvtf8q032nfy4ggaq0", "aaa", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"),
 			false,
 		},
 		{
 			"invalid contract address",
-			types.NewMsgUpdateTokenMapping("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067", "0x57f96e6B86CdeFdB3d4125"),
+			types.NewMsgUpdateTokenMapping("seele1qdtamvw93dfs5350emkazvtf8q032nfy4ggaq0", "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067", "0x57f96e6B86CdeFdB3d4125"),
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t1 *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expValid {
+				require.NoError(t1, err)
+			} else {
+				require.Error(t1, err)
+			}
+		})
+	}
+}
+
+func TestValidateMsgConvertSRC20(t *testing.T) {
+	address := sdk.AccAddress(common.HexToAddress("0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2").Bytes()).String()
+	contract := "0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067"
+	testCases := []struct {
+		name     string
+		msg      *types.MsgConvertSRC20
+		expValid bool
+	}{
+		{
+			"valid",
+			types.NewMsgConvertSRC20(address, contract, sdk.NewInt(1)),
+			true,
+		},
+		{
+			"invalid address",
+			types.NewMsgConvertSRC20("seele1abc", contract, sdk.NewInt(1)),
+			false,
+		},
+		{
+			"invalid contract",
+			types.NewMsgConvertSRC20(address, "0x6E7eef2b30585B2A4D45Ba", sdk.NewInt(1)),
+			false,
+		},
+		{
+			"zero amount",
+			types.NewMsgConvertSRC20(address, contract, sdk.ZeroInt()),
+			false,
+		},
+		{
+			"nil amount",
+			&types.MsgConvertSRC20{Address: address, Contract: contract},
 			false,
 		},
 	}
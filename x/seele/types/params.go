@@ -2,11 +2,13 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var (
@@ -18,10 +20,30 @@ var (
 	KeySeeleAdmin = []byte("KeySeeleAdmin")
 	// KeyEnableAutoDeployment is store's key for the EnableAutoDeployment
 	KeyEnableAutoDeployment = []byte("KeyEnableAutoDeployment")
+	// KeyGravityBridgeContract is store's key for the GravityBridgeContract
+	KeyGravityBridgeContract = []byte("KeyGravityBridgeContract")
+	// KeyTransferRecordRetention is store's key for the TransferRecordRetention
+	KeyTransferRecordRetention = []byte("KeyTransferRecordRetention")
+	// KeyDeploymentPolicies is store's key for the DeploymentPolicies
+	KeyDeploymentPolicies = []byte("KeyDeploymentPolicies")
+	// KeyUnconvertedGravityDenoms is store's key for the UnconvertedGravityDenoms
+	KeyUnconvertedGravityDenoms = []byte("KeyUnconvertedGravityDenoms")
+	// KeyEvmCallGasLimit is store's key for the EvmCallGasLimit
+	KeyEvmCallGasLimit = []byte("KeyEvmCallGasLimit")
+	// KeyEvmGasRatio is store's key for the EvmGasRatio
+	KeyEvmGasRatio = []byte("KeyEvmGasRatio")
 )
 
 const IbcCroDenomDefaultValue = "ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0865"
 const IbcTimeoutDefaultValue = uint64(86400000000000) // 1 day
+const EvmCallGasLimitDefaultValue = uint64(3000000)
+
+var (
+	// EvmGasRatioDefaultValue charges the evm gas of the module calls one for one
+	EvmGasRatioDefaultValue = sdk.OneDec()
+	// EvmGasRatioMaxValue bounds the gas charged for the evm gas of the module calls
+	EvmGasRatioMaxValue = sdk.NewDec(100)
+)
 
 // ParamKeyTable returns the parameter key table.
 func ParamKeyTable() paramtypes.KeyTable {
@@ -29,22 +51,34 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the seele module
-func NewParams(ibcCroDenom string, ibcTimeout uint64, SeeleAdmin string, enableAutoDeployment bool) Params {
+func NewParams(ibcCroDenom string, ibcTimeout uint64, SeeleAdmin string, enableAutoDeployment bool, gravityBridgeContract string, transferRecordRetention uint64, deploymentPolicies []OriginDeploymentPolicy, unconvertedGravityDenoms []string, evmCallGasLimit uint64, evmGasRatio sdk.Dec) Params {
 	return Params{
-		IbcCroDenom:          ibcCroDenom,
-		IbcTimeout:           ibcTimeout,
-		SeeleAdmin:           SeeleAdmin,
-		EnableAutoDeployment: enableAutoDeployment,
+		IbcCroDenom:              ibcCroDenom,
+		IbcTimeout:               ibcTimeout,
+		SeeleAdmin:               SeeleAdmin,
+		EnableAutoDeployment:     enableAutoDeployment,
+		GravityBridgeContract:    gravityBridgeContract,
+		TransferRecordRetention:  transferRecordRetention,
+		DeploymentPolicies:       deploymentPolicies,
+		UnconvertedGravityDenoms: unconvertedGravityDenoms,
+		EvmCallGasLimit:          evmCallGasLimit,
+		EvmGasRatio:              evmGasRatio,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the seele module
 func DefaultParams() Params {
 	return Params{
-		IbcCroDenom:          IbcCroDenomDefaultValue,
-		IbcTimeout:           IbcTimeoutDefaultValue,
-		SeeleAdmin:           "",
-		EnableAutoDeployment: false,
+		IbcCroDenom:              IbcCroDenomDefaultValue,
+		IbcTimeout:               IbcTimeoutDefaultValue,
+		SeeleAdmin:               "",
+		EnableAutoDeployment:     false,
+		GravityBridgeContract:    "",
+		TransferRecordRetention:  0,
+		DeploymentPolicies:       []OriginDeploymentPolicy{},
+		UnconvertedGravityDenoms: []string{},
+		EvmCallGasLimit:          EvmCallGasLimitDefaultValue,
+		EvmGasRatio:              EvmGasRatioDefaultValue,
 	}
 }
 
@@ -61,9 +95,68 @@ func (p Params) Validate() error {
 			return err
 		}
 	}
+	if err := validateIsHexAddress(p.GravityBridgeContract); err != nil {
+		return err
+	}
+	if err := validateDeploymentPolicies(p.DeploymentPolicies); err != nil {
+		return err
+	}
+	if err := validateDenoms(p.UnconvertedGravityDenoms); err != nil {
+		return err
+	}
+	if err := validateEvmCallGasLimit(p.EvmCallGasLimit); err != nil {
+		return err
+	}
+	if err := validateEvmGasRatio(p.EvmGasRatio); err != nil {
+		return err
+	}
 	return nil
 }
 
+// IsUnconvertedGravityDenom returns if the cosmos originated denom is left as bank coins when it returns from ethereum
+func (p Params) IsUnconvertedGravityDenom(denom string) bool {
+	for _, d := range p.UnconvertedGravityDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// DeploymentPolicy returns the policy applied to a token without contract arriving from the origin,
+// the token is the ethereum contract for gravity and the denom for ibc.
+func (p Params) DeploymentPolicy(origin TransferOrigin, token string) DeploymentPolicy {
+	for _, policy := range p.DeploymentPolicies {
+		if policy.Origin != origin {
+			continue
+		}
+		if containsToken(policy.Blacklist, token) {
+			return DeploymentPolicyKeepNative
+		}
+		if len(policy.Whitelist) > 0 && !containsToken(policy.Whitelist, token) {
+			return DeploymentPolicyRequireMapping
+		}
+		if policy.Policy != DeploymentPolicyUnspecified {
+			return policy.Policy
+		}
+		break
+	}
+	if p.EnableAutoDeployment {
+		return DeploymentPolicyAutoDeploy
+	}
+	return DeploymentPolicyRequireMapping
+}
+
+func containsToken(tokens []string, token string) bool {
+	for _, t := range tokens {
+		// ethereum addresses are not case sensitive
+		if strings.EqualFold(t, token) {
+			return true
+		}
+	}
+	return false
+}
+
 // String implements the fmt.Stringer interface
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -77,6 +170,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyIbcTimeout, &p.IbcTimeout, validateIsUint64),
 		paramtypes.NewParamSetPair(KeySeeleAdmin, &p.SeeleAdmin, validateIsAddress),
 		paramtypes.NewParamSetPair(KeyEnableAutoDeployment, &p.EnableAutoDeployment, validateIsBool),
+		paramtypes.NewParamSetPair(KeyGravityBridgeContract, &p.GravityBridgeContract, validateIsHexAddress),
+		paramtypes.NewParamSetPair(KeyTransferRecordRetention, &p.TransferRecordRetention, validateIsUint64),
+		paramtypes.NewParamSetPair(KeyDeploymentPolicies, &p.DeploymentPolicies, validateDeploymentPolicies),
+		paramtypes.NewParamSetPair(KeyUnconvertedGravityDenoms, &p.UnconvertedGravityDenoms, validateDenoms),
+		paramtypes.NewParamSetPair(KeyEvmCallGasLimit, &p.EvmCallGasLimit, validateEvmCallGasLimit),
+		paramtypes.NewParamSetPair(KeyEvmGasRatio, &p.EvmGasRatio, validateEvmGasRatio),
 	}
 }
 
@@ -119,3 +218,86 @@ func validateIsBool(i interface{}) error {
 	}
 	return nil
 }
+
+func validateIsHexAddress(i interface{}) error {
+	s, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(s) > 0 && !common.IsHexAddress(s) {
+		return fmt.Errorf("invalid hex address: %s", s)
+	}
+	return nil
+}
+
+func validateDeploymentPolicies(i interface{}) error {
+	policies, ok := i.([]OriginDeploymentPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	origins := make(map[TransferOrigin]bool)
+	for _, policy := range policies {
+		if policy.Origin != TransferOriginGravity && policy.Origin != TransferOriginIBC {
+			return fmt.Errorf("invalid deployment policy origin: %s", policy.Origin)
+		}
+		if origins[policy.Origin] {
+			return fmt.Errorf("duplicated deployment policy for origin %s", policy.Origin)
+		}
+		origins[policy.Origin] = true
+		if _, ok := DeploymentPolicy_name[int32(policy.Policy)]; !ok {
+			return fmt.Errorf("invalid deployment policy: %d", policy.Policy)
+		}
+		for _, token := range append(policy.Whitelist, policy.Blacklist...) {
+			if policy.Origin == TransferOriginGravity && !common.IsHexAddress(token) {
+				return fmt.Errorf("invalid ethereum contract in deployment policy: %s", token)
+			}
+			if policy.Origin == TransferOriginIBC && !IsValidIBCDenom(token) {
+				return fmt.Errorf("invalid ibc denom in deployment policy: %s", token)
+			}
+		}
+	}
+	return nil
+}
+
+func validateDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool)
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicated denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+func validateEvmCallGasLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("evm call gas limit must be positive")
+	}
+	return nil
+}
+
+func validateEvmGasRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("evm gas ratio must be non-negative: %s", v)
+	}
+	if v.GT(EvmGasRatioMaxValue) {
+		return fmt.Errorf("evm gas ratio must not exceed %s: %s", EvmGasRatioMaxValue, v)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -94,3 +95,163 @@ func Test_validateIsAddress(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateIsHexAddress(t *testing.T) {
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"invalid type", args{true}, true},
+		{"invalid address", args{"0x123"}, true},
+		{"empty address", args{""}, false},
+		{"correct address", args{"0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503"}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateIsHexAddress(tt.args.i) != nil)
+		})
+	}
+}
+
+func Test_validateDeploymentPolicies(t *testing.T) {
+	contract := "0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503"
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"invalid type", args{"a"}, true},
+		{"empty", args{[]OriginDeploymentPolicy{}}, false},
+		{"valid policies", args{[]OriginDeploymentPolicy{
+			{Origin: TransferOriginGravity, Policy: DeploymentPolicyRequireMapping, Whitelist: []string{contract}},
+			{Origin: TransferOriginIBC, Policy: DeploymentPolicyKeepNative, Blacklist: []string{IbcCroDenomDefaultValue}},
+		}}, false},
+		{"unspecified origin", args{[]OriginDeploymentPolicy{
+			{Origin: TransferOriginUnspecified, Policy: DeploymentPolicyAutoDeploy},
+		}}, true},
+		{"duplicated origin", args{[]OriginDeploymentPolicy{
+			{Origin: TransferOriginGravity, Policy: DeploymentPolicyAutoDeploy},
+			{Origin: TransferOriginGravity, Policy: DeploymentPolicyKeepNative},
+		}}, true},
+		{"invalid policy", args{[]OriginDeploymentPolicy{
+			{Origin: TransferOriginGravity, Policy: DeploymentPolicy(10)},
+		}}, true},
+		{"invalid ethereum contract", args{[]OriginDeploymentPolicy{
+			{Origin: TransferOriginGravity, Blacklist: []string{IbcCroDenomDefaultValue}},
+		}}, true},
+		{"invalid ibc denom", args{[]OriginDeploymentPolicy{
+			{Origin: TransferOriginIBC, Whitelist: []string{contract}},
+		}}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateDeploymentPolicies(tt.args.i) != nil)
+		})
+	}
+}
+
+func TestParams_DeploymentPolicy(t *testing.T) {
+	listed := "0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503"
+	other := "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"
+
+	params := DefaultParams()
+	require.Equal(t, DeploymentPolicyRequireMapping, params.DeploymentPolicy(TransferOriginGravity, listed))
+	params.EnableAutoDeployment = true
+	require.Equal(t, DeploymentPolicyAutoDeploy, params.DeploymentPolicy(TransferOriginGravity, listed))
+
+	params.DeploymentPolicies = []OriginDeploymentPolicy{
+		{Origin: TransferOriginGravity, Policy: DeploymentPolicyKeepNative},
+	}
+	require.Equal(t, DeploymentPolicyKeepNative, params.DeploymentPolicy(TransferOriginGravity, listed))
+	require.Equal(t, DeploymentPolicyAutoDeploy, params.DeploymentPolicy(TransferOriginIBC, IbcCroDenomDefaultValue))
+
+	// the whitelist is not case sensitive
+	params.DeploymentPolicies = []OriginDeploymentPolicy{
+		{Origin: TransferOriginGravity, Whitelist: []string{strings.ToLower(listed)}},
+	}
+	require.Equal(t, DeploymentPolicyAutoDeploy, params.DeploymentPolicy(TransferOriginGravity, listed))
+	require.Equal(t, DeploymentPolicyRequireMapping, params.DeploymentPolicy(TransferOriginGravity, other))
+
+	params.DeploymentPolicies = []OriginDeploymentPolicy{
+		{Origin: TransferOriginGravity, Policy: DeploymentPolicyAutoDeploy, Blacklist: []string{listed}},
+	}
+	require.Equal(t, DeploymentPolicyKeepNative, params.DeploymentPolicy(TransferOriginGravity, listed))
+	require.Equal(t, DeploymentPolicyAutoDeploy, params.DeploymentPolicy(TransferOriginGravity, other))
+}
+
+func Test_validateDenoms(t *testing.T) {
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"invalid type", args{"a"}, true},
+		{"valid denoms", args{[]string{"snp", IbcCroDenomDefaultValue}}, false},
+		{"invalid denom", args{[]string{"1"}}, true},
+		{"duplicated denom", args{[]string{"snp", "snp"}}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateDenoms(tt.args.i) != nil)
+		})
+	}
+}
+
+func Test_validateEvmCallGasLimit(t *testing.T) {
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"invalid type", args{"a"}, true},
+		{"default limit", args{EvmCallGasLimitDefaultValue}, false},
+		{"zero limit", args{uint64(0)}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateEvmCallGasLimit(tt.args.i) != nil)
+		})
+	}
+}
+
+func Test_validateEvmGasRatio(t *testing.T) {
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"invalid type", args{"a"}, true},
+		{"default ratio", args{EvmGasRatioDefaultValue}, false},
+		{"free calls", args{sdk.ZeroDec()}, false},
+		{"negative ratio", args{sdk.NewDec(-1)}, true},
+		{"nil ratio", args{sdk.Dec{}}, true},
+		{"max ratio", args{EvmGasRatioMaxValue}, false},
+		{"ratio above the max", args{EvmGasRatioMaxValue.Add(sdk.SmallestDec())}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateEvmGasRatio(tt.args.i) != nil)
+		})
+	}
+}
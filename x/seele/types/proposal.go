@@ -11,14 +11,28 @@ import (
 const (
 	// ProposalTypeTokenMappingChange defines the type for a TokenMappingChangeProposal
 	ProposalTypeTokenMappingChange = "TokenMappingChange"
+	// ProposalTypePendingTokenApproval defines the type for a PendingTokenApprovalProposal
+	ProposalTypePendingTokenApproval = "PendingTokenApproval"
+	// ProposalTypeQuarantinedDepositRelease defines the type for a QuarantinedDepositReleaseProposal
+	ProposalTypeQuarantinedDepositRelease = "QuarantinedDepositRelease"
 )
 
 // Assert TokenMappingChangeProposal implements govtypes.Content at compile-time
 var _ govtypes.Content = &TokenMappingChangeProposal{}
 
+// Assert PendingTokenApprovalProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &PendingTokenApprovalProposal{}
+
+// Assert QuarantinedDepositReleaseProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &QuarantinedDepositReleaseProposal{}
+
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeTokenMappingChange)
 	govtypes.RegisterProposalTypeCodec(&TokenMappingChangeProposal{}, "seele/TokenMappingChangeProposal")
+	govtypes.RegisterProposalType(ProposalTypePendingTokenApproval)
+	govtypes.RegisterProposalTypeCodec(&PendingTokenApprovalProposal{}, "seele/PendingTokenApprovalProposal")
+	govtypes.RegisterProposalType(ProposalTypeQuarantinedDepositRelease)
+	govtypes.RegisterProposalTypeCodec(&QuarantinedDepositReleaseProposal{}, "seele/QuarantinedDepositReleaseProposal")
 }
 
 func NewTokenMappingChangeProposal(title, description, denom string, contractAddr *common.Address) *TokenMappingChangeProposal {
@@ -60,3 +74,92 @@ func (tcp TokenMappingChangeProposal) String() string {
 
 	return b.String()
 }
+
+func NewPendingTokenApprovalProposal(title, description string, tokenContract common.Address, releaseNative bool) *PendingTokenApprovalProposal {
+	return &PendingTokenApprovalProposal{title, description, tokenContract.Hex(), releaseNative}
+}
+
+// GetTitle returns the title of a pending token approval proposal.
+func (ptp *PendingTokenApprovalProposal) GetTitle() string { return ptp.Title }
+
+// GetDescription returns the description of a pending token approval proposal.
+func (ptp *PendingTokenApprovalProposal) GetDescription() string { return ptp.Description }
+
+// ProposalRoute returns the routing key of a pending token approval proposal.
+func (ptp *PendingTokenApprovalProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a pending token approval proposal.
+func (ptp *PendingTokenApprovalProposal) ProposalType() string {
+	return ProposalTypePendingTokenApproval
+}
+
+// ValidateBasic validates the pending token approval proposal
+func (ptp *PendingTokenApprovalProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(ptp); err != nil {
+		return err
+	}
+	if !common.IsHexAddress(ptp.TokenContract) {
+		return fmt.Errorf("invalid token contract address: %s", ptp.TokenContract)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (ptp PendingTokenApprovalProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Pending Token Approval Proposal:
+  Title:          %s
+  Description:    %s
+  Token Contract: %s
+  Release Native: %t
+`, ptp.Title, ptp.Description, ptp.TokenContract, ptp.ReleaseNative))
+
+	return b.String()
+}
+
+func NewQuarantinedDepositReleaseProposal(title, description string, id uint64, receiver string) *QuarantinedDepositReleaseProposal {
+	return &QuarantinedDepositReleaseProposal{title, description, id, receiver}
+}
+
+// GetTitle returns the title of a quarantined deposit release proposal.
+func (qrp *QuarantinedDepositReleaseProposal) GetTitle() string { return qrp.Title }
+
+// GetDescription returns the description of a quarantined deposit release proposal.
+func (qrp *QuarantinedDepositReleaseProposal) GetDescription() string { return qrp.Description }
+
+// ProposalRoute returns the routing key of a quarantined deposit release proposal.
+func (qrp *QuarantinedDepositReleaseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a quarantined deposit release proposal.
+func (qrp *QuarantinedDepositReleaseProposal) ProposalType() string {
+	return ProposalTypeQuarantinedDepositRelease
+}
+
+// ValidateBasic validates the quarantined deposit release proposal
+func (qrp *QuarantinedDepositReleaseProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(qrp); err != nil {
+		return err
+	}
+	if qrp.Id == 0 {
+		return fmt.Errorf("invalid deposit id")
+	}
+	if _, err := ParseCosmosReceiver(qrp.Receiver); err != nil {
+		return fmt.Errorf("invalid receiver address: %w", err)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (qrp QuarantinedDepositReleaseProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Quarantined Deposit Release Proposal:
+  Title:       %s
+  Description: %s
+  Id:          %d
+  Receiver:    %s
+`, qrp.Title, qrp.Description, qrp.Id, qrp.Receiver))
+
+	return b.String()
+}
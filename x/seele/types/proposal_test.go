@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Seele-N/Seele/x/seele/types"
+)
+
+func TestValidateQuarantinedDepositReleaseProposal(t *testing.T) {
+	receiver, err := bech32.ConvertAndEncode(types.MainnetBech32Prefix, common.HexToAddress("0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2").Bytes())
+	require.NoError(t, err)
+	testCases := []struct {
+		name     string
+		proposal *types.QuarantinedDepositReleaseProposal
+		expValid bool
+	}{
+		{
+			"valid hex receiver",
+			types.NewQuarantinedDepositReleaseProposal("title", "description", 1, "0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067"),
+			true,
+		},
+		{
+			"valid bech32 receiver",
+			types.NewQuarantinedDepositReleaseProposal("title", "description", 1, receiver),
+			true,
+		},
+		{
+			"empty title",
+			types.NewQuarantinedDepositReleaseProposal("", "description", 1, receiver),
+			false,
+		},
+		{
+			"invalid id",
+			types.NewQuarantinedDepositReleaseProposal("title", "description", 0, receiver),
+			false,
+		},
+		{
+			"invalid receiver",
+			types.NewQuarantinedDepositReleaseProposal("title", "description", 1, "0x6E7eef2b30585B2A4D45Ba"),
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t1 *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expValid {
+				require.NoError(t1, err)
+			} else {
+				require.Error(t1, err)
+			}
+		})
+	}
+}
@@ -6,7 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -38,7 +41,7 @@ func (m *ContractByDenomRequest) Reset()         { *m = ContractByDenomRequest{}
 func (m *ContractByDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractByDenomRequest) ProtoMessage()    {}
 func (*ContractByDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ed0fd688c48372, []int{0}
+	return fileDescriptor_15e391f7d65c1d9c, []int{0}
 }
 func (m *ContractByDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -84,7 +87,7 @@ func (m *ContractByDenomResponse) Reset()         { *m = ContractByDenomResponse
 func (m *ContractByDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractByDenomResponse) ProtoMessage()    {}
 func (*ContractByDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ed0fd688c48372, []int{1}
+	return fileDescriptor_15e391f7d65c1d9c, []int{1}
 }
 func (m *ContractByDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -136,7 +139,7 @@ func (m *DenomByContractRequest) Reset()         { *m = DenomByContractRequest{}
 func (m *DenomByContractRequest) String() string { return proto.CompactTextString(m) }
 func (*DenomByContractRequest) ProtoMessage()    {}
 func (*DenomByContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ed0fd688c48372, []int{2}
+	return fileDescriptor_15e391f7d65c1d9c, []int{2}
 }
 func (m *DenomByContractRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -181,7 +184,7 @@ func (m *DenomByContractResponse) Reset()         { *m = DenomByContractResponse
 func (m *DenomByContractResponse) String() string { return proto.CompactTextString(m) }
 func (*DenomByContractResponse) ProtoMessage()    {}
 func (*DenomByContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ed0fd688c48372, []int{3}
+	return fileDescriptor_15e391f7d65c1d9c, []int{3}
 }
 func (m *DenomByContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -217,364 +220,4961 @@ func (m *DenomByContractResponse) GetDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*ContractByDenomRequest)(nil), "seele.ContractByDenomRequest")
-	proto.RegisterType((*ContractByDenomResponse)(nil), "seele.ContractByDenomResponse")
-	proto.RegisterType((*DenomByContractRequest)(nil), "seele.DenomByContractRequest")
-	proto.RegisterType((*DenomByContractResponse)(nil), "seele.DenomByContractResponse")
+// EthereumTransferRequest is the request type of EthereumTransfer call
+type EthereumTransferRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("seele/query.proto", fileDescriptor_d4ed0fd688c48372) }
-
-var fileDescriptor_d4ed0fd688c48372 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x4a, 0xeb, 0x40,
-	0x14, 0xc7, 0x9b, 0x42, 0xcb, 0xbd, 0xc3, 0xbd, 0x14, 0x86, 0x4b, 0x7b, 0x09, 0x12, 0x25, 0x8a,
-	0x5f, 0xd8, 0x0c, 0x51, 0x9f, 0xa0, 0xba, 0x73, 0x65, 0x97, 0xdd, 0x94, 0x49, 0x1c, 0xd2, 0x80,
-	0x9d, 0x93, 0x66, 0x26, 0xc5, 0x50, 0x0a, 0xa2, 0x2f, 0x50, 0xf0, 0xa5, 0x5c, 0x16, 0xdc, 0xb8,
-	0x94, 0xd6, 0x07, 0x91, 0x4c, 0x32, 0xad, 0xf4, 0xc3, 0x55, 0xe6, 0x9c, 0xf9, 0x9f, 0x73, 0x7e,
-	0xe7, 0x9f, 0x41, 0xd8, 0x8f, 0x81, 0x83, 0x20, 0x83, 0x84, 0xc5, 0xa9, 0x13, 0xc5, 0x20, 0x01,
-	0x57, 0xf3, 0x9c, 0xb9, 0x13, 0x00, 0x04, 0xf7, 0x8c, 0xd0, 0x28, 0x24, 0x94, 0x73, 0x90, 0x54,
-	0x86, 0xc0, 0x45, 0xae, 0x32, 0x4f, 0x7d, 0x10, 0x7d, 0x10, 0xc4, 0xa3, 0x82, 0xe5, 0xe5, 0x64,
-	0xe8, 0x7a, 0x4c, 0x52, 0x97, 0x44, 0x34, 0x08, 0xb9, 0x12, 0xe7, 0x5a, 0xdb, 0x41, 0xf5, 0x2b,
-	0xe0, 0x32, 0xa6, 0xbe, 0x6c, 0xa5, 0xd7, 0x8c, 0x43, 0xbf, 0xcd, 0x06, 0x09, 0x13, 0x12, 0xff,
-	0x43, 0x95, 0xbb, 0x2c, 0xfe, 0x6f, 0xec, 0x19, 0xc7, 0xbf, 0xdb, 0x79, 0x60, 0x77, 0x50, 0x63,
-	0x4d, 0x2f, 0x22, 0xe0, 0x82, 0x61, 0x13, 0xfd, 0xf2, 0x8b, 0xab, 0xa2, 0x66, 0x11, 0xe3, 0x7d,
-	0xf4, 0x97, 0x26, 0x12, 0xba, 0x0b, 0x41, 0x59, 0x09, 0xfe, 0x64, 0x49, 0xdd, 0xcf, 0xbe, 0x44,
-	0x75, 0xd5, 0xb1, 0x95, 0xea, 0x94, 0x66, 0xf9, 0xa1, 0xb5, 0x4d, 0x50, 0x63, 0xad, 0xaa, 0x20,
-	0xda, 0xb8, 0xc2, 0xf9, 0xa4, 0x8c, 0x2a, 0xb7, 0x99, 0x2b, 0xf8, 0xd1, 0x40, 0xb5, 0x95, 0x6d,
-	0xb0, 0xe5, 0xe4, 0x1e, 0x3b, 0x9b, 0x6d, 0x31, 0x77, 0xb7, 0xde, 0xe7, 0x43, 0xed, 0xb3, 0xa7,
-	0xb7, 0xcf, 0x97, 0xf2, 0x21, 0x3e, 0x20, 0xc5, 0x0f, 0x1c, 0xba, 0x44, 0xc3, 0x76, 0xbd, 0xb4,
-	0xab, 0x20, 0xc8, 0x48, 0x7d, 0xc6, 0xf8, 0xd9, 0x40, 0xb5, 0x15, 0xfc, 0x25, 0xc2, 0x66, 0x37,
-	0x96, 0x08, 0x5b, 0xf6, 0xb6, 0x89, 0x42, 0x38, 0xc1, 0x47, 0xdf, 0x10, 0xd4, 0xbc, 0x6c, 0xbe,
-	0x66, 0x21, 0x23, 0x7d, 0x1a, 0xb7, 0x6e, 0x5e, 0x67, 0x96, 0x31, 0x9d, 0x59, 0xc6, 0xc7, 0xcc,
-	0x32, 0x26, 0x73, 0xab, 0x34, 0x9d, 0x5b, 0xa5, 0xf7, 0xb9, 0x55, 0xea, 0xb8, 0x41, 0x28, 0x7b,
-	0x89, 0xe7, 0xf8, 0xd0, 0x27, 0x7e, 0x9c, 0x46, 0x12, 0x9a, 0x10, 0x07, 0x4d, 0xbf, 0x47, 0x43,
-	0xae, 0xbb, 0x3f, 0xe8, 0x83, 0x4c, 0x23, 0x26, 0xbc, 0xaa, 0x7a, 0x59, 0x17, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xf7, 0xcb, 0x7f, 0xa6, 0xc1, 0x02, 0x00, 0x00,
+func (m *EthereumTransferRequest) Reset()         { *m = EthereumTransferRequest{} }
+func (m *EthereumTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*EthereumTransferRequest) ProtoMessage()    {}
+func (*EthereumTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{4}
+}
+func (m *EthereumTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumTransferRequest.Merge(m, src)
+}
+func (m *EthereumTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumTransferRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_EthereumTransferRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ContractByDenom queries contract addresses by native denom
-	ContractByDenom(ctx context.Context, in *ContractByDenomRequest, opts ...grpc.CallOption) (*ContractByDenomResponse, error)
-	// DenomByContract queries native denom by contract address
-	DenomByContract(ctx context.Context, in *DenomByContractRequest, opts ...grpc.CallOption) (*DenomByContractResponse, error)
+func (m *EthereumTransferRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// EthereumTransferResponse is the response type of EthereumTransfer call
+type EthereumTransferResponse struct {
+	Transfer EthereumTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *EthereumTransferResponse) Reset()         { *m = EthereumTransferResponse{} }
+func (m *EthereumTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*EthereumTransferResponse) ProtoMessage()    {}
+func (*EthereumTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{5}
 }
-
-func (c *queryClient) ContractByDenom(ctx context.Context, in *ContractByDenomRequest, opts ...grpc.CallOption) (*ContractByDenomResponse, error) {
-	out := new(ContractByDenomResponse)
-	err := c.cc.Invoke(ctx, "/seele.Query/ContractByDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EthereumTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *EthereumTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumTransferResponse.Merge(m, src)
+}
+func (m *EthereumTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumTransferResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DenomByContract(ctx context.Context, in *DenomByContractRequest, opts ...grpc.CallOption) (*DenomByContractResponse, error) {
-	out := new(DenomByContractResponse)
-	err := c.cc.Invoke(ctx, "/seele.Query/DenomByContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EthereumTransferResponse proto.InternalMessageInfo
+
+func (m *EthereumTransferResponse) GetTransfer() EthereumTransfer {
+	if m != nil {
+		return m.Transfer
 	}
-	return out, nil
+	return EthereumTransfer{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ContractByDenom queries contract addresses by native denom
-	ContractByDenom(context.Context, *ContractByDenomRequest) (*ContractByDenomResponse, error)
-	// DenomByContract queries native denom by contract address
-	DenomByContract(context.Context, *DenomByContractRequest) (*DenomByContractResponse, error)
+// TransferRecordRequest is the request type of TransferRecord call
+type TransferRecordRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *TransferRecordRequest) Reset()         { *m = TransferRecordRequest{} }
+func (m *TransferRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferRecordRequest) ProtoMessage()    {}
+func (*TransferRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{6}
 }
-
-func (*UnimplementedQueryServer) ContractByDenom(ctx context.Context, req *ContractByDenomRequest) (*ContractByDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractByDenom not implemented")
+func (m *TransferRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DenomByContract(ctx context.Context, req *DenomByContractRequest) (*DenomByContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomByContract not implemented")
+func (m *TransferRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *TransferRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRecordRequest.Merge(m, src)
 }
-
-func _Query_ContractByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractByDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractByDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seele.Query/ContractByDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractByDenom(ctx, req.(*ContractByDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *TransferRecordRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_DenomByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DenomByContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomByContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seele.Query/DenomByContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomByContract(ctx, req.(*DenomByContractRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *TransferRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRecordRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "seele.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ContractByDenom",
-			Handler:    _Query_ContractByDenom_Handler,
-		},
-		{
-			MethodName: "DenomByContract",
-			Handler:    _Query_DenomByContract_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "seele/query.proto",
-}
+var xxx_messageInfo_TransferRecordRequest proto.InternalMessageInfo
 
-func (m *ContractByDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransferRecordRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ContractByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TransferRecordResponse is the response type of TransferRecord and TransferRecordByExternalID calls
+type TransferRecordResponse struct {
+	Record TransferRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
 }
 
-func (m *ContractByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *TransferRecordResponse) Reset()         { *m = TransferRecordResponse{} }
+func (m *TransferRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*TransferRecordResponse) ProtoMessage()    {}
+func (*TransferRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{7}
+}
+func (m *TransferRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TransferRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRecordResponse.Merge(m, src)
+}
+func (m *TransferRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRecordResponse.DiscardUnknown(m)
 }
 
-func (m *ContractByDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TransferRecordResponse proto.InternalMessageInfo
+
+func (m *TransferRecordResponse) GetRecord() TransferRecord {
+	if m != nil {
+		return m.Record
 	}
-	return dAtA[:n], nil
+	return TransferRecord{}
 }
 
-func (m *ContractByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TransferRecordsByAddressRequest is the request type of TransferRecordsByAddress call
+type TransferRecordsByAddressRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ContractByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AutoContract) > 0 {
-		i -= len(m.AutoContract)
-		copy(dAtA[i:], m.AutoContract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AutoContract)))
-		i--
-		dAtA[i] = 0x12
+func (m *TransferRecordsByAddressRequest) Reset()         { *m = TransferRecordsByAddressRequest{} }
+func (m *TransferRecordsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferRecordsByAddressRequest) ProtoMessage()    {}
+func (*TransferRecordsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{8}
+}
+func (m *TransferRecordsByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRecordsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRecordsByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TransferRecordsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRecordsByAddressRequest.Merge(m, src)
+}
+func (m *TransferRecordsByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRecordsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRecordsByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRecordsByAddressRequest proto.InternalMessageInfo
+
+func (m *TransferRecordsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DenomByContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransferRecordsByAddressRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DenomByContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TransferRecordsByAddressResponse is the response type of TransferRecordsByAddress call
+type TransferRecordsByAddressResponse struct {
+	Records    []TransferRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *DenomByContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
+func (m *TransferRecordsByAddressResponse) Reset()         { *m = TransferRecordsByAddressResponse{} }
+func (m *TransferRecordsByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*TransferRecordsByAddressResponse) ProtoMessage()    {}
+func (*TransferRecordsByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{9}
+}
+func (m *TransferRecordsByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRecordsByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRecordsByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TransferRecordsByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRecordsByAddressResponse.Merge(m, src)
+}
+func (m *TransferRecordsByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRecordsByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRecordsByAddressResponse.DiscardUnknown(m)
 }
 
-func (m *DenomByContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TransferRecordsByAddressResponse proto.InternalMessageInfo
+
+func (m *TransferRecordsByAddressResponse) GetRecords() []TransferRecord {
+	if m != nil {
+		return m.Records
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DenomByContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TransferRecordsByAddressResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *DenomByContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+// TransferRecordByExternalIDRequest is the request type of TransferRecordByExternalID call
+type TransferRecordByExternalIDRequest struct {
+	Direction TransferDirection `protobuf:"varint,1,opt,name=direction,proto3,enum=seele.TransferDirection" json:"direction,omitempty"`
+	Origin    TransferOrigin    `protobuf:"varint,2,opt,name=origin,proto3,enum=seele.TransferOrigin" json:"origin,omitempty"`
+	// the ibc channel on this chain, empty for gravity transfers
+	Channel  string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *TransferRecordByExternalIDRequest) Reset()         { *m = TransferRecordByExternalIDRequest{} }
+func (m *TransferRecordByExternalIDRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferRecordByExternalIDRequest) ProtoMessage()    {}
+func (*TransferRecordByExternalIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{10}
+}
+func (m *TransferRecordByExternalIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRecordByExternalIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRecordByExternalIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TransferRecordByExternalIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRecordByExternalIDRequest.Merge(m, src)
+}
+func (m *TransferRecordByExternalIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRecordByExternalIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRecordByExternalIDRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_TransferRecordByExternalIDRequest proto.InternalMessageInfo
+
+func (m *TransferRecordByExternalIDRequest) GetDirection() TransferDirection {
+	if m != nil {
+		return m.Direction
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return TransferDirectionUnspecified
 }
-func (m *ContractByDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *TransferRecordByExternalIDRequest) GetOrigin() TransferOrigin {
+	if m != nil {
+		return m.Origin
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return TransferOriginUnspecified
+}
+
+func (m *TransferRecordByExternalIDRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return n
+	return ""
 }
 
-func (m *ContractByDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TransferRecordByExternalIDRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QuarantinedDepositsRequest is the request type of QuarantinedDeposits call
+type QuarantinedDepositsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuarantinedDepositsRequest) Reset()         { *m = QuarantinedDepositsRequest{} }
+func (m *QuarantinedDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedDepositsRequest) ProtoMessage()    {}
+func (*QuarantinedDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{11}
+}
+func (m *QuarantinedDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedDepositsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.AutoContract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuarantinedDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDepositsRequest.Merge(m, src)
+}
+func (m *QuarantinedDepositsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDepositsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedDepositsRequest proto.InternalMessageInfo
+
+func (m *QuarantinedDepositsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *DenomByContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QuarantinedDepositsResponse is the response type of QuarantinedDeposits call
+type QuarantinedDepositsResponse struct {
+	Deposits   []QuarantinedDeposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuarantinedDepositsResponse) Reset()         { *m = QuarantinedDepositsResponse{} }
+func (m *QuarantinedDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedDepositsResponse) ProtoMessage()    {}
+func (*QuarantinedDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{12}
+}
+func (m *QuarantinedDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuarantinedDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDepositsResponse.Merge(m, src)
+}
+func (m *QuarantinedDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDepositsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedDepositsResponse proto.InternalMessageInfo
+
+func (m *QuarantinedDepositsResponse) GetDeposits() []QuarantinedDeposit {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+func (m *QuarantinedDepositsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// PendingTokenDepositsRequest is the request type of PendingTokenDeposits call
+type PendingTokenDepositsRequest struct {
+	// the ethereum contract of the token, all the pending deposits are returned if empty
+	TokenContract string             `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PendingTokenDepositsRequest) Reset()         { *m = PendingTokenDepositsRequest{} }
+func (m *PendingTokenDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingTokenDepositsRequest) ProtoMessage()    {}
+func (*PendingTokenDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{13}
+}
+func (m *PendingTokenDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTokenDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTokenDepositsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTokenDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTokenDepositsRequest.Merge(m, src)
+}
+func (m *PendingTokenDepositsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTokenDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTokenDepositsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTokenDepositsRequest proto.InternalMessageInfo
+
+func (m *PendingTokenDepositsRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *PendingTokenDepositsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// PendingTokenDepositsResponse is the response type of PendingTokenDeposits call
+type PendingTokenDepositsResponse struct {
+	Deposits   []PendingTokenDeposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits"`
+	Pagination *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PendingTokenDepositsResponse) Reset()         { *m = PendingTokenDepositsResponse{} }
+func (m *PendingTokenDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingTokenDepositsResponse) ProtoMessage()    {}
+func (*PendingTokenDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{14}
+}
+func (m *PendingTokenDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTokenDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTokenDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTokenDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTokenDepositsResponse.Merge(m, src)
+}
+func (m *PendingTokenDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTokenDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTokenDepositsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTokenDepositsResponse proto.InternalMessageInfo
+
+func (m *PendingTokenDepositsResponse) GetDeposits() []PendingTokenDeposit {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+func (m *PendingTokenDepositsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// EvmTracesRequest is the request type of EvmTraces call
+type EvmTracesRequest struct {
+	// the hex encoded hash of the tx
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// the index of the message in the tx
+	MsgIndex uint32 `protobuf:"varint,2,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+}
+
+func (m *EvmTracesRequest) Reset()         { *m = EvmTracesRequest{} }
+func (m *EvmTracesRequest) String() string { return proto.CompactTextString(m) }
+func (*EvmTracesRequest) ProtoMessage()    {}
+func (*EvmTracesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{15}
+}
+func (m *EvmTracesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmTracesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmTracesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmTracesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTracesRequest.Merge(m, src)
+}
+func (m *EvmTracesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmTracesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTracesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmTracesRequest proto.InternalMessageInfo
+
+func (m *EvmTracesRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EvmTracesRequest) GetMsgIndex() uint32 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+// EvmTracesResponse is the response type of EvmTraces call
+type EvmTracesResponse struct {
+	Traces []EvmCallTrace `protobuf:"bytes,1,rep,name=traces,proto3" json:"traces"`
+}
+
+func (m *EvmTracesResponse) Reset()         { *m = EvmTracesResponse{} }
+func (m *EvmTracesResponse) String() string { return proto.CompactTextString(m) }
+func (*EvmTracesResponse) ProtoMessage()    {}
+func (*EvmTracesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{16}
+}
+func (m *EvmTracesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmTracesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmTracesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmTracesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTracesResponse.Merge(m, src)
+}
+func (m *EvmTracesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmTracesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTracesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmTracesResponse proto.InternalMessageInfo
+
+func (m *EvmTracesResponse) GetTraces() []EvmCallTrace {
+	if m != nil {
+		return m.Traces
+	}
+	return nil
+}
+
+// SRC20Balance is the balance of an address in a denom, held in the bank or wrapped in the SRC20 contract of the denom
+type SRC20Balance struct {
+	Denom       string     `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	BankBalance types.Coin `protobuf:"bytes,2,opt,name=bank_balance,json=bankBalance,proto3" json:"bank_balance"`
+	// the SRC20 contract of the denom, the following fields are empty if the denom isn't mapped to a contract
+	Contract     string                                 `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	Src20Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=src20_balance,json=src20Balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"src20_balance"`
+	TotalSupply  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_supply,json=totalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_supply"`
+	Name         string                                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol       string                                 `protobuf:"bytes,7,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals     uint32                                 `protobuf:"varint,8,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *SRC20Balance) Reset()         { *m = SRC20Balance{} }
+func (m *SRC20Balance) String() string { return proto.CompactTextString(m) }
+func (*SRC20Balance) ProtoMessage()    {}
+func (*SRC20Balance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{17}
+}
+func (m *SRC20Balance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SRC20Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SRC20Balance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SRC20Balance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SRC20Balance.Merge(m, src)
+}
+func (m *SRC20Balance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SRC20Balance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SRC20Balance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SRC20Balance proto.InternalMessageInfo
+
+func (m *SRC20Balance) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SRC20Balance) GetBankBalance() types.Coin {
+	if m != nil {
+		return m.BankBalance
+	}
+	return types.Coin{}
+}
+
+func (m *SRC20Balance) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *SRC20Balance) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SRC20Balance) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *SRC20Balance) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+// SRC20BalanceRequest is the request type of SRC20Balance call
+type SRC20BalanceRequest struct {
+	// the address could be either bech32 or hex encoded
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *SRC20BalanceRequest) Reset()         { *m = SRC20BalanceRequest{} }
+func (m *SRC20BalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*SRC20BalanceRequest) ProtoMessage()    {}
+func (*SRC20BalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{18}
+}
+func (m *SRC20BalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SRC20BalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SRC20BalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SRC20BalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SRC20BalanceRequest.Merge(m, src)
+}
+func (m *SRC20BalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SRC20BalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SRC20BalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SRC20BalanceRequest proto.InternalMessageInfo
+
+func (m *SRC20BalanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SRC20BalanceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// SRC20BalanceResponse is the response type of SRC20Balance call
+type SRC20BalanceResponse struct {
+	Balance SRC20Balance `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *SRC20BalanceResponse) Reset()         { *m = SRC20BalanceResponse{} }
+func (m *SRC20BalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*SRC20BalanceResponse) ProtoMessage()    {}
+func (*SRC20BalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{19}
+}
+func (m *SRC20BalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SRC20BalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SRC20BalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SRC20BalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SRC20BalanceResponse.Merge(m, src)
+}
+func (m *SRC20BalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SRC20BalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SRC20BalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SRC20BalanceResponse proto.InternalMessageInfo
+
+func (m *SRC20BalanceResponse) GetBalance() SRC20Balance {
+	if m != nil {
+		return m.Balance
+	}
+	return SRC20Balance{}
+}
+
+// SRC20BalancesRequest is the request type of SRC20Balances call
+type SRC20BalancesRequest struct {
+	// the address could be either bech32 or hex encoded
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *SRC20BalancesRequest) Reset()         { *m = SRC20BalancesRequest{} }
+func (m *SRC20BalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*SRC20BalancesRequest) ProtoMessage()    {}
+func (*SRC20BalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{20}
+}
+func (m *SRC20BalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SRC20BalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SRC20BalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SRC20BalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SRC20BalancesRequest.Merge(m, src)
+}
+func (m *SRC20BalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SRC20BalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SRC20BalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SRC20BalancesRequest proto.InternalMessageInfo
+
+func (m *SRC20BalancesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// SRC20BalancesResponse is the response type of SRC20Balances call
+type SRC20BalancesResponse struct {
+	Balances []SRC20Balance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances"`
+}
+
+func (m *SRC20BalancesResponse) Reset()         { *m = SRC20BalancesResponse{} }
+func (m *SRC20BalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*SRC20BalancesResponse) ProtoMessage()    {}
+func (*SRC20BalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{21}
+}
+func (m *SRC20BalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SRC20BalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SRC20BalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SRC20BalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SRC20BalancesResponse.Merge(m, src)
+}
+func (m *SRC20BalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SRC20BalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SRC20BalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SRC20BalancesResponse proto.InternalMessageInfo
+
+func (m *SRC20BalancesResponse) GetBalances() []SRC20Balance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// SRC20AddressRequest is the request type of SRC20Address call
+type SRC20AddressRequest struct {
+	// the name and the decimals of the contract are resolved from the denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *SRC20AddressRequest) Reset()         { *m = SRC20AddressRequest{} }
+func (m *SRC20AddressRequest) String() string { return proto.CompactTextString(m) }
+func (*SRC20AddressRequest) ProtoMessage()    {}
+func (*SRC20AddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{22}
+}
+func (m *SRC20AddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SRC20AddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SRC20AddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SRC20AddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SRC20AddressRequest.Merge(m, src)
+}
+func (m *SRC20AddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SRC20AddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SRC20AddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SRC20AddressRequest proto.InternalMessageInfo
+
+func (m *SRC20AddressRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// SRC20AddressResponse is the response type of SRC20Address call
+type SRC20AddressResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// true if the contract is already deployed
+	Deployed bool `protobuf:"varint,2,opt,name=deployed,proto3" json:"deployed,omitempty"`
+}
+
+func (m *SRC20AddressResponse) Reset()         { *m = SRC20AddressResponse{} }
+func (m *SRC20AddressResponse) String() string { return proto.CompactTextString(m) }
+func (*SRC20AddressResponse) ProtoMessage()    {}
+func (*SRC20AddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15e391f7d65c1d9c, []int{23}
+}
+func (m *SRC20AddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SRC20AddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SRC20AddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SRC20AddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SRC20AddressResponse.Merge(m, src)
+}
+func (m *SRC20AddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SRC20AddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SRC20AddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SRC20AddressResponse proto.InternalMessageInfo
+
+func (m *SRC20AddressResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SRC20AddressResponse) GetDeployed() bool {
+	if m != nil {
+		return m.Deployed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ContractByDenomRequest)(nil), "seele.ContractByDenomRequest")
+	proto.RegisterType((*ContractByDenomResponse)(nil), "seele.ContractByDenomResponse")
+	proto.RegisterType((*DenomByContractRequest)(nil), "seele.DenomByContractRequest")
+	proto.RegisterType((*DenomByContractResponse)(nil), "seele.DenomByContractResponse")
+	proto.RegisterType((*EthereumTransferRequest)(nil), "seele.EthereumTransferRequest")
+	proto.RegisterType((*EthereumTransferResponse)(nil), "seele.EthereumTransferResponse")
+	proto.RegisterType((*TransferRecordRequest)(nil), "seele.TransferRecordRequest")
+	proto.RegisterType((*TransferRecordResponse)(nil), "seele.TransferRecordResponse")
+	proto.RegisterType((*TransferRecordsByAddressRequest)(nil), "seele.TransferRecordsByAddressRequest")
+	proto.RegisterType((*TransferRecordsByAddressResponse)(nil), "seele.TransferRecordsByAddressResponse")
+	proto.RegisterType((*TransferRecordByExternalIDRequest)(nil), "seele.TransferRecordByExternalIDRequest")
+	proto.RegisterType((*QuarantinedDepositsRequest)(nil), "seele.QuarantinedDepositsRequest")
+	proto.RegisterType((*QuarantinedDepositsResponse)(nil), "seele.QuarantinedDepositsResponse")
+	proto.RegisterType((*PendingTokenDepositsRequest)(nil), "seele.PendingTokenDepositsRequest")
+	proto.RegisterType((*PendingTokenDepositsResponse)(nil), "seele.PendingTokenDepositsResponse")
+	proto.RegisterType((*EvmTracesRequest)(nil), "seele.EvmTracesRequest")
+	proto.RegisterType((*EvmTracesResponse)(nil), "seele.EvmTracesResponse")
+	proto.RegisterType((*SRC20Balance)(nil), "seele.SRC20Balance")
+	proto.RegisterType((*SRC20BalanceRequest)(nil), "seele.SRC20BalanceRequest")
+	proto.RegisterType((*SRC20BalanceResponse)(nil), "seele.SRC20BalanceResponse")
+	proto.RegisterType((*SRC20BalancesRequest)(nil), "seele.SRC20BalancesRequest")
+	proto.RegisterType((*SRC20BalancesResponse)(nil), "seele.SRC20BalancesResponse")
+	proto.RegisterType((*SRC20AddressRequest)(nil), "seele.SRC20AddressRequest")
+	proto.RegisterType((*SRC20AddressResponse)(nil), "seele.SRC20AddressResponse")
+}
+
+func init() { proto.RegisterFile("seele/query.proto", fileDescriptor_15e391f7d65c1d9c) }
+
+var fileDescriptor_15e391f7d65c1d9c = []byte{
+	// 1408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x65, 0x5b, 0x96, 0xc7, 0x1f, 0x49, 0x36, 0x8e, 0xa5, 0xc8, 0x8e, 0x6c, 0xd3, 0x6f,
+	0x6c, 0x27, 0x6f, 0x22, 0xc6, 0x4a, 0x5a, 0xa0, 0x68, 0x2f, 0x95, 0xed, 0x34, 0x69, 0xfa, 0x91,
+	0x28, 0xe9, 0x25, 0x17, 0x81, 0x22, 0xb7, 0x12, 0x11, 0x89, 0xab, 0x70, 0x29, 0xc1, 0x82, 0x20,
+	0xa0, 0x68, 0x7b, 0x29, 0xd0, 0x43, 0x81, 0xfe, 0x82, 0xb6, 0xe8, 0x1f, 0xe8, 0xa1, 0xe7, 0x1e,
+	0x73, 0x0c, 0xd0, 0x4b, 0xd1, 0x43, 0x50, 0x24, 0xfd, 0x09, 0xfd, 0x01, 0x05, 0x77, 0x67, 0x29,
+	0x89, 0x22, 0x6d, 0x20, 0x48, 0x2f, 0x16, 0x77, 0xe7, 0xd9, 0x79, 0x9e, 0x99, 0xfd, 0x98, 0x81,
+	0xe1, 0x1c, 0xa7, 0xb4, 0x49, 0x8d, 0xa7, 0x1d, 0xea, 0xf5, 0x8a, 0x6d, 0x8f, 0xf9, 0x8c, 0xcc,
+	0x8a, 0xa9, 0xfc, 0x55, 0x8b, 0xf1, 0x16, 0xe3, 0x46, 0xcd, 0xe4, 0x68, 0x37, 0xba, 0xfb, 0x35,
+	0xea, 0x9b, 0xfb, 0x46, 0xdb, 0xac, 0x3b, 0xae, 0xe9, 0x3b, 0xcc, 0x95, 0x4b, 0xf2, 0x85, 0x51,
+	0xac, 0x42, 0x59, 0xcc, 0x51, 0xf6, 0x95, 0x3a, 0xab, 0x33, 0xf1, 0x69, 0x04, 0x5f, 0x38, 0xbb,
+	0x5e, 0x67, 0xac, 0xde, 0xa4, 0x86, 0xd9, 0x76, 0x0c, 0xd3, 0x75, 0x99, 0x2f, 0x5c, 0x72, 0xb4,
+	0xa2, 0x32, 0xf1, 0x57, 0x4e, 0xe9, 0x45, 0x58, 0x3d, 0x60, 0xae, 0xef, 0x99, 0x96, 0x5f, 0xee,
+	0x1d, 0x52, 0x97, 0xb5, 0x2a, 0xf4, 0x69, 0x87, 0x72, 0x9f, 0xac, 0xc0, 0xac, 0x1d, 0x8c, 0x73,
+	0xda, 0xa6, 0xb6, 0x37, 0x5f, 0x91, 0x03, 0xfd, 0x31, 0x64, 0x27, 0xf0, 0xbc, 0xcd, 0x5c, 0x4e,
+	0x49, 0x1e, 0x32, 0x16, 0x9a, 0x70, 0x4d, 0x38, 0x26, 0xdb, 0xb0, 0x64, 0x76, 0x7c, 0x56, 0x0d,
+	0x01, 0x29, 0x01, 0x58, 0x0c, 0x26, 0x95, 0x3f, 0xfd, 0x16, 0xac, 0x0a, 0x8f, 0xe5, 0x9e, 0x9a,
+	0x52, 0x5a, 0x4e, 0x70, 0xad, 0x1b, 0x90, 0x9d, 0x58, 0x85, 0x8a, 0xe2, 0x43, 0xb8, 0x02, 0xd9,
+	0x23, 0xbf, 0x41, 0x3d, 0xda, 0x69, 0x3d, 0xf2, 0x4c, 0x97, 0x7f, 0x4e, 0x3d, 0xc5, 0xb3, 0x0c,
+	0x29, 0xc7, 0x16, 0xe8, 0x99, 0x4a, 0xca, 0xb1, 0xf5, 0xcf, 0x20, 0x37, 0x09, 0x45, 0xe7, 0xef,
+	0x40, 0xc6, 0xc7, 0x39, 0xb1, 0x62, 0xa1, 0x94, 0x2d, 0xca, 0xcc, 0x46, 0x97, 0x94, 0x67, 0x9e,
+	0xbd, 0xd8, 0x98, 0xaa, 0x84, 0x70, 0x7d, 0x17, 0x2e, 0x0c, 0xdd, 0x59, 0xcc, 0xb3, 0x93, 0xf8,
+	0x3f, 0x86, 0xd5, 0x28, 0x10, 0xd9, 0x6f, 0x42, 0xda, 0x13, 0x33, 0xc8, 0x7d, 0x01, 0xb9, 0xc7,
+	0xe1, 0xc8, 0x8c, 0x50, 0xfd, 0x2b, 0x0d, 0x36, 0xc6, 0x01, 0xbc, 0xdc, 0x7b, 0xdf, 0xb6, 0x3d,
+	0xca, 0xb9, 0x92, 0x90, 0x83, 0x39, 0x53, 0xce, 0x60, 0xd6, 0xd4, 0x90, 0xdc, 0x06, 0x18, 0x9e,
+	0x52, 0xb1, 0x81, 0x0b, 0xa5, 0x9d, 0xa2, 0x3c, 0xa6, 0xc5, 0xe0, 0x98, 0x16, 0xe5, 0x91, 0xc7,
+	0xc3, 0x5a, 0xbc, 0x6f, 0xd6, 0x29, 0x7a, 0xad, 0x8c, 0xac, 0xd4, 0x7f, 0xd4, 0x60, 0x33, 0x59,
+	0x05, 0xc6, 0xf7, 0x16, 0xcc, 0x49, 0xd1, 0x81, 0x8c, 0xe9, 0xd3, 0x02, 0x54, 0x58, 0xf2, 0x41,
+	0x8c, 0xc6, 0xdd, 0x53, 0x35, 0x4a, 0xce, 0x31, 0x91, 0xbf, 0x69, 0xb0, 0x15, 0xa1, 0xea, 0x1d,
+	0x1d, 0xfb, 0xd4, 0x73, 0xcd, 0xe6, 0xdd, 0x43, 0x95, 0xac, 0xb7, 0x61, 0xde, 0x76, 0x3c, 0x6a,
+	0x09, 0xb6, 0x20, 0x5d, 0xcb, 0xa5, 0x5c, 0x44, 0xe7, 0xa1, 0xb2, 0x57, 0x86, 0x50, 0x72, 0x1d,
+	0xd2, 0xcc, 0x73, 0xea, 0x8e, 0x94, 0xb8, 0x3c, 0x11, 0xdc, 0xa7, 0xc2, 0x58, 0x41, 0x50, 0xb0,
+	0x27, 0x56, 0xc3, 0x74, 0x5d, 0xda, 0xcc, 0x4d, 0xcb, 0x3d, 0xc1, 0x61, 0x70, 0x31, 0x78, 0xa0,
+	0xc5, 0xb5, 0x68, 0x6e, 0x46, 0x1c, 0x9b, 0x70, 0xac, 0xdb, 0x90, 0x7f, 0xd0, 0x31, 0x3d, 0xd3,
+	0xf5, 0x1d, 0x97, 0xda, 0x87, 0xb4, 0xcd, 0xb8, 0xe3, 0x87, 0xfb, 0x3c, 0xbe, 0x9b, 0xda, 0x6b,
+	0xef, 0xe6, 0x4f, 0x1a, 0xac, 0xc5, 0xd2, 0xe0, 0x46, 0xbe, 0x0b, 0x19, 0x1b, 0xe7, 0x70, 0x27,
+	0x2f, 0x62, 0xb0, 0x93, 0xab, 0xd4, 0x45, 0x51, 0x0b, 0xde, 0xdc, 0x76, 0x7e, 0xab, 0xc1, 0xda,
+	0x7d, 0xea, 0xda, 0x8e, 0x5b, 0x7f, 0xc4, 0x9e, 0x50, 0x37, 0x9a, 0x8d, 0xcb, 0xb0, 0xec, 0x07,
+	0xf3, 0xd5, 0xc8, 0x33, 0xb3, 0x24, 0x66, 0xd5, 0xc3, 0xf2, 0xc6, 0xae, 0xc0, 0xcf, 0x1a, 0xac,
+	0xc7, 0xcb, 0xc1, 0xac, 0xbd, 0x37, 0x91, 0xb5, 0x3c, 0x66, 0x2d, 0x66, 0xd9, 0x7f, 0x97, 0xb6,
+	0x3b, 0x70, 0xf6, 0xa8, 0x1b, 0xbc, 0x63, 0x16, 0x0d, 0x53, 0x95, 0x85, 0x39, 0xff, 0xb8, 0xda,
+	0x30, 0x79, 0x03, 0x73, 0x94, 0xf6, 0x8f, 0xef, 0x98, 0xbc, 0x41, 0xd6, 0x60, 0xbe, 0xc5, 0xeb,
+	0x55, 0xc7, 0xb5, 0xe9, 0xb1, 0x20, 0x5d, 0xaa, 0x64, 0x5a, 0xbc, 0x7e, 0x37, 0x18, 0xeb, 0xb7,
+	0xe1, 0xdc, 0x88, 0x27, 0x8c, 0x72, 0x1f, 0xd2, 0xbe, 0x98, 0xc1, 0x18, 0xcf, 0xab, 0x07, 0xb4,
+	0xdb, 0x3a, 0x30, 0x9b, 0x4d, 0x81, 0x56, 0x4f, 0x98, 0x04, 0xea, 0xff, 0xa4, 0x60, 0xf1, 0x61,
+	0xe5, 0xa0, 0x74, 0xa3, 0x6c, 0x36, 0x4d, 0xd7, 0x4a, 0x78, 0xe3, 0x49, 0x19, 0x16, 0x6b, 0xa6,
+	0xfb, 0xa4, 0x5a, 0x93, 0x28, 0xcc, 0xc1, 0xc5, 0xb1, 0x1c, 0xa8, 0xe8, 0x0f, 0x98, 0xe3, 0x22,
+	0xcb, 0x42, 0xb0, 0x48, 0x79, 0x1e, 0x2d, 0x3a, 0xd3, 0x91, 0x7a, 0xf6, 0x10, 0x96, 0xb8, 0x67,
+	0x95, 0x6e, 0x84, 0x04, 0xc1, 0xe5, 0x9b, 0x2f, 0x17, 0x03, 0x2f, 0x7f, 0xbe, 0xd8, 0xd8, 0xa9,
+	0x3b, 0x7e, 0xa3, 0x53, 0x2b, 0x5a, 0xac, 0x65, 0x60, 0x1d, 0x97, 0x3f, 0xd7, 0xb9, 0xfd, 0xc4,
+	0xf0, 0x7b, 0x6d, 0xca, 0x8b, 0x77, 0x5d, 0xbf, 0xb2, 0x28, 0x9c, 0x28, 0xc2, 0x07, 0xb0, 0xe8,
+	0x33, 0xdf, 0x6c, 0x56, 0x79, 0xa7, 0xdd, 0x6e, 0xf6, 0x72, 0xb3, 0xaf, 0xe5, 0x73, 0x41, 0xf8,
+	0x78, 0x28, 0x5c, 0x10, 0x02, 0x33, 0xae, 0xd9, 0xa2, 0xb9, 0xb4, 0xd0, 0x2f, 0xbe, 0xc9, 0x2a,
+	0xa4, 0x79, 0xaf, 0x55, 0x63, 0xcd, 0xdc, 0x9c, 0xdc, 0x3f, 0x39, 0x0a, 0xe2, 0xb5, 0xa9, 0xe5,
+	0xb4, 0xcc, 0x26, 0xcf, 0x65, 0xe4, 0xf6, 0xa9, 0xb1, 0x7e, 0x04, 0xe7, 0x47, 0xb3, 0x7e, 0x7a,
+	0xb1, 0x08, 0xb7, 0x25, 0x35, 0x5a, 0x7a, 0xef, 0xc1, 0xca, 0xb8, 0x9b, 0xb0, 0x9a, 0xcd, 0xa9,
+	0x44, 0xca, 0x97, 0x48, 0x9d, 0x84, 0x51, 0xb4, 0x7a, 0xeb, 0x11, 0xa9, 0xdf, 0x18, 0x77, 0x76,
+	0x7a, 0x05, 0xd3, 0x3f, 0x81, 0x0b, 0x91, 0x15, 0x61, 0xb5, 0xc9, 0xa0, 0xd7, 0xe8, 0x51, 0x8c,
+	0x11, 0x10, 0x42, 0xf5, 0x7d, 0xcc, 0x4a, 0xa4, 0x84, 0xc6, 0x1e, 0xc9, 0x0f, 0x67, 0x32, 0xa9,
+	0xb3, 0xd3, 0xfa, 0x47, 0xb0, 0x32, 0xbe, 0x04, 0x15, 0x24, 0x67, 0x52, 0x6c, 0x4b, 0xbb, 0xc9,
+	0x7a, 0xd4, 0x16, 0xc9, 0xcc, 0x54, 0xc2, 0x71, 0xe9, 0xd7, 0x45, 0x98, 0x7d, 0x10, 0x5c, 0x65,
+	0x32, 0x80, 0x33, 0x91, 0xbe, 0x8c, 0x5c, 0xc2, 0x10, 0xe2, 0xfb, 0xbb, 0x7c, 0x21, 0xc9, 0x2c,
+	0x15, 0xe9, 0xff, 0xff, 0xf2, 0xf7, 0xbf, 0xbf, 0x4f, 0x5d, 0x26, 0xdb, 0xb2, 0x5f, 0x34, 0xba,
+	0x41, 0xfb, 0x29, 0xa1, 0xd5, 0x5a, 0xaf, 0x2a, 0x82, 0x32, 0xfa, 0xe2, 0x67, 0x40, 0xbe, 0xd0,
+	0xe0, 0x4c, 0xa4, 0x0b, 0x0b, 0xf9, 0xe3, 0x7b, 0xba, 0x7c, 0x21, 0xc9, 0x8c, 0xfc, 0x45, 0xc1,
+	0xbf, 0x47, 0x76, 0x86, 0xfc, 0x82, 0x2c, 0x20, 0x57, 0x42, 0x8c, 0xbe, 0xfa, 0x1a, 0x90, 0x01,
+	0x9c, 0x8d, 0x36, 0x5e, 0xa4, 0x90, 0xd0, 0x91, 0x29, 0x0d, 0x1b, 0x89, 0x76, 0x14, 0xb1, 0x27,
+	0x44, 0xe8, 0x64, 0x73, 0x28, 0x82, 0x22, 0xb6, 0xaa, 0xda, 0x39, 0xa3, 0xef, 0xd8, 0x03, 0xd2,
+	0x85, 0xe5, 0xf1, 0x7e, 0x81, 0xac, 0xc7, 0x76, 0x2c, 0x8a, 0xfa, 0x52, 0x82, 0x15, 0x89, 0x77,
+	0x05, 0xf1, 0x16, 0xd9, 0x18, 0x12, 0x2b, 0xbe, 0x2a, 0x36, 0x3b, 0x92, 0xf7, 0x07, 0x0d, 0x72,
+	0x49, 0xdd, 0x14, 0xd9, 0x89, 0x25, 0x99, 0x68, 0xfa, 0xf2, 0xbb, 0xa7, 0xe2, 0x50, 0xd6, 0x2d,
+	0x21, 0xab, 0x48, 0xae, 0x9d, 0x20, 0x0b, 0x0f, 0xae, 0xd1, 0xc7, 0x8f, 0x01, 0xf9, 0x45, 0x83,
+	0x7c, 0x72, 0x33, 0x45, 0xf6, 0xe2, 0x5b, 0xbb, 0xc9, 0x7e, 0xeb, 0xb4, 0xa4, 0xdd, 0x13, 0xea,
+	0x8e, 0xc8, 0xc1, 0x09, 0xea, 0x28, 0x3a, 0x35, 0xfa, 0x61, 0x3b, 0x36, 0x30, 0xfa, 0xb2, 0xd1,
+	0x1a, 0x18, 0x7d, 0xd5, 0x3d, 0x0d, 0xc8, 0x37, 0x1a, 0xac, 0xc4, 0xd5, 0x68, 0xa2, 0x27, 0x57,
+	0xe2, 0x30, 0xa1, 0xdb, 0x27, 0x62, 0x92, 0x0f, 0x57, 0x5b, 0xe2, 0xab, 0xb2, 0x19, 0x09, 0x0b,
+	0xfa, 0xd7, 0x1a, 0x9c, 0x8f, 0x69, 0xb2, 0xc8, 0x56, 0x62, 0x2b, 0x15, 0x2a, 0xd1, 0x4f, 0x82,
+	0xa0, 0x90, 0x1d, 0x21, 0x64, 0x93, 0x14, 0x86, 0x42, 0x9e, 0x0e, 0xe1, 0x43, 0x19, 0x1e, 0xcc,
+	0x87, 0x45, 0x9c, 0x64, 0x87, 0xc5, 0x7a, 0xac, 0x41, 0xc8, 0xe7, 0x26, 0x0d, 0xc8, 0x53, 0x12,
+	0x3c, 0xd7, 0xc8, 0xd5, 0x21, 0x0f, 0xed, 0x8a, 0x8b, 0x64, 0x51, 0x6e, 0xf4, 0xb1, 0xad, 0x18,
+	0x18, 0xfd, 0xb0, 0x8f, 0x18, 0x10, 0x2f, 0x52, 0xef, 0xf3, 0x31, 0x0f, 0xb3, 0x62, 0x5e, 0x8b,
+	0xb5, 0x21, 0xf9, 0x15, 0x41, 0xbe, 0x4d, 0xb6, 0x86, 0xe4, 0x63, 0x25, 0x7c, 0xe4, 0xbc, 0x76,
+	0x61, 0x69, 0xd4, 0x05, 0x27, 0x71, 0x8e, 0xc3, 0x78, 0xd7, 0xe3, 0x8d, 0x48, 0x7b, 0x55, 0xd0,
+	0xfe, 0x8f, 0xe8, 0x09, 0xb4, 0xa3, 0xf7, 0xa4, 0x81, 0xb1, 0xaa, 0xeb, 0x3b, 0x16, 0x6b, 0xe4,
+	0xca, 0xae, 0xc5, 0xda, 0x90, 0x74, 0x43, 0x90, 0x5e, 0x24, 0xd9, 0x28, 0x29, 0x52, 0x95, 0x77,
+	0x9f, 0xbd, 0x2c, 0x68, 0xcf, 0x5f, 0x16, 0xb4, 0xbf, 0x5e, 0x16, 0xb4, 0xef, 0x5e, 0x15, 0xa6,
+	0x9e, 0xbf, 0x2a, 0x4c, 0xfd, 0xf1, 0xaa, 0x30, 0xf5, 0x78, 0xe9, 0x18, 0xd7, 0x88, 0x8e, 0xa2,
+	0x96, 0x16, 0xff, 0x26, 0xb8, 0xf9, 0xef, 0x00, 0xe2, 0x34, 0x54, 0x82, 0xd5, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ContractByDenom queries contract addresses by native denom
+	ContractByDenom(ctx context.Context, in *ContractByDenomRequest, opts ...grpc.CallOption) (*ContractByDenomResponse, error)
+	// DenomByContract queries native denom by contract address
+	DenomByContract(ctx context.Context, in *DenomByContractRequest, opts ...grpc.CallOption) (*DenomByContractResponse, error)
+	// EthereumTransfer queries the status of a transfer to ethereum initiated from the evm
+	EthereumTransfer(ctx context.Context, in *EthereumTransferRequest, opts ...grpc.CallOption) (*EthereumTransferResponse, error)
+	// TransferRecord queries a record of the bridge transfer ledger by id
+	TransferRecord(ctx context.Context, in *TransferRecordRequest, opts ...grpc.CallOption) (*TransferRecordResponse, error)
+	// TransferRecordsByAddress queries the records of the bridge transfer ledger sent or received by an address,
+	// the address could be either bech32 or hex encoded
+	TransferRecordsByAddress(ctx context.Context, in *TransferRecordsByAddressRequest, opts ...grpc.CallOption) (*TransferRecordsByAddressResponse, error)
+	// TransferRecordByExternalID queries a record of the bridge transfer ledger by the gravity nonce or ibc sequence
+	TransferRecordByExternalID(ctx context.Context, in *TransferRecordByExternalIDRequest, opts ...grpc.CallOption) (*TransferRecordResponse, error)
+	// PendingTokenDeposits queries the gravity deposits waiting for a token mapping
+	PendingTokenDeposits(ctx context.Context, in *PendingTokenDepositsRequest, opts ...grpc.CallOption) (*PendingTokenDepositsResponse, error)
+	// QuarantinedDeposits queries the gravity deposits waiting to be released
+	QuarantinedDeposits(ctx context.Context, in *QuarantinedDepositsRequest, opts ...grpc.CallOption) (*QuarantinedDepositsResponse, error)
+	// EvmTraces queries the traces of the evm calls made by the module while executing a message of a tx,
+	// only served by the nodes configured with an evm tracer
+	EvmTraces(ctx context.Context, in *EvmTracesRequest, opts ...grpc.CallOption) (*EvmTracesResponse, error)
+	// SRC20Balance queries the balance of an address in a denom, combining the bank balance and the balance
+	// wrapped in the SRC20 contract of the denom with the metadata of the contract
+	SRC20Balance(ctx context.Context, in *SRC20BalanceRequest, opts ...grpc.CallOption) (*SRC20BalanceResponse, error)
+	// SRC20Balances queries the balances of an address in the denoms held in the bank or mapped to a SRC20 contract
+	SRC20Balances(ctx context.Context, in *SRC20BalancesRequest, opts ...grpc.CallOption) (*SRC20BalancesResponse, error)
+	// SRC20Address queries the address of the SRC20 contract auto deployed for a denom, whether it is deployed or not
+	SRC20Address(ctx context.Context, in *SRC20AddressRequest, opts ...grpc.CallOption) (*SRC20AddressResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ContractByDenom(ctx context.Context, in *ContractByDenomRequest, opts ...grpc.CallOption) (*ContractByDenomResponse, error) {
+	out := new(ContractByDenomResponse)
+	err := c.cc.Invoke(ctx, "/seele.Query/ContractByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomByContract(ctx context.Context, in *DenomByContractRequest, opts ...grpc.CallOption) (*DenomByContractResponse, error) {
+	out := new(DenomByContractResponse)
+	err := c.cc.Invoke(ctx, "/seele.Query/DenomByContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EthereumTransfer(ctx context.Context, in *EthereumTransferRequest, opts ...grpc.CallOption) (*EthereumTransferResponse, error) {
+	out := new(EthereumTransferResponse)
+	err := c.cc.Invoke(ctx, "/seele.Query/EthereumTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferRecord(ctx context.Context, in *TransferRecordRequest, opts ...grpc.CallOption) (*TransferRecordResponse, error) {
+	out := new(TransferRecordResponse)
+	err := c.cc.Invoke(ctx, "/seele.Query/TransferRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferRecordsByAddress(ctx context.Context, in *TransferRecordsByAddressRequest, opts ...grpc.CallOption) (*TransferRecordsByAddressResponse, error) {
+	out := new(TransferRecordsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/seele.Query/TransferRecordsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferRecordByExternalID(ctx context.Context, in *TransferRecordByExternalIDRequest, opts ...grpc.CallOption) (*TransferRecordResponse, error) {
+	out := new(TransferRecordResponse)
+	err := c.cc.Invoke(ctx, "/seele.Query/TransferRecordByExternalID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingTokenDeposits(ctx context.Context, in *PendingTokenDepositsRequest, opts ...grpc.CallOption) (*PendingTokenDepositsResponse, error) {
+	out := new(PendingTokenDepositsResponse)
+	err := c.cc.Invoke(ctx, "/seele.Query/PendingTokenDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuarantinedDeposits(ctx context.Context, in *QuarantinedDepositsRequest, opts ...grpc.CallOption) (*QuarantinedDepositsResponse, error) {
+	out := new(QuarantinedDepositsResponse)
+	err := c.cc.Invoke(ctx, "/seele.Query/QuarantinedDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EvmTraces(ctx context.Context, in *EvmTracesRequest, opts ...grpc.CallOption) (*EvmTracesResponse, error) {
+	out := new(EvmTracesResponse)
+	err := c.cc.Invoke(ctx, "/seele.Query/EvmTraces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SRC20Balance(ctx context.Context, in *SRC20BalanceRequest, opts ...grpc.CallOption) (*SRC20BalanceResponse, error) {
+	out := new(SRC20BalanceResponse)
+	err := c.cc.Invoke(ctx, "/seele.Query/SRC20Balance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SRC20Balances(ctx context.Context, in *SRC20BalancesRequest, opts ...grpc.CallOption) (*SRC20BalancesResponse, error) {
+	out := new(SRC20BalancesResponse)
+	err := c.cc.Invoke(ctx, "/seele.Query/SRC20Balances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SRC20Address(ctx context.Context, in *SRC20AddressRequest, opts ...grpc.CallOption) (*SRC20AddressResponse, error) {
+	out := new(SRC20AddressResponse)
+	err := c.cc.Invoke(ctx, "/seele.Query/SRC20Address", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ContractByDenom queries contract addresses by native denom
+	ContractByDenom(context.Context, *ContractByDenomRequest) (*ContractByDenomResponse, error)
+	// DenomByContract queries native denom by contract address
+	DenomByContract(context.Context, *DenomByContractRequest) (*DenomByContractResponse, error)
+	// EthereumTransfer queries the status of a transfer to ethereum initiated from the evm
+	EthereumTransfer(context.Context, *EthereumTransferRequest) (*EthereumTransferResponse, error)
+	// TransferRecord queries a record of the bridge transfer ledger by id
+	TransferRecord(context.Context, *TransferRecordRequest) (*TransferRecordResponse, error)
+	// TransferRecordsByAddress queries the records of the bridge transfer ledger sent or received by an address,
+	// the address could be either bech32 or hex encoded
+	TransferRecordsByAddress(context.Context, *TransferRecordsByAddressRequest) (*TransferRecordsByAddressResponse, error)
+	// TransferRecordByExternalID queries a record of the bridge transfer ledger by the gravity nonce or ibc sequence
+	TransferRecordByExternalID(context.Context, *TransferRecordByExternalIDRequest) (*TransferRecordResponse, error)
+	// PendingTokenDeposits queries the gravity deposits waiting for a token mapping
+	PendingTokenDeposits(context.Context, *PendingTokenDepositsRequest) (*PendingTokenDepositsResponse, error)
+	// QuarantinedDeposits queries the gravity deposits waiting to be released
+	QuarantinedDeposits(context.Context, *QuarantinedDepositsRequest) (*QuarantinedDepositsResponse, error)
+	// EvmTraces queries the traces of the evm calls made by the module while executing a message of a tx,
+	// only served by the nodes configured with an evm tracer
+	EvmTraces(context.Context, *EvmTracesRequest) (*EvmTracesResponse, error)
+	// SRC20Balance queries the balance of an address in a denom, combining the bank balance and the balance
+	// wrapped in the SRC20 contract of the denom with the metadata of the contract
+	SRC20Balance(context.Context, *SRC20BalanceRequest) (*SRC20BalanceResponse, error)
+	// SRC20Balances queries the balances of an address in the denoms held in the bank or mapped to a SRC20 contract
+	SRC20Balances(context.Context, *SRC20BalancesRequest) (*SRC20BalancesResponse, error)
+	// SRC20Address queries the address of the SRC20 contract auto deployed for a denom, whether it is deployed or not
+	SRC20Address(context.Context, *SRC20AddressRequest) (*SRC20AddressResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ContractByDenom(ctx context.Context, req *ContractByDenomRequest) (*ContractByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractByDenom not implemented")
+}
+func (*UnimplementedQueryServer) DenomByContract(ctx context.Context, req *DenomByContractRequest) (*DenomByContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomByContract not implemented")
+}
+func (*UnimplementedQueryServer) EthereumTransfer(ctx context.Context, req *EthereumTransferRequest) (*EthereumTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthereumTransfer not implemented")
+}
+func (*UnimplementedQueryServer) TransferRecord(ctx context.Context, req *TransferRecordRequest) (*TransferRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRecord not implemented")
+}
+func (*UnimplementedQueryServer) TransferRecordsByAddress(ctx context.Context, req *TransferRecordsByAddressRequest) (*TransferRecordsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRecordsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) TransferRecordByExternalID(ctx context.Context, req *TransferRecordByExternalIDRequest) (*TransferRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRecordByExternalID not implemented")
+}
+func (*UnimplementedQueryServer) PendingTokenDeposits(ctx context.Context, req *PendingTokenDepositsRequest) (*PendingTokenDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTokenDeposits not implemented")
+}
+func (*UnimplementedQueryServer) QuarantinedDeposits(ctx context.Context, req *QuarantinedDepositsRequest) (*QuarantinedDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantinedDeposits not implemented")
+}
+func (*UnimplementedQueryServer) EvmTraces(ctx context.Context, req *EvmTracesRequest) (*EvmTracesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvmTraces not implemented")
+}
+func (*UnimplementedQueryServer) SRC20Balance(ctx context.Context, req *SRC20BalanceRequest) (*SRC20BalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SRC20Balance not implemented")
+}
+func (*UnimplementedQueryServer) SRC20Balances(ctx context.Context, req *SRC20BalancesRequest) (*SRC20BalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SRC20Balances not implemented")
+}
+func (*UnimplementedQueryServer) SRC20Address(ctx context.Context, req *SRC20AddressRequest) (*SRC20AddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SRC20Address not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ContractByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Query/ContractByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractByDenom(ctx, req.(*ContractByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomByContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomByContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Query/DenomByContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomByContract(ctx, req.(*DenomByContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EthereumTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthereumTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EthereumTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Query/EthereumTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthereumTransfer(ctx, req.(*EthereumTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Query/TransferRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferRecord(ctx, req.(*TransferRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferRecordsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRecordsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferRecordsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Query/TransferRecordsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferRecordsByAddress(ctx, req.(*TransferRecordsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferRecordByExternalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRecordByExternalIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferRecordByExternalID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Query/TransferRecordByExternalID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferRecordByExternalID(ctx, req.(*TransferRecordByExternalIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingTokenDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingTokenDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTokenDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Query/PendingTokenDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTokenDeposits(ctx, req.(*PendingTokenDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuarantinedDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantinedDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuarantinedDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Query/QuarantinedDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuarantinedDeposits(ctx, req.(*QuarantinedDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EvmTraces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvmTracesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvmTraces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Query/EvmTraces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvmTraces(ctx, req.(*EvmTracesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SRC20Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SRC20BalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SRC20Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Query/SRC20Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SRC20Balance(ctx, req.(*SRC20BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SRC20Balances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SRC20BalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SRC20Balances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Query/SRC20Balances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SRC20Balances(ctx, req.(*SRC20BalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SRC20Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SRC20AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SRC20Address(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Query/SRC20Address",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SRC20Address(ctx, req.(*SRC20AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "seele.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ContractByDenom",
+			Handler:    _Query_ContractByDenom_Handler,
+		},
+		{
+			MethodName: "DenomByContract",
+			Handler:    _Query_DenomByContract_Handler,
+		},
+		{
+			MethodName: "EthereumTransfer",
+			Handler:    _Query_EthereumTransfer_Handler,
+		},
+		{
+			MethodName: "TransferRecord",
+			Handler:    _Query_TransferRecord_Handler,
+		},
+		{
+			MethodName: "TransferRecordsByAddress",
+			Handler:    _Query_TransferRecordsByAddress_Handler,
+		},
+		{
+			MethodName: "TransferRecordByExternalID",
+			Handler:    _Query_TransferRecordByExternalID_Handler,
+		},
+		{
+			MethodName: "PendingTokenDeposits",
+			Handler:    _Query_PendingTokenDeposits_Handler,
+		},
+		{
+			MethodName: "QuarantinedDeposits",
+			Handler:    _Query_QuarantinedDeposits_Handler,
+		},
+		{
+			MethodName: "EvmTraces",
+			Handler:    _Query_EvmTraces_Handler,
+		},
+		{
+			MethodName: "SRC20Balance",
+			Handler:    _Query_SRC20Balance_Handler,
+		},
+		{
+			MethodName: "SRC20Balances",
+			Handler:    _Query_SRC20Balances_Handler,
+		},
+		{
+			MethodName: "SRC20Address",
+			Handler:    _Query_SRC20Address_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "seele/query.proto",
+}
+
+func (m *ContractByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AutoContract) > 0 {
+		i -= len(m.AutoContract)
+		copy(dAtA[i:], m.AutoContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AutoContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomByContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomByContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomByContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomByContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomByContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomByContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRecordsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRecordsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecordsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRecordsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRecordsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecordsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRecordByExternalIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRecordByExternalIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecordByExternalIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Origin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Direction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantinedDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantinedDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTokenDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTokenDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTokenDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTokenDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTokenDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTokenDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmTracesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmTracesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmTracesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmTracesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmTracesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmTracesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Traces) > 0 {
+		for iNdEx := len(m.Traces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Traces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SRC20Balance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SRC20Balance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SRC20Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Src20Balance.Size()
+		i -= size
+		if _, err := m.Src20Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.BankBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SRC20BalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SRC20BalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SRC20BalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SRC20BalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SRC20BalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SRC20BalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SRC20BalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SRC20BalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SRC20BalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SRC20BalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SRC20BalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SRC20BalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SRC20AddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SRC20AddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SRC20AddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SRC20AddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SRC20AddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SRC20AddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deployed {
+		i--
+		if m.Deployed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ContractByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AutoContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomByContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomByContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EthereumTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *EthereumTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TransferRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *TransferRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TransferRecordsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferRecordsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferRecordByExternalIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		n += 1 + sovQuery(uint64(m.Direction))
+	}
+	if m.Origin != 0 {
+		n += 1 + sovQuery(uint64(m.Origin))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QuarantinedDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuarantinedDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingTokenDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingTokenDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EvmTracesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *EvmTracesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Traces) > 0 {
+		for _, e := range m.Traces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SRC20Balance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.BankBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Src20Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *SRC20BalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SRC20BalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SRC20BalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SRC20BalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SRC20AddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SRC20AddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Deployed {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ContractByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomByContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomByContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomByContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomByContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomByContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRecordsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecordsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecordsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRecordsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecordsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecordsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, TransferRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRecordByExternalIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecordByExternalIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecordByExternalIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= TransferDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= TransferOrigin(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, QuarantinedDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingTokenDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTokenDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTokenDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingTokenDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTokenDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTokenDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, PendingTokenDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmTracesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmTracesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmTracesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmTracesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmTracesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmTracesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Traces = append(m.Traces, EvmCallTrace{})
+			if err := m.Traces[len(m.Traces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SRC20Balance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SRC20Balance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SRC20Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Src20Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Src20Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *DenomByContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ContractByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *SRC20BalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -597,13 +5197,45 @@ func (m *ContractByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SRC20BalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SRC20BalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -656,7 +5288,7 @@ func (m *ContractByDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractByDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *SRC20BalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -679,17 +5311,17 @@ func (m *ContractByDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractByDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SRC20BalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SRC20BalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -699,27 +5331,78 @@ func (m *ContractByDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SRC20BalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SRC20BalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SRC20BalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -747,7 +5430,7 @@ func (m *ContractByDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AutoContract = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -770,7 +5453,7 @@ func (m *ContractByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomByContractRequest) Unmarshal(dAtA []byte) error {
+func (m *SRC20BalancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -793,15 +5476,99 @@ func (m *DenomByContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomByContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SRC20BalancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SRC20BalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, SRC20Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SRC20AddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SRC20AddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SRC20AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -829,7 +5596,7 @@ func (m *DenomByContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -852,7 +5619,7 @@ func (m *DenomByContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomByContractResponse) Unmarshal(dAtA []byte) error {
+func (m *SRC20AddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -875,15 +5642,15 @@ func (m *DenomByContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomByContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SRC20AddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomByContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SRC20AddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -911,8 +5678,28 @@ func (m *DenomByContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deployed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// panicSelector is the selector of the Panic(uint256) error raised by the failed assertions of solidity
+var panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+// DecodeRevertReason returns the reason of a reverted evm call from its abi encoded return data,
+// either the message of an Error(string) or the code of a Panic(uint256). Other data are hex encoded.
+func DecodeRevertReason(ret []byte) string {
+	if len(ret) == 0 {
+		return ""
+	}
+	if reason, err := abi.UnpackRevert(ret); err == nil {
+		return reason
+	}
+	if len(ret) == 4+32 && bytes.Equal(ret[:4], panicSelector) {
+		return fmt.Sprintf("panic code 0x%x", new(big.Int).SetBytes(ret[4:]))
+	}
+	return hexutil.Encode(ret)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeRevertReason(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ret    []byte
+		reason string
+	}{
+		{"empty", nil, ""},
+		{
+			"error string",
+			common.FromHex("08c379a0" +
+				"0000000000000000000000000000000000000000000000000000000000000020" +
+				"000000000000000000000000000000000000000000000000000000000000000c" +
+				"6e6f7420616c6c6f776564210000000000000000000000000000000000000000"),
+			"not allowed!",
+		},
+		{
+			"panic",
+			common.FromHex("4e487b71" + "0000000000000000000000000000000000000000000000000000000000000011"),
+			"panic code 0x11",
+		},
+		{"custom data", common.FromHex("deadbeef"), "0xdeadbeef"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.reason, DecodeRevertReason(tc.ret))
+		})
+	}
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -23,19 +25,224 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DeploymentPolicy enumerates what happens to the tokens without contract arriving over a bridge
+type DeploymentPolicy int32
+
+const (
+	// DEPLOYMENT_POLICY_UNSPECIFIED follows enable_auto_deployment
+	DeploymentPolicyUnspecified DeploymentPolicy = 0
+	// DEPLOYMENT_POLICY_AUTO_DEPLOY deploys a new src20 contract for the token
+	DeploymentPolicyAutoDeploy DeploymentPolicy = 1
+	// DEPLOYMENT_POLICY_REQUIRE_MAPPING waits for a mapping approved by governance,
+	// the ibc vouchers can't be converted
+	DeploymentPolicyRequireMapping DeploymentPolicy = 2
+	// DEPLOYMENT_POLICY_KEEP_NATIVE leaves the funds as native coins
+	DeploymentPolicyKeepNative DeploymentPolicy = 3
+)
+
+var DeploymentPolicy_name = map[int32]string{
+	0: "DEPLOYMENT_POLICY_UNSPECIFIED",
+	1: "DEPLOYMENT_POLICY_AUTO_DEPLOY",
+	2: "DEPLOYMENT_POLICY_REQUIRE_MAPPING",
+	3: "DEPLOYMENT_POLICY_KEEP_NATIVE",
+}
+
+var DeploymentPolicy_value = map[string]int32{
+	"DEPLOYMENT_POLICY_UNSPECIFIED":     0,
+	"DEPLOYMENT_POLICY_AUTO_DEPLOY":     1,
+	"DEPLOYMENT_POLICY_REQUIRE_MAPPING": 2,
+	"DEPLOYMENT_POLICY_KEEP_NATIVE":     3,
+}
+
+func (x DeploymentPolicy) String() string {
+	return proto.EnumName(DeploymentPolicy_name, int32(x))
+}
+
+func (DeploymentPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{0}
+}
+
+// EthereumTransferStatus enumerates the states of a transfer to ethereum initiated from the evm
+type EthereumTransferStatus int32
+
+const (
+	// ETHEREUM_TRANSFER_STATUS_UNSPECIFIED defines a no-op status
+	EthereumTransferStatusUnspecified EthereumTransferStatus = 0
+	// ETHEREUM_TRANSFER_STATUS_QUEUED means the transfer waits to be submitted to the gravity module at end block
+	EthereumTransferStatusQueued EthereumTransferStatus = 1
+	// ETHEREUM_TRANSFER_STATUS_PENDING means the transfer is in the gravity pool waiting for a batch
+	EthereumTransferStatusPending EthereumTransferStatus = 2
+	// ETHEREUM_TRANSFER_STATUS_BATCHED means the transfer is included in an outgoing batch
+	EthereumTransferStatusBatched EthereumTransferStatus = 3
+	// ETHEREUM_TRANSFER_STATUS_COMPLETED means the batch has been executed on ethereum
+	EthereumTransferStatusCompleted EthereumTransferStatus = 4
+	// ETHEREUM_TRANSFER_STATUS_FAILED means the transfer could not be submitted to the gravity module
+	EthereumTransferStatusFailed EthereumTransferStatus = 5
+	// ETHEREUM_TRANSFER_STATUS_REFUNDED means the transfer failed and the tokens were given back to the sender
+	EthereumTransferStatusRefunded EthereumTransferStatus = 6
+)
+
+var EthereumTransferStatus_name = map[int32]string{
+	0: "ETHEREUM_TRANSFER_STATUS_UNSPECIFIED",
+	1: "ETHEREUM_TRANSFER_STATUS_QUEUED",
+	2: "ETHEREUM_TRANSFER_STATUS_PENDING",
+	3: "ETHEREUM_TRANSFER_STATUS_BATCHED",
+	4: "ETHEREUM_TRANSFER_STATUS_COMPLETED",
+	5: "ETHEREUM_TRANSFER_STATUS_FAILED",
+	6: "ETHEREUM_TRANSFER_STATUS_REFUNDED",
+}
+
+var EthereumTransferStatus_value = map[string]int32{
+	"ETHEREUM_TRANSFER_STATUS_UNSPECIFIED": 0,
+	"ETHEREUM_TRANSFER_STATUS_QUEUED":      1,
+	"ETHEREUM_TRANSFER_STATUS_PENDING":     2,
+	"ETHEREUM_TRANSFER_STATUS_BATCHED":     3,
+	"ETHEREUM_TRANSFER_STATUS_COMPLETED":   4,
+	"ETHEREUM_TRANSFER_STATUS_FAILED":      5,
+	"ETHEREUM_TRANSFER_STATUS_REFUNDED":    6,
+}
+
+func (x EthereumTransferStatus) String() string {
+	return proto.EnumName(EthereumTransferStatus_name, int32(x))
+}
+
+func (EthereumTransferStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{1}
+}
+
+// TransferDirection enumerates the directions of a bridge transfer
+type TransferDirection int32
+
+const (
+	// TRANSFER_DIRECTION_UNSPECIFIED defines a no-op direction
+	TransferDirectionUnspecified TransferDirection = 0
+	// TRANSFER_DIRECTION_INBOUND defines a transfer coming into the chain
+	TransferDirectionInbound TransferDirection = 1
+	// TRANSFER_DIRECTION_OUTBOUND defines a transfer leaving the chain
+	TransferDirectionOutbound TransferDirection = 2
+)
+
+var TransferDirection_name = map[int32]string{
+	0: "TRANSFER_DIRECTION_UNSPECIFIED",
+	1: "TRANSFER_DIRECTION_INBOUND",
+	2: "TRANSFER_DIRECTION_OUTBOUND",
+}
+
+var TransferDirection_value = map[string]int32{
+	"TRANSFER_DIRECTION_UNSPECIFIED": 0,
+	"TRANSFER_DIRECTION_INBOUND":     1,
+	"TRANSFER_DIRECTION_OUTBOUND":    2,
+}
+
+func (x TransferDirection) String() string {
+	return proto.EnumName(TransferDirection_name, int32(x))
+}
+
+func (TransferDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{2}
+}
+
+// TransferOrigin enumerates the bridges a transfer goes through
+type TransferOrigin int32
+
+const (
+	// TRANSFER_ORIGIN_UNSPECIFIED defines a no-op origin
+	TransferOriginUnspecified TransferOrigin = 0
+	// TRANSFER_ORIGIN_GRAVITY defines a transfer through the gravity bridge
+	TransferOriginGravity TransferOrigin = 1
+	// TRANSFER_ORIGIN_IBC defines a transfer through ibc
+	TransferOriginIBC TransferOrigin = 2
+)
+
+var TransferOrigin_name = map[int32]string{
+	0: "TRANSFER_ORIGIN_UNSPECIFIED",
+	1: "TRANSFER_ORIGIN_GRAVITY",
+	2: "TRANSFER_ORIGIN_IBC",
+}
+
+var TransferOrigin_value = map[string]int32{
+	"TRANSFER_ORIGIN_UNSPECIFIED": 0,
+	"TRANSFER_ORIGIN_GRAVITY":     1,
+	"TRANSFER_ORIGIN_IBC":         2,
+}
+
+func (x TransferOrigin) String() string {
+	return proto.EnumName(TransferOrigin_name, int32(x))
+}
+
+func (TransferOrigin) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{3}
+}
+
+// TransferStatus enumerates the states of a bridge transfer
+type TransferStatus int32
+
+const (
+	// TRANSFER_STATUS_UNSPECIFIED defines a no-op status
+	TransferStatusUnspecified TransferStatus = 0
+	// TRANSFER_STATUS_PENDING means the transfer is in flight
+	TransferStatusPending TransferStatus = 1
+	// TRANSFER_STATUS_COMPLETED means the transfer reached the receiver
+	TransferStatusCompleted TransferStatus = 2
+	// TRANSFER_STATUS_REFUNDED means the transfer failed and the tokens were given back to the sender
+	TransferStatusRefunded TransferStatus = 3
+	// TRANSFER_STATUS_FAILED means the transfer failed
+	TransferStatusFailed TransferStatus = 4
+)
+
+var TransferStatus_name = map[int32]string{
+	0: "TRANSFER_STATUS_UNSPECIFIED",
+	1: "TRANSFER_STATUS_PENDING",
+	2: "TRANSFER_STATUS_COMPLETED",
+	3: "TRANSFER_STATUS_REFUNDED",
+	4: "TRANSFER_STATUS_FAILED",
+}
+
+var TransferStatus_value = map[string]int32{
+	"TRANSFER_STATUS_UNSPECIFIED": 0,
+	"TRANSFER_STATUS_PENDING":     1,
+	"TRANSFER_STATUS_COMPLETED":   2,
+	"TRANSFER_STATUS_REFUNDED":    3,
+	"TRANSFER_STATUS_FAILED":      4,
+}
+
+func (x TransferStatus) String() string {
+	return proto.EnumName(TransferStatus_name, int32(x))
+}
+
+func (TransferStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{4}
+}
+
 // Params defines the parameters for the seele module.
 type Params struct {
-	IbcCroDenom string `protobuf:"bytes,1,opt,name=ibc_seele_denom,json=ibcCroDenom,proto3" json:"ibc_seele_denom,omitempty" yaml:"ibc_seele_denom,omitempty"`
+	IbcCroDenom string `protobuf:"bytes,1,opt,name=ibc_seele_denom,json=ibcSeeleDenom,proto3" json:"ibc_seele_denom,omitempty" yaml:"ibc_seele_denom,omitempty"`
 	IbcTimeout  uint64 `protobuf:"varint,2,opt,name=ibc_timeout,json=ibcTimeout,proto3" json:"ibc_timeout,omitempty"`
 	// the admin address who can update token mapping
-	SeeleAdmin           string `protobuf:"bytes,3,opt,name=seele_admin,json=SeeleAdmin,proto3" json:"seele_admin,omitempty"`
+	SeeleAdmin           string `protobuf:"bytes,3,opt,name=seele_admin,json=seeleAdmin,proto3" json:"seele_admin,omitempty"`
 	EnableAutoDeployment bool   `protobuf:"varint,4,opt,name=enable_auto_deployment,json=enableAutoDeployment,proto3" json:"enable_auto_deployment,omitempty"`
+	// the bridge contract allowed to send tokens to ethereum from the evm,
+	// empty means the feature is disabled
+	GravityBridgeContract string `protobuf:"bytes,5,opt,name=gravity_bridge_contract,json=gravityBridgeContract,proto3" json:"gravity_bridge_contract,omitempty"`
+	// the number of blocks finalized transfer records are kept in the ledger,
+	// zero means the records are never pruned
+	TransferRecordRetention uint64 `protobuf:"varint,6,opt,name=transfer_record_retention,json=transferRecordRetention,proto3" json:"transfer_record_retention,omitempty"`
+	// the policies applied to the tokens without contract arriving from each origin,
+	// an origin without policy falls back to enable_auto_deployment
+	DeploymentPolicies []OriginDeploymentPolicy `protobuf:"bytes,7,rep,name=deployment_policies,json=deploymentPolicies,proto3" json:"deployment_policies"`
+	// the cosmos originated denoms left as bank coins when they return from ethereum,
+	// the other ones are converted to their src20 contract if any
+	UnconvertedGravityDenoms []string `protobuf:"bytes,8,rep,name=unconverted_gravity_denoms,json=unconvertedGravityDenoms,proto3" json:"unconverted_gravity_denoms,omitempty"`
+	// the gas limit of every evm call made by the module
+	EvmCallGasLimit uint64 `protobuf:"varint,9,opt,name=evm_call_gas_limit,json=evmCallGasLimit,proto3" json:"evm_call_gas_limit,omitempty"`
+	// the ratio of the evm gas used by the calls of the module charged to the gas meter of the tx
+	EvmGasRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=evm_gas_ratio,json=evmGasRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"evm_gas_ratio"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bc54992a93db2d2, []int{0}
+	return fileDescriptor_44c03fef4994c986, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -92,6 +299,113 @@ func (m *Params) GetEnableAutoDeployment() bool {
 	return false
 }
 
+func (m *Params) GetGravityBridgeContract() string {
+	if m != nil {
+		return m.GravityBridgeContract
+	}
+	return ""
+}
+
+func (m *Params) GetTransferRecordRetention() uint64 {
+	if m != nil {
+		return m.TransferRecordRetention
+	}
+	return 0
+}
+
+func (m *Params) GetDeploymentPolicies() []OriginDeploymentPolicy {
+	if m != nil {
+		return m.DeploymentPolicies
+	}
+	return nil
+}
+
+func (m *Params) GetUnconvertedGravityDenoms() []string {
+	if m != nil {
+		return m.UnconvertedGravityDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetEvmCallGasLimit() uint64 {
+	if m != nil {
+		return m.EvmCallGasLimit
+	}
+	return 0
+}
+
+// OriginDeploymentPolicy defines the deployment policy of a bridge,
+// the lists hold ethereum contracts for gravity and denoms for ibc.
+type OriginDeploymentPolicy struct {
+	Origin TransferOrigin   `protobuf:"varint,1,opt,name=origin,proto3,enum=seele.TransferOrigin" json:"origin,omitempty"`
+	Policy DeploymentPolicy `protobuf:"varint,2,opt,name=policy,proto3,enum=seele.DeploymentPolicy" json:"policy,omitempty"`
+	// if not empty, the other tokens require a mapping
+	Whitelist []string `protobuf:"bytes,3,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
+	// the listed tokens are left native
+	Blacklist []string `protobuf:"bytes,4,rep,name=blacklist,proto3" json:"blacklist,omitempty"`
+}
+
+func (m *OriginDeploymentPolicy) Reset()         { *m = OriginDeploymentPolicy{} }
+func (m *OriginDeploymentPolicy) String() string { return proto.CompactTextString(m) }
+func (*OriginDeploymentPolicy) ProtoMessage()    {}
+func (*OriginDeploymentPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{1}
+}
+func (m *OriginDeploymentPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OriginDeploymentPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OriginDeploymentPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OriginDeploymentPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OriginDeploymentPolicy.Merge(m, src)
+}
+func (m *OriginDeploymentPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *OriginDeploymentPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_OriginDeploymentPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OriginDeploymentPolicy proto.InternalMessageInfo
+
+func (m *OriginDeploymentPolicy) GetOrigin() TransferOrigin {
+	if m != nil {
+		return m.Origin
+	}
+	return TransferOriginUnspecified
+}
+
+func (m *OriginDeploymentPolicy) GetPolicy() DeploymentPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return DeploymentPolicyUnspecified
+}
+
+func (m *OriginDeploymentPolicy) GetWhitelist() []string {
+	if m != nil {
+		return m.Whitelist
+	}
+	return nil
+}
+
+func (m *OriginDeploymentPolicy) GetBlacklist() []string {
+	if m != nil {
+		return m.Blacklist
+	}
+	return nil
+}
+
 // TokenMappingChangeProposal defines a proposal to change one token mapping.
 type TokenMappingChangeProposal struct {
 	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -103,7 +417,7 @@ type TokenMappingChangeProposal struct {
 func (m *TokenMappingChangeProposal) Reset()      { *m = TokenMappingChangeProposal{} }
 func (*TokenMappingChangeProposal) ProtoMessage() {}
 func (*TokenMappingChangeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bc54992a93db2d2, []int{1}
+	return fileDescriptor_44c03fef4994c986, []int{2}
 }
 func (m *TokenMappingChangeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -132,6 +446,88 @@ func (m *TokenMappingChangeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TokenMappingChangeProposal proto.InternalMessageInfo
 
+// PendingTokenApprovalProposal defines a proposal to resolve the gravity deposits of a token waiting for a mapping,
+// they are converted to a new auto-deployed src20 contract or released as native coins.
+type PendingTokenApprovalProposal struct {
+	Title         string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	TokenContract string `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	ReleaseNative bool   `protobuf:"varint,4,opt,name=release_native,json=releaseNative,proto3" json:"release_native,omitempty"`
+}
+
+func (m *PendingTokenApprovalProposal) Reset()      { *m = PendingTokenApprovalProposal{} }
+func (*PendingTokenApprovalProposal) ProtoMessage() {}
+func (*PendingTokenApprovalProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{3}
+}
+func (m *PendingTokenApprovalProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTokenApprovalProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTokenApprovalProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTokenApprovalProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTokenApprovalProposal.Merge(m, src)
+}
+func (m *PendingTokenApprovalProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTokenApprovalProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTokenApprovalProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTokenApprovalProposal proto.InternalMessageInfo
+
+// QuarantinedDepositReleaseProposal defines a proposal to release a quarantined gravity deposit to a receiver,
+// the receiver is a hex or a bech32 address.
+type QuarantinedDepositReleaseProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Receiver    string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *QuarantinedDepositReleaseProposal) Reset()      { *m = QuarantinedDepositReleaseProposal{} }
+func (*QuarantinedDepositReleaseProposal) ProtoMessage() {}
+func (*QuarantinedDepositReleaseProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{4}
+}
+func (m *QuarantinedDepositReleaseProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedDepositReleaseProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedDepositReleaseProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuarantinedDepositReleaseProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDepositReleaseProposal.Merge(m, src)
+}
+func (m *QuarantinedDepositReleaseProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedDepositReleaseProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDepositReleaseProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedDepositReleaseProposal proto.InternalMessageInfo
+
 // TokenMapping defines a mapping between native denom and contract
 type TokenMapping struct {
 	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -142,7 +538,7 @@ func (m *TokenMapping) Reset()         { *m = TokenMapping{} }
 func (m *TokenMapping) String() string { return proto.CompactTextString(m) }
 func (*TokenMapping) ProtoMessage()    {}
 func (*TokenMapping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bc54992a93db2d2, []int{2}
+	return fileDescriptor_44c03fef4994c986, []int{5}
 }
 func (m *TokenMapping) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,266 +581,4128 @@ func (m *TokenMapping) GetContract() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "seele.Params")
-	proto.RegisterType((*TokenMappingChangeProposal)(nil), "seele.TokenMappingChangeProposal")
-	proto.RegisterType((*TokenMapping)(nil), "seele.TokenMapping")
+// EthereumTransfer tracks a transfer to ethereum initiated from the evm
+type EthereumTransfer struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the evm address which initiated the transfer
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the bridge contract which emitted the request
+	BridgeContract string `protobuf:"bytes,3,opt,name=bridge_contract,json=bridgeContract,proto3" json:"bridge_contract,omitempty"`
+	// the src20 contract of the transferred token
+	TokenContract string `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// the erc20 contract on ethereum, filled when submitted to the gravity module
+	Erc20Contract     string                 `protobuf:"bytes,5,opt,name=erc20_contract,json=erc20Contract,proto3" json:"erc20_contract,omitempty"`
+	EthereumRecipient string                 `protobuf:"bytes,6,opt,name=ethereum_recipient,json=ethereumRecipient,proto3" json:"ethereum_recipient,omitempty"`
+	Amount            types.Coin             `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount"`
+	BridgeFee         types.Coin             `protobuf:"bytes,8,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
+	Status            EthereumTransferStatus `protobuf:"varint,9,opt,name=status,proto3,enum=seele.EthereumTransferStatus" json:"status,omitempty"`
+	// the id of the outgoing transaction in the gravity pool
+	OutgoingTxId uint64 `protobuf:"varint,10,opt,name=outgoing_tx_id,json=outgoingTxId,proto3" json:"outgoing_tx_id,omitempty"`
+	// the nonce of the batch the transfer is included in
+	BatchNonce    uint64 `protobuf:"varint,11,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	CreatedHeight int64  `protobuf:"varint,12,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	UpdatedHeight int64  `protobuf:"varint,13,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// the reason of the failure if any
+	Error string `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+	// the id of the record in the transfer ledger
+	RecordId uint64 `protobuf:"varint,15,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("seele/seele.proto", fileDescriptor_8bc54992a93db2d2) }
-
-var fileDescriptor_8bc54992a93db2d2 = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbf, 0xae, 0xd3, 0x30,
-	0x14, 0xc6, 0xe3, 0x4b, 0xa9, 0x5a, 0xf7, 0xb2, 0x98, 0x0a, 0x45, 0x1d, 0x92, 0x90, 0xa9, 0x03,
-	0xf7, 0x46, 0x08, 0xa6, 0x4e, 0xdc, 0xb6, 0x62, 0x41, 0x48, 0x55, 0xd4, 0x89, 0x25, 0x72, 0x5c,
-	0x2b, 0xb5, 0x88, 0x7d, 0x2c, 0xc7, 0x91, 0xc8, 0x1b, 0x30, 0x76, 0x64, 0xec, 0xe3, 0x30, 0x76,
-	0x64, 0x42, 0xa8, 0x7d, 0x03, 0x9e, 0x00, 0x25, 0x86, 0xd2, 0x0e, 0x77, 0xf2, 0xf9, 0xbe, 0x4f,
-	0x3e, 0xe7, 0xe7, 0x3f, 0xf8, 0x39, 0x33, 0xa0, 0xa0, 0x4a, 0xdc, 0x72, 0xaf, 0x0d, 0x58, 0x20,
-	0x7d, 0xa7, 0x26, 0xe3, 0x02, 0x0a, 0xe8, 0xac, 0xa4, 0xad, 0x5c, 0x1a, 0x1f, 0x10, 0xee, 0xaf,
-	0xa8, 0xa1, 0xb2, 0x22, 0xef, 0xf1, 0x33, 0x91, 0xb3, 0x8c, 0x19, 0xc8, 0x36, 0x5c, 0x81, 0xf4,
-	0x51, 0x84, 0xa6, 0xc3, 0x79, 0xfc, 0xfb, 0x67, 0x18, 0x34, 0x54, 0x96, 0xb3, 0xf8, 0x2a, 0x7e,
-	0x05, 0x52, 0x58, 0x2e, 0xb5, 0x6d, 0xe2, 0x74, 0x24, 0x72, 0xb6, 0x30, 0xb0, 0x6c, 0x7d, 0x12,
-	0xe2, 0x56, 0x66, 0x56, 0x48, 0x0e, 0xb5, 0xf5, 0x6f, 0x22, 0x34, 0xed, 0xa5, 0x58, 0xe4, 0x6c,
-	0xed, 0x1c, 0xf2, 0x12, 0xdf, 0x3a, 0xa6, 0x8c, 0x6e, 0xa4, 0x50, 0xfe, 0x93, 0x76, 0x4e, 0x3a,
-	0x72, 0xde, 0x43, 0x6b, 0x91, 0xb7, 0xf8, 0x05, 0x57, 0x34, 0x2f, 0x79, 0x46, 0x6b, 0xdb, 0x0e,
-	0xd4, 0x25, 0x34, 0x92, 0x2b, 0xeb, 0xf7, 0x22, 0x34, 0x1d, 0xa4, 0x63, 0x97, 0x3e, 0xd4, 0x16,
-	0x96, 0xe7, 0x6c, 0xd6, 0xfb, 0xb6, 0x0f, 0xbd, 0x78, 0x87, 0xf0, 0x64, 0x0d, 0x9f, 0xb9, 0xfa,
-	0x48, 0xb5, 0x16, 0xaa, 0x58, 0x6c, 0xa9, 0x2a, 0xf8, 0xca, 0x80, 0x86, 0x8a, 0x96, 0x64, 0x8c,
-	0x9f, 0x5a, 0x61, 0x4b, 0xee, 0x8e, 0x97, 0x3a, 0x41, 0x22, 0x3c, 0xda, 0xf0, 0x8a, 0x19, 0xa1,
-	0xad, 0x00, 0xd5, 0x41, 0x0f, 0xd3, 0x4b, 0xab, 0xdd, 0xe7, 0xae, 0xc5, 0xe1, 0x3a, 0x41, 0x26,
-	0x78, 0xc0, 0x40, 0x59, 0x43, 0x99, 0x43, 0x1b, 0xa6, 0x67, 0x3d, 0x1b, 0x7c, 0xdd, 0x87, 0x5e,
-	0x87, 0xf4, 0x0e, 0xdf, 0x5e, 0x12, 0xfd, 0xef, 0x85, 0x1e, 0xeb, 0x75, 0x73, 0xdd, 0x6b, 0xfe,
-	0xe1, 0xfb, 0x31, 0x40, 0x87, 0x63, 0x80, 0x7e, 0x1d, 0x03, 0xb4, 0x3b, 0x05, 0xde, 0xe1, 0x14,
-	0x78, 0x3f, 0x4e, 0x81, 0xf7, 0xe9, 0x75, 0x21, 0xec, 0xb6, 0xce, 0xef, 0x19, 0xc8, 0x84, 0x99,
-	0x46, 0x5b, 0xb8, 0x03, 0x53, 0xdc, 0xb1, 0x2d, 0x15, 0xea, 0xef, 0x4f, 0x48, 0xbe, 0xfc, 0x2b,
-	0x6c, 0xa3, 0x79, 0x95, 0xf7, 0xbb, 0xb7, 0x7f, 0xf3, 0x27, 0x00, 0x00, 0xff, 0xff, 0x28, 0x01,
-	0xb4, 0xac, 0x30, 0x02, 0x00, 0x00,
+func (m *EthereumTransfer) Reset()         { *m = EthereumTransfer{} }
+func (m *EthereumTransfer) String() string { return proto.CompactTextString(m) }
+func (*EthereumTransfer) ProtoMessage()    {}
+func (*EthereumTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{6}
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EthereumTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EthereumTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumTransfer.Merge(m, src)
+}
+func (m *EthereumTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumTransfer.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EthereumTransfer proto.InternalMessageInfo
+
+func (m *EthereumTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EnableAutoDeployment {
-		i--
-		if m.EnableAutoDeployment {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+func (m *EthereumTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.SeeleAdmin) > 0 {
-		i -= len(m.SeeleAdmin)
-		copy(dAtA[i:], m.SeeleAdmin)
-		i = encodeVarintSeele(dAtA, i, uint64(len(m.SeeleAdmin)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EthereumTransfer) GetBridgeContract() string {
+	if m != nil {
+		return m.BridgeContract
 	}
-	if m.IbcTimeout != 0 {
-		i = encodeVarintSeele(dAtA, i, uint64(m.IbcTimeout))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *EthereumTransfer) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if len(m.IbcCroDenom) > 0 {
-		i -= len(m.IbcCroDenom)
-		copy(dAtA[i:], m.IbcCroDenom)
-		i = encodeVarintSeele(dAtA, i, uint64(len(m.IbcCroDenom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EthereumTransfer) GetErc20Contract() string {
+	if m != nil {
+		return m.Erc20Contract
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TokenMappingChangeProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EthereumTransfer) GetEthereumRecipient() string {
+	if m != nil {
+		return m.EthereumRecipient
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TokenMappingChangeProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EthereumTransfer) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *TokenMappingChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintSeele(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x22
+func (m *EthereumTransfer) GetBridgeFee() types.Coin {
+	if m != nil {
+		return m.BridgeFee
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintSeele(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
+	return types.Coin{}
+}
+
+func (m *EthereumTransfer) GetStatus() EthereumTransferStatus {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintSeele(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return EthereumTransferStatusUnspecified
+}
+
+func (m *EthereumTransfer) GetOutgoingTxId() uint64 {
+	if m != nil {
+		return m.OutgoingTxId
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintSeele(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EthereumTransfer) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TokenMapping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EthereumTransfer) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TokenMapping) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EthereumTransfer) GetUpdatedHeight() int64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
 }
 
-func (m *TokenMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintSeele(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x12
+func (m *EthereumTransfer) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintSeele(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EthereumTransfer) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintSeele(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSeele(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// TransferRecord is an entry of the bridge transfer ledger
+type TransferRecord struct {
+	Id        uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Direction TransferDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=seele.TransferDirection" json:"direction,omitempty"`
+	Origin    TransferOrigin    `protobuf:"varint,3,opt,name=origin,proto3,enum=seele.TransferOrigin" json:"origin,omitempty"`
+	// the ibc channel on this chain, empty for gravity transfers
+	Channel string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	// the external id of the transfer: the gravity event nonce of inbound gravity transfers,
+	// the gravity outgoing tx id of outbound gravity transfers or the ibc packet sequence,
+	// zero if unknown
+	Sequence      uint64         `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Sender        string         `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver      string         `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount        types.Coin     `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount"`
+	Status        TransferStatus `protobuf:"varint,9,opt,name=status,proto3,enum=seele.TransferStatus" json:"status,omitempty"`
+	CreatedHeight int64          `protobuf:"varint,10,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	UpdatedHeight int64          `protobuf:"varint,11,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// the reason of the failure if any
+	Error string `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *TransferRecord) Reset()         { *m = TransferRecord{} }
+func (m *TransferRecord) String() string { return proto.CompactTextString(m) }
+func (*TransferRecord) ProtoMessage()    {}
+func (*TransferRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{7}
+}
+func (m *TransferRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TransferRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRecord.Merge(m, src)
+}
+func (m *TransferRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRecord proto.InternalMessageInfo
+
+func (m *TransferRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = len(m.IbcCroDenom)
-	if l > 0 {
-		n += 1 + l + sovSeele(uint64(l))
+	return 0
+}
+
+func (m *TransferRecord) GetDirection() TransferDirection {
+	if m != nil {
+		return m.Direction
 	}
-	if m.IbcTimeout != 0 {
-		n += 1 + sovSeele(uint64(m.IbcTimeout))
+	return TransferDirectionUnspecified
+}
+
+func (m *TransferRecord) GetOrigin() TransferOrigin {
+	if m != nil {
+		return m.Origin
 	}
-	l = len(m.SeeleAdmin)
-	if l > 0 {
-		n += 1 + l + sovSeele(uint64(l))
+	return TransferOriginUnspecified
+}
+
+func (m *TransferRecord) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	if m.EnableAutoDeployment {
-		n += 2
+	return ""
+}
+
+func (m *TransferRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return n
+	return 0
 }
 
-func (m *TokenMappingChangeProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TransferRecord) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovSeele(uint64(l))
+	return ""
+}
+
+func (m *TransferRecord) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovSeele(uint64(l))
+	return ""
+}
+
+func (m *TransferRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovSeele(uint64(l))
+	return types.Coin{}
+}
+
+func (m *TransferRecord) GetStatus() TransferStatus {
+	if m != nil {
+		return m.Status
 	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovSeele(uint64(l))
+	return TransferStatusUnspecified
+}
+
+func (m *TransferRecord) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	return n
+	return 0
 }
 
-func (m *TokenMapping) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TransferRecord) GetUpdatedHeight() int64 {
+	if m != nil {
+		return m.UpdatedHeight
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovSeele(uint64(l))
+	return 0
+}
+
+func (m *TransferRecord) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovSeele(uint64(l))
+	return ""
+}
+
+// QuarantinedDeposit is a gravity deposit whose cosmos receiver couldn't be parsed,
+// the coins are held by the module until they are released by governance
+type QuarantinedDeposit struct {
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventNonce     uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	TokenContract  string `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	EthereumSender string `protobuf:"bytes,4,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	// the receiver as submitted on ethereum
+	CosmosReceiver string     `protobuf:"bytes,5,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	Amount         types.Coin `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount"`
+	Height         int64      `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// the id of the record in the transfer ledger
+	RecordId uint64 `protobuf:"varint,8,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+}
+
+func (m *QuarantinedDeposit) Reset()         { *m = QuarantinedDeposit{} }
+func (m *QuarantinedDeposit) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedDeposit) ProtoMessage()    {}
+func (*QuarantinedDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{8}
+}
+func (m *QuarantinedDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuarantinedDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDeposit.Merge(m, src)
+}
+func (m *QuarantinedDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedDeposit proto.InternalMessageInfo
+
+func (m *QuarantinedDeposit) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QuarantinedDeposit) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *QuarantinedDeposit) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *QuarantinedDeposit) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QuarantinedDeposit) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
+	}
+	return 0
+}
+
+// PendingTokenDeposit is a gravity deposit of a token waiting for a mapping approved by governance,
+// the coins are held by the module in the meantime
+type PendingTokenDeposit struct {
+	Id            uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TokenContract string     `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Receiver      string     `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount        types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Height        int64      `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// the id of the record in the transfer ledger
+	RecordId uint64 `protobuf:"varint,6,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+}
+
+func (m *PendingTokenDeposit) Reset()         { *m = PendingTokenDeposit{} }
+func (m *PendingTokenDeposit) String() string { return proto.CompactTextString(m) }
+func (*PendingTokenDeposit) ProtoMessage()    {}
+func (*PendingTokenDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{9}
+}
+func (m *PendingTokenDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTokenDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTokenDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTokenDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTokenDeposit.Merge(m, src)
+}
+func (m *PendingTokenDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTokenDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTokenDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTokenDeposit proto.InternalMessageInfo
+
+func (m *PendingTokenDeposit) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingTokenDeposit) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *PendingTokenDeposit) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *PendingTokenDeposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *PendingTokenDeposit) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PendingTokenDeposit) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
+	}
+	return 0
+}
+
+// PendingTokenApproval is the resolution approved for the deposits of a token waiting for a mapping,
+// they are resolved in bounded batches until none is left
+type PendingTokenApproval struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	AutoDeploy    bool   `protobuf:"varint,2,opt,name=auto_deploy,json=autoDeploy,proto3" json:"auto_deploy,omitempty"`
+	ReleaseNative bool   `protobuf:"varint,3,opt,name=release_native,json=releaseNative,proto3" json:"release_native,omitempty"`
+}
+
+func (m *PendingTokenApproval) Reset()         { *m = PendingTokenApproval{} }
+func (m *PendingTokenApproval) String() string { return proto.CompactTextString(m) }
+func (*PendingTokenApproval) ProtoMessage()    {}
+func (*PendingTokenApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{10}
+}
+func (m *PendingTokenApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTokenApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTokenApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTokenApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTokenApproval.Merge(m, src)
+}
+func (m *PendingTokenApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTokenApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTokenApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTokenApproval proto.InternalMessageInfo
+
+func (m *PendingTokenApproval) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *PendingTokenApproval) GetAutoDeploy() bool {
+	if m != nil {
+		return m.AutoDeploy
+	}
+	return false
+}
+
+func (m *PendingTokenApproval) GetReleaseNative() bool {
+	if m != nil {
+		return m.ReleaseNative
+	}
+	return false
+}
+
+// EvmCallTrace is the trace of an evm call made by the module, it is only kept in the memory of the nodes
+// configured with an evm tracer
+type EvmCallTrace struct {
+	// the called contract, empty for a contract creation
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	GasUsed  uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// the error of the call with the decoded revert reason, empty if the call succeeded
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// the name of the tracer which produced the trace
+	Tracer string `protobuf:"bytes,4,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	// the json encoded output of the tracer
+	Trace string `protobuf:"bytes,5,opt,name=trace,proto3" json:"trace,omitempty"`
+}
+
+func (m *EvmCallTrace) Reset()         { *m = EvmCallTrace{} }
+func (m *EvmCallTrace) String() string { return proto.CompactTextString(m) }
+func (*EvmCallTrace) ProtoMessage()    {}
+func (*EvmCallTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44c03fef4994c986, []int{11}
+}
+func (m *EvmCallTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmCallTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmCallTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmCallTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmCallTrace.Merge(m, src)
+}
+func (m *EvmCallTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmCallTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmCallTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmCallTrace proto.InternalMessageInfo
+
+func (m *EvmCallTrace) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EvmCallTrace) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EvmCallTrace) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EvmCallTrace) GetTracer() string {
+	if m != nil {
+		return m.Tracer
+	}
+	return ""
+}
+
+func (m *EvmCallTrace) GetTrace() string {
+	if m != nil {
+		return m.Trace
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("seele.DeploymentPolicy", DeploymentPolicy_name, DeploymentPolicy_value)
+	proto.RegisterEnum("seele.EthereumTransferStatus", EthereumTransferStatus_name, EthereumTransferStatus_value)
+	proto.RegisterEnum("seele.TransferDirection", TransferDirection_name, TransferDirection_value)
+	proto.RegisterEnum("seele.TransferOrigin", TransferOrigin_name, TransferOrigin_value)
+	proto.RegisterEnum("seele.TransferStatus", TransferStatus_name, TransferStatus_value)
+	proto.RegisterType((*Params)(nil), "seele.Params")
+	proto.RegisterType((*OriginDeploymentPolicy)(nil), "seele.OriginDeploymentPolicy")
+	proto.RegisterType((*TokenMappingChangeProposal)(nil), "seele.TokenMappingChangeProposal")
+	proto.RegisterType((*PendingTokenApprovalProposal)(nil), "seele.PendingTokenApprovalProposal")
+	proto.RegisterType((*QuarantinedDepositReleaseProposal)(nil), "seele.QuarantinedDepositReleaseProposal")
+	proto.RegisterType((*TokenMapping)(nil), "seele.TokenMapping")
+	proto.RegisterType((*EthereumTransfer)(nil), "seele.EthereumTransfer")
+	proto.RegisterType((*TransferRecord)(nil), "seele.TransferRecord")
+	proto.RegisterType((*QuarantinedDeposit)(nil), "seele.QuarantinedDeposit")
+	proto.RegisterType((*PendingTokenDeposit)(nil), "seele.PendingTokenDeposit")
+	proto.RegisterType((*PendingTokenApproval)(nil), "seele.PendingTokenApproval")
+	proto.RegisterType((*EvmCallTrace)(nil), "seele.EvmCallTrace")
+}
+
+func init() { proto.RegisterFile("seele/seele.proto", fileDescriptor_44c03fef4994c986) }
+
+var fileDescriptor_44c03fef4994c986 = []byte{
+	// 1908 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcf, 0x6f, 0xdb, 0xc8,
+	0xf5, 0x37, 0x25, 0x59, 0x96, 0x47, 0xb1, 0xac, 0x30, 0x8e, 0x4d, 0x33, 0x89, 0xc4, 0xe8, 0xbb,
+	0xf9, 0xc6, 0xd8, 0x36, 0x72, 0xd7, 0xdb, 0xa6, 0x85, 0xb1, 0x58, 0x54, 0x3f, 0x68, 0x87, 0x88,
+	0x2d, 0x29, 0x34, 0xb5, 0xc0, 0xb6, 0x07, 0x82, 0x22, 0x5f, 0xe4, 0x41, 0x24, 0x52, 0x4b, 0x0e,
+	0xd5, 0xf8, 0x0f, 0x28, 0xb0, 0xd5, 0x69, 0x7b, 0xeb, 0x45, 0xc0, 0x02, 0x3d, 0xf4, 0xd2, 0xf6,
+	0xd4, 0x53, 0x81, 0xf6, 0xbc, 0xc7, 0x3d, 0x15, 0x8b, 0x02, 0x0d, 0x8a, 0xe4, 0xda, 0x53, 0xff,
+	0x82, 0x82, 0x33, 0x94, 0x4c, 0x51, 0x54, 0x9a, 0xed, 0x5e, 0x12, 0xcf, 0xfb, 0xe5, 0xf7, 0x3e,
+	0xef, 0xf3, 0xde, 0x8c, 0x89, 0x6e, 0x7a, 0x00, 0x03, 0x38, 0xa4, 0xff, 0x56, 0x47, 0xae, 0x43,
+	0x1c, 0x7e, 0x9d, 0x1e, 0xc4, 0x9d, 0xbe, 0xd3, 0x77, 0xa8, 0xe4, 0x30, 0xf8, 0x89, 0x29, 0xc5,
+	0x92, 0xe9, 0x78, 0x43, 0xc7, 0x3b, 0xec, 0x19, 0x1e, 0x1c, 0x8e, 0x3f, 0xe8, 0x01, 0x31, 0x3e,
+	0x38, 0x34, 0x1d, 0x6c, 0x33, 0x7d, 0xe5, 0x5f, 0x19, 0x94, 0xed, 0x18, 0xae, 0x31, 0xf4, 0xf8,
+	0x9f, 0xa3, 0x6d, 0xdc, 0x33, 0x75, 0x1a, 0x4d, 0xb7, 0xc0, 0x76, 0x86, 0x02, 0x27, 0x71, 0x07,
+	0x9b, 0xf5, 0x0f, 0x5f, 0xbf, 0x2a, 0xe7, 0x95, 0x9e, 0xd9, 0x70, 0x9d, 0x66, 0x20, 0xfe, 0xf7,
+	0xab, 0xb2, 0x74, 0x65, 0x0c, 0x07, 0xc7, 0x95, 0x98, 0xfd, 0xf7, 0x9d, 0x21, 0x26, 0x30, 0x1c,
+	0x91, 0xab, 0x8a, 0xba, 0x85, 0x7b, 0xe6, 0x45, 0xa0, 0xa2, 0x2e, 0x7c, 0x19, 0xe5, 0x03, 0x63,
+	0x82, 0x87, 0xe0, 0xf8, 0x44, 0x48, 0x49, 0xdc, 0x41, 0x46, 0x45, 0xb8, 0x67, 0x6a, 0x4c, 0x12,
+	0x18, 0xb0, 0x48, 0x86, 0x35, 0xc4, 0xb6, 0x90, 0x0e, 0x7e, 0xb3, 0x8a, 0xa8, 0xa8, 0x16, 0x48,
+	0xf8, 0x1f, 0xa2, 0x5d, 0xb0, 0x8d, 0x5e, 0x60, 0xe1, 0x13, 0x47, 0xb7, 0x60, 0x34, 0x70, 0xae,
+	0x86, 0x60, 0x13, 0x21, 0x23, 0x71, 0x07, 0x39, 0x75, 0x87, 0x69, 0x6b, 0x3e, 0x71, 0x9a, 0x73,
+	0x1d, 0xff, 0x18, 0xed, 0xf5, 0x5d, 0x63, 0x8c, 0xc9, 0x95, 0xde, 0x73, 0xb1, 0xd5, 0x07, 0xdd,
+	0x74, 0x6c, 0xe2, 0x1a, 0x26, 0x11, 0xd6, 0xe9, 0xaf, 0xb8, 0x1d, 0xaa, 0xeb, 0x54, 0xdb, 0x08,
+	0x95, 0xfc, 0x31, 0xda, 0x27, 0xae, 0x61, 0x7b, 0xcf, 0xc1, 0xd5, 0x5d, 0x30, 0x1d, 0xd7, 0xd2,
+	0x5d, 0x20, 0x60, 0x13, 0xec, 0xd8, 0x42, 0x96, 0x66, 0xbf, 0x37, 0x33, 0x50, 0xa9, 0x5e, 0x9d,
+	0xa9, 0x79, 0x0d, 0xdd, 0xba, 0xce, 0x4e, 0x1f, 0x39, 0x03, 0x6c, 0x62, 0xf0, 0x84, 0x0d, 0x29,
+	0x7d, 0x90, 0x3f, 0xba, 0x57, 0x65, 0xbd, 0x6b, 0xbb, 0xb8, 0x8f, 0xed, 0xeb, 0x4c, 0x3b, 0x81,
+	0xd9, 0x55, 0x3d, 0xf3, 0xd5, 0xab, 0xf2, 0x9a, 0xca, 0x5b, 0x8b, 0x72, 0x0c, 0x1e, 0xff, 0x11,
+	0x12, 0x7d, 0xdb, 0x74, 0xec, 0x31, 0xb8, 0x04, 0x2c, 0x7d, 0x56, 0x15, 0x05, 0xde, 0x13, 0x72,
+	0x52, 0xfa, 0x60, 0x53, 0x15, 0x22, 0x16, 0xa7, 0xcc, 0x80, 0xc2, 0xef, 0xf1, 0xdf, 0x43, 0x3c,
+	0x8c, 0x87, 0xba, 0x69, 0x0c, 0x06, 0x7a, 0xdf, 0xf0, 0xf4, 0x01, 0x1e, 0x62, 0x22, 0x6c, 0xd2,
+	0x42, 0xb6, 0x61, 0x3c, 0x6c, 0x18, 0x83, 0xc1, 0xa9, 0xe1, 0x9d, 0x05, 0x62, 0x5e, 0x45, 0x5b,
+	0x81, 0x71, 0x60, 0xe7, 0x1a, 0x04, 0x3b, 0x02, 0xa2, 0x3c, 0xa8, 0x06, 0xb9, 0xfd, 0xfd, 0x55,
+	0xf9, 0xff, 0xfb, 0x98, 0x5c, 0xfa, 0xbd, 0xaa, 0xe9, 0x0c, 0x0f, 0x43, 0x7a, 0xb1, 0xff, 0x1e,
+	0x79, 0xd6, 0x8b, 0x43, 0x72, 0x35, 0x02, 0xaf, 0xda, 0x04, 0x53, 0xcd, 0xc3, 0x78, 0x78, 0x6a,
+	0x78, 0x6a, 0x10, 0xe2, 0x38, 0xf3, 0x9b, 0x2f, 0xcb, 0x6b, 0x95, 0x3f, 0x71, 0x68, 0x37, 0xb9,
+	0x72, 0xfe, 0x11, 0xca, 0x3a, 0x54, 0x43, 0x59, 0x57, 0x38, 0xba, 0x1d, 0x02, 0xa5, 0x85, 0x28,
+	0x33, 0x37, 0x35, 0x34, 0xe2, 0x0f, 0x51, 0x96, 0x22, 0x7b, 0x45, 0xb9, 0x54, 0x38, 0xda, 0x0b,
+	0xcd, 0xe3, 0x71, 0xd5, 0xd0, 0x8c, 0xbf, 0x8b, 0x36, 0x7f, 0x71, 0x89, 0x09, 0x0c, 0xb0, 0x47,
+	0x84, 0x34, 0x85, 0xeb, 0x5a, 0x10, 0x68, 0x7b, 0x03, 0xc3, 0x7c, 0x41, 0xb5, 0x19, 0xa6, 0x9d,
+	0x0b, 0x2a, 0x5f, 0x70, 0x48, 0xd4, 0x9c, 0x17, 0x60, 0x9f, 0x1b, 0xa3, 0x11, 0xb6, 0xfb, 0x8d,
+	0x4b, 0xc3, 0xee, 0x43, 0xc7, 0x75, 0x46, 0x8e, 0x67, 0x0c, 0xf8, 0x1d, 0xb4, 0x4e, 0x30, 0x19,
+	0x00, 0x9b, 0x17, 0x95, 0x1d, 0x78, 0x09, 0xe5, 0x2d, 0xf0, 0x4c, 0x17, 0x8f, 0x28, 0x69, 0x52,
+	0x54, 0x17, 0x15, 0x05, 0x7e, 0x6c, 0xce, 0x18, 0xdb, 0xd9, 0x81, 0x17, 0x51, 0x6e, 0xce, 0xd1,
+	0x0c, 0x55, 0xcc, 0xcf, 0xc7, 0xb9, 0xcf, 0xbf, 0x2c, 0xaf, 0x51, 0x24, 0xff, 0xc8, 0xa1, 0xbb,
+	0x1d, 0xb0, 0x2d, 0x6c, 0xf7, 0x69, 0x66, 0xb5, 0xd1, 0xc8, 0x75, 0xc6, 0xc6, 0xe0, 0x3b, 0x27,
+	0xf5, 0x00, 0x15, 0x48, 0x10, 0xf0, 0x7a, 0x50, 0x58, 0x76, 0x5b, 0x54, 0x3a, 0x1f, 0x90, 0x07,
+	0xa8, 0xe0, 0xc2, 0x00, 0x0c, 0x0f, 0x74, 0xdb, 0x20, 0x78, 0x0c, 0xe1, 0x18, 0x6e, 0x85, 0xd2,
+	0x16, 0x15, 0x46, 0x12, 0xfe, 0x35, 0x87, 0xee, 0x3f, 0xf3, 0x0d, 0xd7, 0xb0, 0x09, 0xb6, 0xc1,
+	0x6a, 0xc2, 0xc8, 0xf1, 0x30, 0x51, 0x99, 0xf5, 0x77, 0xce, 0xba, 0x80, 0x52, 0xd8, 0xa2, 0x99,
+	0x66, 0xd4, 0x14, 0xb6, 0x02, 0x10, 0x5d, 0x30, 0x01, 0x8f, 0xc1, 0x9d, 0x81, 0x38, 0x3b, 0x47,
+	0x72, 0xfa, 0x29, 0xba, 0x11, 0x6d, 0xeb, 0x75, 0x43, 0xb8, 0x55, 0x0d, 0x49, 0x2d, 0x36, 0xa4,
+	0xf2, 0x4d, 0x06, 0x15, 0x65, 0x72, 0x09, 0x2e, 0xf8, 0xc3, 0x19, 0x53, 0xc3, 0x64, 0xb8, 0x79,
+	0x32, 0xbb, 0x28, 0xeb, 0x81, 0x6d, 0x81, 0x1b, 0xba, 0x87, 0x27, 0xfe, 0x21, 0xda, 0x8e, 0x2f,
+	0x25, 0x86, 0x75, 0xa1, 0xb7, 0xb8, 0x8d, 0x96, 0x7b, 0x92, 0x59, 0xd1, 0x13, 0x70, 0xcd, 0xa3,
+	0x1f, 0xc4, 0x77, 0xdc, 0x16, 0x95, 0xce, 0xcd, 0x1e, 0x21, 0x1e, 0xc2, 0x94, 0x83, 0xdd, 0x86,
+	0x47, 0x38, 0xd8, 0xa2, 0x59, 0x6a, 0x7a, 0x73, 0xa6, 0x51, 0x67, 0x0a, 0xfe, 0xc7, 0x28, 0x6b,
+	0x0c, 0x1d, 0xdf, 0x26, 0xc2, 0x86, 0xc4, 0x1d, 0xe4, 0x8f, 0xf6, 0xab, 0x6c, 0xda, 0xab, 0xc1,
+	0x9d, 0x52, 0x0d, 0xef, 0x94, 0x6a, 0xc3, 0xc1, 0x76, 0xb8, 0xbd, 0x42, 0x73, 0xfe, 0x63, 0x84,
+	0xc2, 0xf2, 0x9e, 0x03, 0x08, 0xb9, 0x77, 0x73, 0xde, 0x64, 0x2e, 0x27, 0x00, 0xfc, 0x8f, 0x50,
+	0xd6, 0x23, 0x06, 0xf1, 0x3d, 0xba, 0xa7, 0x0a, 0xf3, 0xd5, 0x19, 0xc7, 0xfb, 0x82, 0x1a, 0xa9,
+	0xa1, 0x31, 0xff, 0x1e, 0x2a, 0x38, 0x3e, 0xe9, 0x3b, 0xd8, 0xee, 0xeb, 0xe4, 0xa5, 0x8e, 0x2d,
+	0xba, 0xbe, 0x32, 0xea, 0x8d, 0x99, 0x54, 0x7b, 0xa9, 0x58, 0xc1, 0x7d, 0xd3, 0x33, 0x88, 0x79,
+	0xa9, 0xdb, 0x8e, 0x6d, 0x82, 0x90, 0xa7, 0x26, 0x88, 0x8a, 0x5a, 0x81, 0x24, 0x00, 0xd3, 0x74,
+	0xc1, 0x08, 0x76, 0xed, 0x25, 0xe0, 0xfe, 0x25, 0x11, 0x6e, 0x48, 0xdc, 0x41, 0x5a, 0xdd, 0x0a,
+	0xa5, 0x4f, 0xa8, 0x30, 0x30, 0xf3, 0x47, 0x56, 0xd4, 0x6c, 0x8b, 0x99, 0x85, 0xd2, 0xd0, 0x6c,
+	0x07, 0xad, 0x83, 0xeb, 0x3a, 0xae, 0x50, 0x60, 0xcc, 0xa2, 0x07, 0xfe, 0x0e, 0xda, 0x0c, 0x2f,
+	0x17, 0x6c, 0x09, 0xdb, 0x34, 0x85, 0x1c, 0x13, 0x28, 0x56, 0xe5, 0x2f, 0x69, 0x54, 0xd0, 0x16,
+	0xae, 0x98, 0x25, 0x62, 0x3d, 0x46, 0x9b, 0x16, 0x76, 0xc1, 0x9c, 0x4f, 0x45, 0xe1, 0x48, 0x88,
+	0xad, 0xcd, 0xe6, 0x4c, 0xaf, 0x5e, 0x9b, 0x46, 0x76, 0x6d, 0xfa, 0x5d, 0x76, 0xad, 0x80, 0x36,
+	0xcc, 0x4b, 0xc3, 0xb6, 0x61, 0x10, 0xf2, 0x6e, 0x76, 0x0c, 0x46, 0xc3, 0x83, 0xcf, 0x7c, 0x08,
+	0x20, 0x5c, 0x67, 0xf9, 0xcf, 0xce, 0x11, 0xd6, 0x67, 0x17, 0x58, 0x1f, 0x1d, 0xcd, 0x8d, 0xc5,
+	0xd1, 0x8c, 0x70, 0x2d, 0xf7, 0xed, 0xb8, 0xf6, 0x28, 0xc6, 0x95, 0x78, 0x45, 0x31, 0x8e, 0x2c,
+	0x37, 0x17, 0xbd, 0x5b, 0x73, 0xf3, 0x6f, 0x6d, 0xee, 0x8d, 0x48, 0x73, 0x2b, 0xbf, 0x4f, 0x21,
+	0x7e, 0x79, 0xe1, 0x2d, 0xf5, 0xb0, 0x8c, 0xf2, 0x30, 0x0e, 0x1e, 0x0a, 0x8c, 0x88, 0xe1, 0xcb,
+	0x88, 0x8a, 0xe6, 0x44, 0x7c, 0x97, 0x85, 0xfc, 0x10, 0x6d, 0xcf, 0xa7, 0x3a, 0xc4, 0x9d, 0x35,
+	0xab, 0x30, 0x13, 0x5f, 0xcc, 0xb7, 0x0e, 0x03, 0x55, 0x9f, 0xb7, 0x81, 0xad, 0x89, 0x02, 0x13,
+	0xab, 0xcb, 0xcd, 0xc8, 0x7e, 0xbb, 0x66, 0xec, 0xa2, 0x6c, 0x08, 0xd7, 0x06, 0x85, 0x2b, 0x3c,
+	0x2d, 0xd2, 0x3d, 0x17, 0xa3, 0xfb, 0xdf, 0x38, 0x74, 0x2b, 0x7a, 0xa1, 0xad, 0xc2, 0x6b, 0x19,
+	0x8e, 0x54, 0x12, 0x1c, 0x51, 0x96, 0xa5, 0x57, 0xb2, 0x2c, 0xf3, 0xbf, 0x16, 0xb6, 0xbe, 0xba,
+	0xb0, 0x6c, 0xac, 0xb0, 0x5f, 0x72, 0x68, 0x27, 0xe9, 0xa6, 0x4e, 0xa8, 0x84, 0x4b, 0xaa, 0xa4,
+	0x8c, 0xf2, 0x91, 0x17, 0x2f, 0xad, 0x36, 0xa7, 0x22, 0x63, 0xfe, 0xce, 0x4d, 0xb8, 0x8a, 0xd3,
+	0x09, 0x57, 0x71, 0xe5, 0x57, 0x1c, 0xba, 0x21, 0xb3, 0x97, 0x9e, 0xe6, 0x1a, 0x26, 0x2c, 0xdc,
+	0x6b, 0xdc, 0xe2, 0xbd, 0xc6, 0xef, 0xa3, 0x5c, 0xf0, 0xfc, 0xf3, 0x3d, 0xb0, 0x42, 0x4a, 0x6e,
+	0xf4, 0x0d, 0xaf, 0xeb, 0x81, 0x75, 0xcd, 0xf6, 0x74, 0x74, 0x95, 0xed, 0xa2, 0x6c, 0xe0, 0x39,
+	0x67, 0x5d, 0x78, 0x0a, 0xac, 0xe9, 0x4f, 0x21, 0xc7, 0xd8, 0xe1, 0xfd, 0x3f, 0xa4, 0x50, 0x71,
+	0xe9, 0x05, 0x58, 0x47, 0xf7, 0x9a, 0x72, 0xe7, 0xac, 0xfd, 0xe9, 0xb9, 0xdc, 0xd2, 0xf4, 0x4e,
+	0xfb, 0x4c, 0x69, 0x7c, 0xaa, 0x77, 0x5b, 0x17, 0x1d, 0xb9, 0xa1, 0x9c, 0x28, 0x72, 0xb3, 0xb8,
+	0x26, 0x96, 0x27, 0x53, 0xe9, 0x4e, 0xdc, 0xb1, 0x6b, 0x7b, 0x23, 0x30, 0xf1, 0x73, 0x0c, 0x16,
+	0x5f, 0x4b, 0x8a, 0x51, 0xeb, 0x6a, 0x6d, 0x9d, 0x89, 0x8b, 0x9c, 0x58, 0x9a, 0x4c, 0x25, 0x31,
+	0x1e, 0xe3, 0xfa, 0xcf, 0x06, 0x5e, 0x41, 0xf7, 0x97, 0x43, 0xa8, 0xf2, 0xb3, 0xae, 0xa2, 0xca,
+	0xfa, 0x79, 0xad, 0xd3, 0x51, 0x5a, 0xa7, 0xc5, 0x94, 0x58, 0x99, 0x4c, 0xa5, 0x52, 0x3c, 0x8c,
+	0x0a, 0x9f, 0xf9, 0xd8, 0x85, 0xd9, 0x7b, 0x22, 0x31, 0x9b, 0xa7, 0xb2, 0xdc, 0xd1, 0x5b, 0x35,
+	0x4d, 0xf9, 0x44, 0x2e, 0xa6, 0x93, 0xb3, 0x79, 0x0a, 0x30, 0x62, 0x5d, 0x13, 0x33, 0x9f, 0xff,
+	0xb6, 0xb4, 0xf6, 0xfe, 0xef, 0x32, 0x68, 0x37, 0xf9, 0xda, 0xe3, 0xdb, 0xe8, 0x3d, 0x59, 0x7b,
+	0x22, 0xab, 0x72, 0xf7, 0x5c, 0xd7, 0xd4, 0x5a, 0xeb, 0xe2, 0x44, 0x56, 0xf5, 0x0b, 0xad, 0xa6,
+	0x75, 0x2f, 0x62, 0xe0, 0x3d, 0x98, 0x4c, 0xa5, 0xfb, 0xc9, 0x51, 0xa2, 0x10, 0xca, 0xa8, 0xbc,
+	0x32, 0xe0, 0xb3, 0xae, 0xdc, 0x95, 0x9b, 0x45, 0x4e, 0x94, 0x26, 0x53, 0xe9, 0x6e, 0x72, 0xac,
+	0x67, 0x3e, 0xf8, 0x60, 0xf1, 0xa7, 0x48, 0x5a, 0x19, 0xa6, 0x23, 0xb7, 0x9a, 0x0c, 0xc5, 0xfb,
+	0x93, 0xa9, 0x74, 0x2f, 0x39, 0x4e, 0x38, 0x33, 0x6f, 0x0d, 0x54, 0xaf, 0x69, 0x8d, 0x27, 0x72,
+	0xb3, 0x98, 0x7e, 0x5b, 0xa0, 0x7a, 0x70, 0xa9, 0x83, 0xc5, 0x3f, 0x45, 0x95, 0x95, 0x81, 0x1a,
+	0xed, 0xf3, 0xce, 0x99, 0xac, 0xc9, 0xcd, 0x62, 0x46, 0xfc, 0xbf, 0xc9, 0x54, 0x2a, 0x27, 0x87,
+	0x6a, 0x38, 0xc3, 0xd1, 0x00, 0xc8, 0x7f, 0x41, 0xe9, 0xa4, 0xa6, 0x9c, 0xc9, 0xcd, 0xe2, 0xfa,
+	0xdb, 0x50, 0x3a, 0x31, 0xf0, 0x00, 0xac, 0x80, 0x6c, 0x2b, 0xc3, 0xa8, 0xf2, 0x49, 0xb7, 0xd5,
+	0x94, 0x9b, 0xc5, 0x2c, 0x23, 0x5b, 0x72, 0x20, 0x15, 0x9e, 0xfb, 0xb6, 0x05, 0x56, 0xc8, 0x94,
+	0x7f, 0x70, 0xe8, 0xe6, 0xd2, 0xdd, 0xcf, 0x37, 0x51, 0x69, 0x1e, 0xbd, 0xa9, 0xa8, 0x72, 0x43,
+	0x53, 0xda, 0xad, 0x18, 0x3d, 0x68, 0xb2, 0x4b, 0xae, 0x51, 0x66, 0x7c, 0x84, 0xc4, 0x84, 0x28,
+	0x4a, 0xab, 0xde, 0xee, 0xb6, 0x02, 0x52, 0xdc, 0x9d, 0x4c, 0x25, 0x61, 0x29, 0x82, 0x62, 0xf7,
+	0x1c, 0xdf, 0xb6, 0xf8, 0x8f, 0xd1, 0x9d, 0x04, 0xef, 0x76, 0x57, 0x63, 0xee, 0x29, 0xf1, 0xde,
+	0x64, 0x2a, 0xed, 0x2f, 0xb9, 0xb7, 0x7d, 0x42, 0xfd, 0xc3, 0xfa, 0xfe, 0xca, 0xa1, 0xc2, 0xe2,
+	0x33, 0x65, 0x21, 0x70, 0x5b, 0x55, 0x4e, 0x95, 0x78, 0x65, 0x0b, 0x81, 0x99, 0x53, 0xb4, 0xac,
+	0xc7, 0x68, 0x2f, 0xee, 0x7f, 0xaa, 0xd6, 0x3e, 0x51, 0xb4, 0x60, 0x5b, 0xec, 0x4f, 0xa6, 0xd2,
+	0xed, 0x45, 0xdf, 0xf0, 0x2f, 0x6b, 0xbe, 0x8a, 0x6e, 0xc5, 0xfd, 0x94, 0x7a, 0xa3, 0x98, 0x12,
+	0x6f, 0x4f, 0xa6, 0xd2, 0xcd, 0x45, 0x1f, 0xa5, 0xde, 0x08, 0x0b, 0xf8, 0x73, 0x0a, 0x15, 0x16,
+	0x3b, 0xb8, 0x50, 0x40, 0xe2, 0xe4, 0x2e, 0x14, 0xb0, 0x3c, 0xb1, 0xd1, 0x02, 0x62, 0x13, 0x16,
+	0x2b, 0x60, 0x71, 0xb2, 0x8e, 0xd1, 0xfe, 0xea, 0x39, 0x48, 0x89, 0x77, 0x26, 0x53, 0x69, 0x6f,
+	0x15, 0xff, 0x7f, 0x82, 0x84, 0x95, 0x7c, 0x4d, 0x8b, 0xe2, 0x64, 0x2a, 0xed, 0x26, 0xf3, 0x34,
+	0xf8, 0x90, 0xb3, 0x62, 0x60, 0x32, 0xa2, 0x30, 0x99, 0x4a, 0x3b, 0x49, 0x83, 0xc2, 0xc0, 0xab,
+	0x3f, 0xfc, 0xea, 0x75, 0x89, 0xfb, 0xfa, 0x75, 0x89, 0xfb, 0xe7, 0xeb, 0x12, 0xf7, 0xc5, 0x9b,
+	0xd2, 0xda, 0xd7, 0x6f, 0x4a, 0x6b, 0xdf, 0xbc, 0x29, 0xad, 0xfd, 0x6c, 0xeb, 0x25, 0xfb, 0x28,
+	0xc6, 0xbe, 0x3f, 0xf4, 0xb2, 0xf4, 0xf3, 0xd6, 0x87, 0xff, 0x19, 0x00, 0x9d, 0x08, 0x57, 0x35,
+	0x30, 0x13, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EvmGasRatio.Size()
+		i -= size
+		if _, err := m.EvmGasRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSeele(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.EvmCallGasLimit != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.EvmCallGasLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.UnconvertedGravityDenoms) > 0 {
+		for iNdEx := len(m.UnconvertedGravityDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnconvertedGravityDenoms[iNdEx])
+			copy(dAtA[i:], m.UnconvertedGravityDenoms[iNdEx])
+			i = encodeVarintSeele(dAtA, i, uint64(len(m.UnconvertedGravityDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DeploymentPolicies) > 0 {
+		for iNdEx := len(m.DeploymentPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeploymentPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSeele(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TransferRecordRetention != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.TransferRecordRetention))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.GravityBridgeContract) > 0 {
+		i -= len(m.GravityBridgeContract)
+		copy(dAtA[i:], m.GravityBridgeContract)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.GravityBridgeContract)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EnableAutoDeployment {
+		i--
+		if m.EnableAutoDeployment {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SeeleAdmin) > 0 {
+		i -= len(m.SeeleAdmin)
+		copy(dAtA[i:], m.SeeleAdmin)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.SeeleAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IbcTimeout != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.IbcTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.IbcCroDenom) > 0 {
+		i -= len(m.IbcCroDenom)
+		copy(dAtA[i:], m.IbcCroDenom)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.IbcCroDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OriginDeploymentPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OriginDeploymentPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OriginDeploymentPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blacklist) > 0 {
+		for iNdEx := len(m.Blacklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Blacklist[iNdEx])
+			copy(dAtA[i:], m.Blacklist[iNdEx])
+			i = encodeVarintSeele(dAtA, i, uint64(len(m.Blacklist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Whitelist) > 0 {
+		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Whitelist[iNdEx])
+			copy(dAtA[i:], m.Whitelist[iNdEx])
+			i = encodeVarintSeele(dAtA, i, uint64(len(m.Whitelist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Policy != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Origin != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenMappingChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenMappingChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenMappingChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTokenApprovalProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTokenApprovalProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTokenApprovalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseNative {
+		i--
+		if m.ReleaseNative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantinedDepositReleaseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedDepositReleaseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedDepositReleaseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Id != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.OutgoingTxId != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.OutgoingTxId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Status != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSeele(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSeele(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.EthereumRecipient) > 0 {
+		i -= len(m.EthereumRecipient)
+		copy(dAtA[i:], m.EthereumRecipient)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.EthereumRecipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Erc20Contract) > 0 {
+		i -= len(m.Erc20Contract)
+		copy(dAtA[i:], m.Erc20Contract)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Erc20Contract)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BridgeContract) > 0 {
+		i -= len(m.BridgeContract)
+		copy(dAtA[i:], m.BridgeContract)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.BridgeContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Status != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSeele(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Origin != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Direction != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantinedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Height != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSeele(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTokenDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTokenDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTokenDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSeele(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTokenApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTokenApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTokenApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseNative {
+		i--
+		if m.ReleaseNative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AutoDeploy {
+		i--
+		if m.AutoDeploy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmCallTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmCallTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmCallTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trace) > 0 {
+		i -= len(m.Trace)
+		copy(dAtA[i:], m.Trace)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Trace)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tracer) > 0 {
+		i -= len(m.Tracer)
+		copy(dAtA[i:], m.Tracer)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Tracer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintSeele(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintSeele(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSeele(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSeele(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IbcCroDenom)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	if m.IbcTimeout != 0 {
+		n += 1 + sovSeele(uint64(m.IbcTimeout))
+	}
+	l = len(m.SeeleAdmin)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	if m.EnableAutoDeployment {
+		n += 2
+	}
+	l = len(m.GravityBridgeContract)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	if m.TransferRecordRetention != 0 {
+		n += 1 + sovSeele(uint64(m.TransferRecordRetention))
+	}
+	if len(m.DeploymentPolicies) > 0 {
+		for _, e := range m.DeploymentPolicies {
+			l = e.Size()
+			n += 1 + l + sovSeele(uint64(l))
+		}
+	}
+	if len(m.UnconvertedGravityDenoms) > 0 {
+		for _, s := range m.UnconvertedGravityDenoms {
+			l = len(s)
+			n += 1 + l + sovSeele(uint64(l))
+		}
+	}
+	if m.EvmCallGasLimit != 0 {
+		n += 1 + sovSeele(uint64(m.EvmCallGasLimit))
+	}
+	l = m.EvmGasRatio.Size()
+	n += 1 + l + sovSeele(uint64(l))
+	return n
+}
+
+func (m *OriginDeploymentPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Origin != 0 {
+		n += 1 + sovSeele(uint64(m.Origin))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovSeele(uint64(m.Policy))
+	}
+	if len(m.Whitelist) > 0 {
+		for _, s := range m.Whitelist {
+			l = len(s)
+			n += 1 + l + sovSeele(uint64(l))
+		}
+	}
+	if len(m.Blacklist) > 0 {
+		for _, s := range m.Blacklist {
+			l = len(s)
+			n += 1 + l + sovSeele(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenMappingChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingTokenApprovalProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	if m.ReleaseNative {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuarantinedDepositReleaseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovSeele(uint64(m.Id))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	return n
+}
+
+func (m *EthereumTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSeele(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.BridgeContract)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.Erc20Contract)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.EthereumRecipient)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSeele(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovSeele(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovSeele(uint64(m.Status))
+	}
+	if m.OutgoingTxId != 0 {
+		n += 1 + sovSeele(uint64(m.OutgoingTxId))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovSeele(uint64(m.BatchNonce))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovSeele(uint64(m.CreatedHeight))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovSeele(uint64(m.UpdatedHeight))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovSeele(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *TransferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSeele(uint64(m.Id))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovSeele(uint64(m.Direction))
+	}
+	if m.Origin != 0 {
+		n += 1 + sovSeele(uint64(m.Origin))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovSeele(uint64(m.Sequence))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSeele(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovSeele(uint64(m.Status))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovSeele(uint64(m.CreatedHeight))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovSeele(uint64(m.UpdatedHeight))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	return n
+}
+
+func (m *QuarantinedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSeele(uint64(m.Id))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovSeele(uint64(m.EventNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSeele(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovSeele(uint64(m.Height))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovSeele(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *PendingTokenDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSeele(uint64(m.Id))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSeele(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovSeele(uint64(m.Height))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovSeele(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *PendingTokenApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	if m.AutoDeploy {
+		n += 2
+	}
+	if m.ReleaseNative {
+		n += 2
+	}
+	return n
+}
+
+func (m *EvmCallTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovSeele(uint64(m.GasUsed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.Tracer)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	l = len(m.Trace)
+	if l > 0 {
+		n += 1 + l + sovSeele(uint64(l))
+	}
+	return n
+}
+
+func sovSeele(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSeele(x uint64) (n int) {
+	return sovSeele(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSeele
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcCroDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcCroDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcTimeout", wireType)
+			}
+			m.IbcTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeeleAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeeleAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableAutoDeployment", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableAutoDeployment = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityBridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityBridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecordRetention", wireType)
+			}
+			m.TransferRecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferRecordRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeploymentPolicies = append(m.DeploymentPolicies, OriginDeploymentPolicy{})
+			if err := m.DeploymentPolicies[len(m.DeploymentPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnconvertedGravityDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnconvertedGravityDenoms = append(m.UnconvertedGravityDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmCallGasLimit", wireType)
+			}
+			m.EvmCallGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmCallGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmGasRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EvmGasRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSeele(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OriginDeploymentPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSeele
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OriginDeploymentPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OriginDeploymentPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= TransferOrigin(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= DeploymentPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blacklist = append(m.Blacklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSeele(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSeele
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenMappingChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenMappingChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSeele(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingTokenApprovalProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSeele
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTokenApprovalProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTokenApprovalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseNative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReleaseNative = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSeele(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedDepositReleaseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSeele
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedDepositReleaseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedDepositReleaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSeele(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSeele
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSeele(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSeele
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EthereumTransferStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxId", wireType)
+			}
+			m.OutgoingTxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutgoingTxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSeele(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSeele
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= TransferDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= TransferOrigin(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TransferStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSeele(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSeele
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSeele(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovSeele(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSeele(x uint64) (n int) {
-	return sovSeele(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PendingTokenDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -467,15 +4725,34 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingTokenDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingTokenDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcCroDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -503,13 +4780,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcCroDenom = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcTimeout", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			m.IbcTimeout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSeele
@@ -519,16 +4796,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IbcTimeout |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeeleAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSeele
@@ -538,29 +4828,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSeele
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSeele
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SeeleAdmin = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableAutoDeployment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSeele
@@ -570,12 +4861,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableAutoDeployment = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSeele(dAtA[iNdEx:])
@@ -597,7 +4906,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
+func (m *PendingTokenApproval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -620,15 +4929,15 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenMappingChangeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingTokenApproval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenMappingChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingTokenApproval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -656,13 +4965,13 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDeploy", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSeele
@@ -672,61 +4981,17 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSeele
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSeele
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.AutoDeploy = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSeele
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSeele
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSeele
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseNative", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSeele
@@ -736,24 +5001,12 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSeele
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSeele
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ReleaseNative = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSeele(dAtA[iNdEx:])
@@ -775,7 +5028,7 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenMapping) Unmarshal(dAtA []byte) error {
+func (m *EvmCallTrace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -798,15 +5051,15 @@ func (m *TokenMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvmCallTrace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvmCallTrace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -834,11 +5087,30 @@ func (m *TokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -866,7 +5138,71 @@ func (m *TokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tracer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeele
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeele
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeele
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
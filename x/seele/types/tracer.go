@@ -1,11 +1,18 @@
 package types
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 type DummyTracer struct{}
@@ -21,3 +28,268 @@ func (dt DummyTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, co
 func (dt DummyTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
 }
 func (dt DummyTracer) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) {}
+
+// Tracers of the evm calls made by the module, configured in app.toml
+const (
+	// EvmTracerNone doesn't trace the evm calls
+	EvmTracerNone = ""
+	// EvmTracerStruct traces the evm calls with the struct logger of go-ethereum
+	EvmTracerStruct = "struct"
+	// EvmTracerCall traces the tree of the calls made by the evm calls
+	EvmTracerCall = "call"
+)
+
+// FlagEvmTracer is the app.toml option of the tracer of the evm calls made by the module
+const FlagEvmTracer = "seele.evm-tracer"
+
+// EvmTracer is a tracer of the evm calls made by the module, producing a json encoded trace
+type EvmTracer interface {
+	vm.Tracer
+	// Trace returns the json encoded trace of the call
+	Trace() (string, error)
+}
+
+// ValidateEvmTracer checks the name of an evm tracer
+func ValidateEvmTracer(name string) error {
+	switch name {
+	case EvmTracerNone, EvmTracerStruct, EvmTracerCall:
+		return nil
+	default:
+		return fmt.Errorf("invalid evm tracer %q, expected one of %q, %q or %q", name, EvmTracerNone, EvmTracerStruct, EvmTracerCall)
+	}
+}
+
+// NewEvmTracer returns a new evm tracer by name, nil if the calls are not traced
+func NewEvmTracer(name string) EvmTracer {
+	switch name {
+	case EvmTracerStruct:
+		return &StructTracer{StructLogger: vm.NewStructLogger(&vm.LogConfig{})}
+	case EvmTracerCall:
+		return NewCallTracer()
+	default:
+		return nil
+	}
+}
+
+// StructTracer traces the opcodes executed by an evm call, the trace has the format of the ethermint struct tracer
+type StructTracer struct {
+	*vm.StructLogger
+	gasUsed uint64
+}
+
+var _ EvmTracer = &StructTracer{}
+
+// CaptureEnd implements the vm.Tracer interface
+func (t *StructTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) {
+	t.StructLogger.CaptureEnd(output, gasUsed, d, err)
+	t.gasUsed = gasUsed
+}
+
+// Trace implements the EvmTracer interface
+func (t *StructTracer) Trace() (string, error) {
+	bz, err := json.Marshal(evmtypes.ExecutionResult{
+		Gas:         t.gasUsed,
+		Failed:      t.Error() != nil,
+		ReturnValue: hex.EncodeToString(t.Output()),
+		StructLogs:  evmtypes.FormatLogs(t.StructLogs()),
+	})
+	return string(bz), err
+}
+
+// CallFrame is a call in the trace of the call tracer
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []*CallFrame    `json:"calls,omitempty"`
+
+	// the gas left before the call opcode and its cost, in the caller frame
+	gasIn   uint64
+	gasCost uint64
+}
+
+// CallTracer traces the tree of the calls made by an evm call, following the format of the go-ethereum call tracer.
+// The frames are built from the call opcodes as the tracer interface has no hook for the inner calls.
+type CallTracer struct {
+	// the frames being executed, the first one is the top level call
+	stack []*CallFrame
+	// true after a call opcode, until the first opcode of the callee
+	descended bool
+}
+
+var _ EvmTracer = &CallTracer{}
+
+// NewCallTracer returns a new call tracer
+func NewCallTracer() *CallTracer {
+	return &CallTracer{}
+}
+
+// CaptureStart implements the vm.Tracer interface
+func (t *CallTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	frame := &CallFrame{
+		Type:  vm.CALL.String(),
+		From:  from,
+		To:    &to,
+		Value: (*hexutil.Big)(new(big.Int).Set(value)),
+		Gas:   hexutil.Uint64(gas),
+		Input: common.CopyBytes(input),
+	}
+	if create {
+		frame.Type = vm.CREATE.String()
+	}
+	t.stack = []*CallFrame{frame}
+}
+
+// CaptureState implements the vm.Tracer interface
+func (t *CallTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if err != nil || len(t.stack) == 0 {
+		return
+	}
+	stack := scope.Stack
+	// the opcode is executed by the frame on top of the stack
+	current := depth == len(t.stack)
+
+	switch {
+	case current && (op == vm.CREATE || op == vm.CREATE2):
+		offset, size := stack.Back(1).Uint64(), stack.Back(2).Uint64()
+		t.push(&CallFrame{
+			Type:    op.String(),
+			From:    scope.Contract.Address(),
+			Value:   (*hexutil.Big)(stack.Back(0).ToBig()),
+			Input:   scope.Memory.GetCopy(int64(offset), int64(size)),
+			gasIn:   gas,
+			gasCost: cost,
+		})
+		return
+
+	case current && (op == vm.CALL || op == vm.CALLCODE || op == vm.DELEGATECALL || op == vm.STATICCALL):
+		to := common.Address(stack.Back(1).Bytes20())
+		// the value is only on the stack of the calls which transfer one
+		argsOffset := 2
+		var value *hexutil.Big
+		if op == vm.CALL || op == vm.CALLCODE {
+			value = (*hexutil.Big)(stack.Back(2).ToBig())
+			argsOffset = 3
+		}
+		offset, size := stack.Back(argsOffset).Uint64(), stack.Back(argsOffset+1).Uint64()
+		t.push(&CallFrame{
+			Type:    op.String(),
+			From:    scope.Contract.Address(),
+			To:      &to,
+			Value:   value,
+			Input:   scope.Memory.GetCopy(int64(offset), int64(size)),
+			gasIn:   gas,
+			gasCost: cost,
+		})
+		return
+	}
+
+	if t.descended {
+		// the callee is entered unless it is a precompile or the call failed early
+		if depth == len(t.stack) {
+			t.stack[len(t.stack)-1].Gas = hexutil.Uint64(gas)
+		}
+		t.descended = false
+	}
+
+	if current && op == vm.REVERT {
+		t.stack[len(t.stack)-1].Error = vm.ErrExecutionReverted.Error()
+		return
+	}
+
+	// back in the caller, the result of the call is on top of its stack
+	if depth == len(t.stack)-1 {
+		t.pop(env, gas, stack.Back(0), rData)
+	}
+}
+
+// CaptureFault implements the vm.Tracer interface
+func (t *CallTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	if depth == len(t.stack) && t.stack[depth-1].Error == "" {
+		t.stack[depth-1].Error = err.Error()
+	}
+}
+
+// CaptureEnd implements the vm.Tracer interface
+func (t *CallTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) {
+	if len(t.stack) == 0 {
+		return
+	}
+	// unwind the frames left open by a failure
+	for len(t.stack) > 1 {
+		t.stack = t.stack[:len(t.stack)-1]
+	}
+	frame := t.stack[0]
+	frame.GasUsed = hexutil.Uint64(gasUsed)
+	frame.Output = common.CopyBytes(output)
+	if err != nil {
+		frame.Error = err.Error()
+		if errors.Is(err, vm.ErrExecutionReverted) {
+			frame.Error = revertError(output)
+		}
+	}
+}
+
+// Trace implements the EvmTracer interface
+func (t *CallTracer) Trace() (string, error) {
+	if len(t.stack) == 0 {
+		return "", errors.New("no call traced")
+	}
+	bz, err := json.Marshal(t.stack[0])
+	return string(bz), err
+}
+
+// push opens the frame of an inner call
+func (t *CallTracer) push(frame *CallFrame) {
+	t.stack = append(t.stack, frame)
+	t.descended = true
+}
+
+// pop closes the frame of the inner call which returned to the caller, the gas is the one left to the caller
+// and the result is either the success flag of a call or the address created
+func (t *CallTracer) pop(env *vm.EVM, gas uint64, result *uint256.Int, rData []byte) {
+	frame := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+
+	isCreate := frame.Type == vm.CREATE.String() || frame.Type == vm.CREATE2.String()
+	switch {
+	case isCreate:
+		// the gas given to the created contract isn't part of the cost of the opcode
+		frame.GasUsed = hexutil.Uint64(frame.gasIn - frame.gasCost - gas)
+	case frame.Gas > 0:
+		frame.GasUsed = hexutil.Uint64(frame.gasIn - frame.gasCost + uint64(frame.Gas) - gas)
+	}
+
+	switch {
+	case result.IsZero():
+		if frame.Error == vm.ErrExecutionReverted.Error() {
+			frame.Error = revertError(rData)
+		} else if frame.Error == "" {
+			frame.Error = "internal failure"
+		}
+	case isCreate:
+		created := common.Address(result.Bytes20())
+		frame.To = &created
+		frame.Output = common.CopyBytes(env.StateDB.GetCode(created))
+	default:
+		frame.Output = common.CopyBytes(rData)
+	}
+
+	parent := t.stack[len(t.stack)-1]
+	parent.Calls = append(parent.Calls, frame)
+}
+
+// revertError returns the error of a reverted call with its decoded reason
+func revertError(ret []byte) string {
+	reason := DecodeRevertReason(ret)
+	if reason == "" {
+		return vm.ErrExecutionReverted.Error()
+	}
+	return fmt.Sprintf("%s: %s", vm.ErrExecutionReverted, reason)
+}
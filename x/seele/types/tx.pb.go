@@ -34,13 +34,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgConvertVouchers struct {
 	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// converts the coins which can be converted and reports the failures of the others,
+	// otherwise no coin is converted unless all of them are
+	BestEffort bool `protobuf:"varint,3,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
 }
 
 func (m *MsgConvertVouchers) Reset()         { *m = MsgConvertVouchers{} }
 func (m *MsgConvertVouchers) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertVouchers) ProtoMessage()    {}
 func (*MsgConvertVouchers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28e09e4eabb18884, []int{0}
+	return fileDescriptor_308a534f49995d56, []int{0}
 }
 func (m *MsgConvertVouchers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -83,6 +86,13 @@ func (m *MsgConvertVouchers) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *MsgConvertVouchers) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
+	}
+	return false
+}
+
 // MsgTransferTokens represents a message to transfer seele evm coins through ibc.
 type MsgTransferTokens struct {
 	From  string                                   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
@@ -94,7 +104,7 @@ func (m *MsgTransferTokens) Reset()         { *m = MsgTransferTokens{} }
 func (m *MsgTransferTokens) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferTokens) ProtoMessage()    {}
 func (*MsgTransferTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28e09e4eabb18884, []int{1}
+	return fileDescriptor_308a534f49995d56, []int{1}
 }
 func (m *MsgTransferTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -144,15 +154,92 @@ func (m *MsgTransferTokens) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+// ConversionResult is the result of the conversion of a coin
+type ConversionResult struct {
+	Coin types.Coin `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin"`
+	// the SRC20 contract of the coin, empty for the ibc seele denom converted to evm coins
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// the amount of evm coins or SRC20 tokens minted
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted"`
+	// the part of the coin which couldn't be converted, left to the sender, zero as the conversions are exact
+	DustRefunded types.Coin `protobuf:"bytes,4,opt,name=dust_refunded,json=dustRefunded,proto3" json:"dust_refunded"`
+	// the reason of the failure in best effort mode, the coin is left to the sender
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ConversionResult) Reset()         { *m = ConversionResult{} }
+func (m *ConversionResult) String() string { return proto.CompactTextString(m) }
+func (*ConversionResult) ProtoMessage()    {}
+func (*ConversionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_308a534f49995d56, []int{2}
+}
+func (m *ConversionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionResult.Merge(m, src)
+}
+func (m *ConversionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionResult proto.InternalMessageInfo
+
+func (m *ConversionResult) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *ConversionResult) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ConversionResult) GetDustRefunded() types.Coin {
+	if m != nil {
+		return m.DustRefunded
+	}
+	return types.Coin{}
+}
+
+func (m *ConversionResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // MsgConvertVouchersResponse defines the ConvertVouchers response type.
 type MsgConvertVouchersResponse struct {
+	Results []ConversionResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// the evm gas used by the calls of the module
+	EvmGasUsed uint64 `protobuf:"varint,2,opt,name=evm_gas_used,json=evmGasUsed,proto3" json:"evm_gas_used,omitempty"`
 }
 
 func (m *MsgConvertVouchersResponse) Reset()         { *m = MsgConvertVouchersResponse{} }
 func (m *MsgConvertVouchersResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertVouchersResponse) ProtoMessage()    {}
 func (*MsgConvertVouchersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28e09e4eabb18884, []int{2}
+	return fileDescriptor_308a534f49995d56, []int{3}
 }
 func (m *MsgConvertVouchersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -181,15 +268,31 @@ func (m *MsgConvertVouchersResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertVouchersResponse proto.InternalMessageInfo
 
+func (m *MsgConvertVouchersResponse) GetResults() []ConversionResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *MsgConvertVouchersResponse) GetEvmGasUsed() uint64 {
+	if m != nil {
+		return m.EvmGasUsed
+	}
+	return 0
+}
+
 // MsgTransferTokensResponse defines the TransferTokens response type.
 type MsgTransferTokensResponse struct {
+	// the evm gas used by the calls of the module
+	EvmGasUsed uint64 `protobuf:"varint,1,opt,name=evm_gas_used,json=evmGasUsed,proto3" json:"evm_gas_used,omitempty"`
 }
 
 func (m *MsgTransferTokensResponse) Reset()         { *m = MsgTransferTokensResponse{} }
 func (m *MsgTransferTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferTokensResponse) ProtoMessage()    {}
 func (*MsgTransferTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28e09e4eabb18884, []int{3}
+	return fileDescriptor_308a534f49995d56, []int{4}
 }
 func (m *MsgTransferTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,6 +321,13 @@ func (m *MsgTransferTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTransferTokensResponse proto.InternalMessageInfo
 
+func (m *MsgTransferTokensResponse) GetEvmGasUsed() uint64 {
+	if m != nil {
+		return m.EvmGasUsed
+	}
+	return 0
+}
+
 // MsgUpdateTokenMapping defines the request type
 type MsgUpdateTokenMapping struct {
 	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -229,7 +339,7 @@ func (m *MsgUpdateTokenMapping) Reset()         { *m = MsgUpdateTokenMapping{} }
 func (m *MsgUpdateTokenMapping) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateTokenMapping) ProtoMessage()    {}
 func (*MsgUpdateTokenMapping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28e09e4eabb18884, []int{4}
+	return fileDescriptor_308a534f49995d56, []int{5}
 }
 func (m *MsgUpdateTokenMapping) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -281,13 +391,15 @@ func (m *MsgUpdateTokenMapping) GetContract() string {
 
 // MsgUpdateTokenMappingResponse defines the response type
 type MsgUpdateTokenMappingResponse struct {
+	// the evm gas used by the calls of the module
+	EvmGasUsed uint64 `protobuf:"varint,1,opt,name=evm_gas_used,json=evmGasUsed,proto3" json:"evm_gas_used,omitempty"`
 }
 
 func (m *MsgUpdateTokenMappingResponse) Reset()         { *m = MsgUpdateTokenMappingResponse{} }
 func (m *MsgUpdateTokenMappingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateTokenMappingResponse) ProtoMessage()    {}
 func (*MsgUpdateTokenMappingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28e09e4eabb18884, []int{5}
+	return fileDescriptor_308a534f49995d56, []int{6}
 }
 func (m *MsgUpdateTokenMappingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,48 +428,181 @@ func (m *MsgUpdateTokenMappingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateTokenMappingResponse proto.InternalMessageInfo
 
+func (m *MsgUpdateTokenMappingResponse) GetEvmGasUsed() uint64 {
+	if m != nil {
+		return m.EvmGasUsed
+	}
+	return 0
+}
+
+// MsgConvertSRC20 represents a message to convert the SRC20 tokens held by the hex address of the signer
+// back to native coins, the tokens are burnt by the module without any ethereum signature.
+type MsgConvertSRC20 struct {
+	Address  string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Contract string                                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *MsgConvertSRC20) Reset()         { *m = MsgConvertSRC20{} }
+func (m *MsgConvertSRC20) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertSRC20) ProtoMessage()    {}
+func (*MsgConvertSRC20) Descriptor() ([]byte, []int) {
+	return fileDescriptor_308a534f49995d56, []int{7}
+}
+func (m *MsgConvertSRC20) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertSRC20) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertSRC20.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertSRC20) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertSRC20.Merge(m, src)
+}
+func (m *MsgConvertSRC20) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertSRC20) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertSRC20.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertSRC20 proto.InternalMessageInfo
+
+func (m *MsgConvertSRC20) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgConvertSRC20) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// MsgConvertSRC20Response defines the ConvertSRC20 response type.
+type MsgConvertSRC20Response struct {
+	// the native coin received by the signer
+	Coin types.Coin `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin"`
+	// the evm gas used by the calls of the module
+	EvmGasUsed uint64 `protobuf:"varint,2,opt,name=evm_gas_used,json=evmGasUsed,proto3" json:"evm_gas_used,omitempty"`
+}
+
+func (m *MsgConvertSRC20Response) Reset()         { *m = MsgConvertSRC20Response{} }
+func (m *MsgConvertSRC20Response) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertSRC20Response) ProtoMessage()    {}
+func (*MsgConvertSRC20Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_308a534f49995d56, []int{8}
+}
+func (m *MsgConvertSRC20Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertSRC20Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertSRC20Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertSRC20Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertSRC20Response.Merge(m, src)
+}
+func (m *MsgConvertSRC20Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertSRC20Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertSRC20Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertSRC20Response proto.InternalMessageInfo
+
+func (m *MsgConvertSRC20Response) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *MsgConvertSRC20Response) GetEvmGasUsed() uint64 {
+	if m != nil {
+		return m.EvmGasUsed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgConvertVouchers)(nil), "seele.MsgConvertVouchers")
 	proto.RegisterType((*MsgTransferTokens)(nil), "seele.MsgTransferTokens")
+	proto.RegisterType((*ConversionResult)(nil), "seele.ConversionResult")
 	proto.RegisterType((*MsgConvertVouchersResponse)(nil), "seele.MsgConvertVouchersResponse")
 	proto.RegisterType((*MsgTransferTokensResponse)(nil), "seele.MsgTransferTokensResponse")
 	proto.RegisterType((*MsgUpdateTokenMapping)(nil), "seele.MsgUpdateTokenMapping")
 	proto.RegisterType((*MsgUpdateTokenMappingResponse)(nil), "seele.MsgUpdateTokenMappingResponse")
-}
-
-func init() { proto.RegisterFile("seele/tx.proto", fileDescriptor_28e09e4eabb18884) }
-
-var fileDescriptor_28e09e4eabb18884 = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x63, 0x87, 0x06, 0x18, 0xa4, 0x56, 0xac, 0x0a, 0x72, 0x0c, 0x75, 0x8a, 0x25, 0xa4,
-	0x5c, 0x62, 0x93, 0xf2, 0x06, 0xed, 0x11, 0x19, 0x89, 0xa8, 0x70, 0xe8, 0x6d, 0x63, 0x6f, 0x37,
-	0x56, 0x95, 0x1d, 0x6b, 0x67, 0x5b, 0xb5, 0x77, 0x1e, 0x00, 0xae, 0x3c, 0x02, 0x4f, 0xd2, 0x63,
-	0x8f, 0x9c, 0x00, 0x25, 0x2f, 0x82, 0xb2, 0x6b, 0x87, 0xd0, 0x34, 0xdc, 0x7a, 0xda, 0x99, 0xf9,
-	0x34, 0x33, 0xff, 0xec, 0xce, 0xc2, 0x4e, 0xae, 0x51, 0x21, 0xa5, 0xe6, 0x32, 0xa9, 0x34, 0x1a,
-	0x64, 0x1d, 0x17, 0x08, 0x77, 0x25, 0x4a, 0xb4, 0xa1, 0x74, 0x61, 0x39, 0x1a, 0x46, 0x39, 0xd2,
-	0x14, 0x29, 0x1d, 0x73, 0x12, 0xe9, 0xc5, 0x70, 0x2c, 0x0c, 0x1f, 0xa6, 0x39, 0x96, 0xca, 0xf1,
-	0xf8, 0xab, 0x07, 0x2c, 0x23, 0x79, 0x84, 0xea, 0x42, 0x68, 0xf3, 0x09, 0xcf, 0xf3, 0x89, 0xd0,
-	0xc4, 0x02, 0x78, 0xc8, 0x8b, 0x42, 0x0b, 0xa2, 0xc0, 0xdb, 0xf7, 0xfa, 0x8f, 0x47, 0x8d, 0xcb,
-	0x38, 0x6c, 0x2d, 0xd2, 0x29, 0xf0, 0xf7, 0xdb, 0xfd, 0x27, 0x07, 0xdd, 0xc4, 0x35, 0x48, 0x16,
-	0x0d, 0x92, 0xba, 0x41, 0x72, 0x84, 0xa5, 0x3a, 0x7c, 0x73, 0xfd, 0xb3, 0xd7, 0xfa, 0xfe, 0xab,
-	0xd7, 0x97, 0xa5, 0x99, 0x9c, 0x8f, 0x93, 0x1c, 0xa7, 0x69, 0xad, 0xc6, 0x1d, 0x03, 0x2a, 0xce,
-	0x52, 0x73, 0x55, 0x09, 0xb2, 0x09, 0x34, 0x72, 0x95, 0xe3, 0x6f, 0x1e, 0x3c, 0xcd, 0x48, 0x1e,
-	0x6b, 0xae, 0xe8, 0x54, 0xe8, 0x63, 0x3c, 0x13, 0x8a, 0x18, 0x83, 0x07, 0xa7, 0x1a, 0xa7, 0xb5,
-	0x1e, 0x6b, 0xb3, 0x6d, 0xf0, 0x0d, 0x06, 0xbe, 0x8d, 0xf8, 0x06, 0xff, 0x8a, 0x6b, 0xdf, 0x9b,
-	0xb8, 0x97, 0x10, 0xae, 0xdf, 0xd7, 0x48, 0x50, 0x85, 0x8a, 0x44, 0xfc, 0x02, 0xba, 0x6b, 0xca,
-	0x97, 0x90, 0xc3, 0xb3, 0x8c, 0xe4, 0xc7, 0xaa, 0xe0, 0x46, 0x58, 0x94, 0xf1, 0xaa, 0x2a, 0x95,
-	0x64, 0xcf, 0xa1, 0x43, 0x42, 0x15, 0x42, 0xd7, 0xc3, 0xd5, 0x1e, 0xdb, 0x85, 0xad, 0x42, 0x28,
-	0x9c, 0xd6, 0x13, 0x3a, 0x87, 0x85, 0xf0, 0x28, 0x47, 0x65, 0x34, 0xcf, 0x4d, 0xd0, 0xb6, 0x60,
-	0xe9, 0xc7, 0x3d, 0xd8, 0xbb, 0xb3, 0x45, 0xa3, 0xe1, 0xe0, 0xb3, 0x0f, 0xed, 0x8c, 0x24, 0xfb,
-	0x00, 0x3b, 0xb7, 0xdf, 0x3c, 0x4c, 0xdc, 0x26, 0x25, 0xeb, 0xf3, 0x85, 0xf1, 0x66, 0xd6, 0x94,
-	0x66, 0xef, 0x61, 0xfb, 0xd6, 0x93, 0x75, 0x57, 0xb2, 0xfe, 0x45, 0xe1, 0xab, 0x8d, 0x68, 0x59,
-	0xef, 0x04, 0xd8, 0x1d, 0x77, 0xb5, 0xb7, 0x92, 0xb8, 0x8e, 0xc3, 0xd7, 0xff, 0xc5, 0x4d, 0xed,
-	0xc3, 0x77, 0xd7, 0xb3, 0xc8, 0xbb, 0x99, 0x45, 0xde, 0xef, 0x59, 0xe4, 0x7d, 0x99, 0x47, 0xad,
-	0x9b, 0x79, 0xd4, 0xfa, 0x31, 0x8f, 0x5a, 0x27, 0xc3, 0xd5, 0x85, 0xd0, 0x57, 0x95, 0xc1, 0x01,
-	0x6a, 0x39, 0xc8, 0x27, 0xbc, 0x54, 0x69, 0xfd, 0xf7, 0x2e, 0x1b, 0xc3, 0xee, 0xc7, 0xb8, 0x63,
-	0xbf, 0xd2, 0xdb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0x34, 0x86, 0x15, 0x9b, 0x03, 0x00,
-	0x00,
+	proto.RegisterType((*MsgConvertSRC20)(nil), "seele.MsgConvertSRC20")
+	proto.RegisterType((*MsgConvertSRC20Response)(nil), "seele.MsgConvertSRC20Response")
+}
+
+func init() { proto.RegisterFile("seele/tx.proto", fileDescriptor_308a534f49995d56) }
+
+var fileDescriptor_308a534f49995d56 = []byte{
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4b, 0x6f, 0xd3, 0x5a,
+	0x10, 0x8e, 0xf3, 0xe8, 0x63, 0xfa, 0xba, 0xf7, 0xa8, 0xb7, 0x75, 0xad, 0x7b, 0x9d, 0xdc, 0x08,
+	0x41, 0x36, 0xd8, 0x7d, 0x2c, 0x58, 0xb1, 0xa0, 0x85, 0x22, 0x24, 0x22, 0x24, 0xd3, 0x22, 0xc4,
+	0x26, 0x72, 0xe2, 0x89, 0x1b, 0xb5, 0x3e, 0x27, 0x3a, 0x73, 0x1c, 0xca, 0x2f, 0x60, 0x09, 0x6b,
+	0x7e, 0x02, 0x4b, 0x7e, 0x04, 0xea, 0xb2, 0x4b, 0xc4, 0xa2, 0xa0, 0xf6, 0x8f, 0x20, 0x1f, 0xbb,
+	0x89, 0x92, 0xf4, 0x45, 0x25, 0x56, 0xf1, 0xcc, 0xf8, 0x3b, 0xdf, 0x37, 0x33, 0x5f, 0x7c, 0x60,
+	0x9e, 0x10, 0x0f, 0xd0, 0x55, 0x87, 0x4e, 0x57, 0x0a, 0x25, 0x58, 0x49, 0xc7, 0xd6, 0x62, 0x28,
+	0x42, 0xa1, 0x33, 0x6e, 0xf2, 0x94, 0x16, 0x2d, 0xbb, 0x25, 0x28, 0x12, 0xe4, 0x36, 0x7d, 0x42,
+	0xb7, 0xb7, 0xd6, 0x44, 0xe5, 0xaf, 0xb9, 0x2d, 0xd1, 0xe1, 0x69, 0xbd, 0xfa, 0xc5, 0x00, 0x56,
+	0xa7, 0x70, 0x4b, 0xf0, 0x1e, 0x4a, 0xf5, 0x4a, 0xc4, 0xad, 0x3d, 0x94, 0xc4, 0x4c, 0x98, 0xf4,
+	0x83, 0x40, 0x22, 0x91, 0x69, 0x54, 0x8c, 0xda, 0xb4, 0x77, 0x1e, 0x32, 0x1f, 0x4a, 0x09, 0x9c,
+	0xcc, 0x7c, 0xa5, 0x50, 0x9b, 0x59, 0x5f, 0x71, 0x52, 0x02, 0x27, 0x21, 0x70, 0x32, 0x02, 0x67,
+	0x4b, 0x74, 0xf8, 0xe6, 0xea, 0xd1, 0x49, 0x39, 0xf7, 0xf9, 0x47, 0xb9, 0x16, 0x76, 0xd4, 0x5e,
+	0xdc, 0x74, 0x5a, 0x22, 0x72, 0x33, 0x35, 0xe9, 0xcf, 0x7d, 0x0a, 0xf6, 0x5d, 0xf5, 0xae, 0x8b,
+	0xa4, 0x01, 0xe4, 0xa5, 0x27, 0xb3, 0x32, 0xcc, 0x34, 0x91, 0x54, 0x03, 0xdb, 0x6d, 0x21, 0x95,
+	0x59, 0xa8, 0x18, 0xb5, 0x29, 0x0f, 0x92, 0xd4, 0x13, 0x9d, 0xa9, 0x7e, 0x32, 0xe0, 0xef, 0x3a,
+	0x85, 0x3b, 0xd2, 0xe7, 0xd4, 0x46, 0xb9, 0x23, 0xf6, 0x91, 0x13, 0x63, 0x50, 0x6c, 0x4b, 0x11,
+	0x65, 0x82, 0xf5, 0x33, 0x9b, 0x87, 0xbc, 0x12, 0x66, 0x5e, 0x67, 0xf2, 0x4a, 0x0c, 0xd4, 0x17,
+	0xfe, 0x94, 0xfa, 0xea, 0xfb, 0x3c, 0xfc, 0x95, 0x8e, 0x93, 0x3a, 0x82, 0x7b, 0x48, 0xf1, 0x81,
+	0x62, 0x1b, 0x50, 0x4c, 0xaa, 0x5a, 0xdb, 0x95, 0xb4, 0xc5, 0x84, 0xd6, 0xd3, 0x2f, 0x33, 0x0b,
+	0xa6, 0x5a, 0x82, 0x2b, 0xe9, 0xb7, 0x54, 0xd6, 0x42, 0x3f, 0x66, 0xdb, 0x30, 0x11, 0x75, 0xb8,
+	0xc2, 0x40, 0x8f, 0x67, 0x7a, 0xd3, 0x49, 0x70, 0xdf, 0x4f, 0xca, 0x77, 0x6f, 0x20, 0xf7, 0x19,
+	0x57, 0x5e, 0x86, 0x66, 0x8f, 0x61, 0x2e, 0x88, 0x49, 0x35, 0x24, 0xb6, 0x63, 0x1e, 0x60, 0x60,
+	0x16, 0x6f, 0xa6, 0x70, 0x36, 0x41, 0x79, 0x19, 0x88, 0x2d, 0x42, 0x09, 0xa5, 0x14, 0xd2, 0x2c,
+	0x69, 0x99, 0x69, 0x50, 0x7d, 0x0b, 0xd6, 0xb8, 0xb5, 0x3c, 0xa4, 0xae, 0xe0, 0x84, 0xec, 0x01,
+	0x4c, 0x4a, 0x3d, 0x9c, 0xc4, 0x62, 0xc9, 0x32, 0x96, 0x1d, 0x6d, 0x64, 0x67, 0x74, 0x78, 0x19,
+	0xe3, 0xf9, 0xdb, 0xac, 0x02, 0xb3, 0xd8, 0x8b, 0x1a, 0xa1, 0x4f, 0x8d, 0x98, 0x30, 0xd0, 0xa3,
+	0x29, 0x7a, 0x80, 0xbd, 0xe8, 0xa9, 0x4f, 0xbb, 0x84, 0x41, 0xf5, 0x21, 0xac, 0x8c, 0xd9, 0xa3,
+	0xcf, 0x3b, 0x0a, 0x37, 0xc6, 0xe0, 0x3e, 0xfc, 0x53, 0xa7, 0x70, 0xb7, 0x1b, 0xf8, 0x0a, 0x35,
+	0xb8, 0xee, 0x77, 0xbb, 0x1d, 0x1e, 0xb2, 0x25, 0x98, 0x20, 0xe4, 0x01, 0xca, 0xcc, 0x63, 0x59,
+	0x94, 0xb4, 0x1f, 0x20, 0x17, 0x51, 0xb6, 0xa5, 0x34, 0x18, 0x5a, 0x5f, 0x61, 0x78, 0x7d, 0xd5,
+	0x47, 0xf0, 0xdf, 0x85, 0x14, 0xbf, 0xa1, 0xf2, 0x83, 0x01, 0x0b, 0x83, 0xf1, 0xbe, 0xf4, 0xb6,
+	0xd6, 0x57, 0xaf, 0xf8, 0xdb, 0x5e, 0xe3, 0x25, 0x3f, 0x12, 0x31, 0x57, 0xb7, 0xf5, 0x52, 0x8a,
+	0xae, 0x76, 0x61, 0x79, 0x44, 0x50, 0xbf, 0x9d, 0x5b, 0xf9, 0xff, 0xda, 0x45, 0xaf, 0x7f, 0xcd,
+	0x43, 0xa1, 0x4e, 0x21, 0x7b, 0x01, 0x0b, 0xa3, 0x5f, 0xb0, 0x95, 0xcc, 0x4d, 0xe3, 0x0e, 0xb4,
+	0xfe, 0xbf, 0xb4, 0xd4, 0xd7, 0xfb, 0x1c, 0xe6, 0x47, 0xbe, 0x2e, 0xe6, 0x00, 0x34, 0x5c, 0xb1,
+	0x2a, 0x97, 0x55, 0xfa, 0xa7, 0xbd, 0x06, 0x76, 0x81, 0x9b, 0xfe, 0x1d, 0xe0, 0xc6, 0xab, 0xd6,
+	0x9d, 0xab, 0xaa, 0xfd, 0x93, 0xb7, 0x61, 0x76, 0xc8, 0x00, 0x4b, 0x63, 0xad, 0xe9, 0xbc, 0x65,
+	0x5f, 0x9c, 0x3f, 0x3f, 0x67, 0xf3, 0xde, 0xd1, 0xa9, 0x6d, 0x1c, 0x9f, 0xda, 0xc6, 0xcf, 0x53,
+	0xdb, 0xf8, 0x78, 0x66, 0xe7, 0x8e, 0xcf, 0xec, 0xdc, 0xb7, 0x33, 0x3b, 0xf7, 0x66, 0xee, 0xd0,
+	0xcd, 0xee, 0x9b, 0x64, 0xdf, 0xcd, 0x09, 0x7d, 0x6d, 0x6c, 0xfc, 0x1a, 0x00, 0x07, 0xf9, 0xeb,
+	0xfb, 0x85, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -378,6 +623,9 @@ type MsgClient interface {
 	TransferTokens(ctx context.Context, in *MsgTransferTokens, opts ...grpc.CallOption) (*MsgTransferTokensResponse, error)
 	// UpdateTokenMapping defines a method to update token mapping
 	UpdateTokenMapping(ctx context.Context, in *MsgUpdateTokenMapping, opts ...grpc.CallOption) (*MsgUpdateTokenMappingResponse, error)
+	// ConvertSRC20 defines a method for converting the SRC20 tokens held by the hex address of the signer
+	// back to native coins
+	ConvertSRC20(ctx context.Context, in *MsgConvertSRC20, opts ...grpc.CallOption) (*MsgConvertSRC20Response, error)
 }
 
 type msgClient struct {
@@ -415,6 +663,15 @@ func (c *msgClient) UpdateTokenMapping(ctx context.Context, in *MsgUpdateTokenMa
 	return out, nil
 }
 
+func (c *msgClient) ConvertSRC20(ctx context.Context, in *MsgConvertSRC20, opts ...grpc.CallOption) (*MsgConvertSRC20Response, error) {
+	out := new(MsgConvertSRC20Response)
+	err := c.cc.Invoke(ctx, "/seele.Msg/ConvertSRC20", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConvertVouchers defines a method for converting ibc voucher to seele evm coins.
@@ -423,6 +680,9 @@ type MsgServer interface {
 	TransferTokens(context.Context, *MsgTransferTokens) (*MsgTransferTokensResponse, error)
 	// UpdateTokenMapping defines a method to update token mapping
 	UpdateTokenMapping(context.Context, *MsgUpdateTokenMapping) (*MsgUpdateTokenMappingResponse, error)
+	// ConvertSRC20 defines a method for converting the SRC20 tokens held by the hex address of the signer
+	// back to native coins
+	ConvertSRC20(context.Context, *MsgConvertSRC20) (*MsgConvertSRC20Response, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -438,6 +698,9 @@ func (*UnimplementedMsgServer) TransferTokens(ctx context.Context, req *MsgTrans
 func (*UnimplementedMsgServer) UpdateTokenMapping(ctx context.Context, req *MsgUpdateTokenMapping) (*MsgUpdateTokenMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTokenMapping not implemented")
 }
+func (*UnimplementedMsgServer) ConvertSRC20(ctx context.Context, req *MsgConvertSRC20) (*MsgConvertSRC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertSRC20 not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -497,6 +760,24 @@ func _Msg_UpdateTokenMapping_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConvertSRC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertSRC20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertSRC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seele.Msg/ConvertSRC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertSRC20(ctx, req.(*MsgConvertSRC20))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "seele.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -513,6 +794,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTokenMapping",
 			Handler:    _Msg_UpdateTokenMapping_Handler,
 		},
+		{
+			MethodName: "ConvertSRC20",
+			Handler:    _Msg_ConvertSRC20_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "seele/tx.proto",
@@ -538,6 +823,16 @@ func (m *MsgConvertVouchers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BestEffort {
+		i--
+		if m.BestEffort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -613,6 +908,73 @@ func (m *MsgTransferTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConversionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.DustRefunded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgConvertVouchersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -633,6 +995,25 @@ func (m *MsgConvertVouchersResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.EvmGasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EvmGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -656,6 +1037,11 @@ func (m *MsgTransferTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.EvmGasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EvmGasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -723,58 +1109,174 @@ func (m *MsgUpdateTokenMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.EvmGasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EvmGasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgConvertSRC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgConvertVouchers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+
+func (m *MsgConvertSRC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTransferTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConvertSRC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertSRC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertSRC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertSRC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EvmGasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EvmGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertVouchers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.BestEffort {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTransferTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConversionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.DustRefunded.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
@@ -785,6 +1287,15 @@ func (m *MsgConvertVouchersResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EvmGasUsed != 0 {
+		n += 1 + sovTx(uint64(m.EvmGasUsed))
+	}
 	return n
 }
 
@@ -794,6 +1305,9 @@ func (m *MsgTransferTokensResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.EvmGasUsed != 0 {
+		n += 1 + sovTx(uint64(m.EvmGasUsed))
+	}
 	return n
 }
 
@@ -824,6 +1338,42 @@ func (m *MsgUpdateTokenMappingResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.EvmGasUsed != 0 {
+		n += 1 + sovTx(uint64(m.EvmGasUsed))
+	}
+	return n
+}
+
+func (m *MsgConvertSRC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConvertSRC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.EvmGasUsed != 0 {
+		n += 1 + sovTx(uint64(m.EvmGasUsed))
+	}
 	return n
 }
 
@@ -833,7 +1383,505 @@ func sovTx(x uint64) (n int) {
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgConvertVouchers) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertVouchers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVouchers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestEffort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BestEffort = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustRefunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DustRefunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertVouchersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -856,17 +1904,17 @@ func (m *MsgConvertVouchers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVouchers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertVouchersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -876,29 +1924,31 @@ func (m *MsgConvertVouchers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Results = append(m.Results, ConversionResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmGasUsed", wireType)
 			}
-			var msglen int
+			m.EvmGasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -908,26 +1958,80 @@ func (m *MsgConvertVouchers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EvmGasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmGasUsed", wireType)
+			}
+			m.EvmGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -949,7 +2053,7 @@ func (m *MsgConvertVouchers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -972,15 +2076,15 @@ func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTokenMapping: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTokenMapping: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1008,11 +2112,11 @@ func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1040,13 +2144,13 @@ func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1056,25 +2160,23 @@ func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1097,7 +2199,7 @@ func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertVouchersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTokenMappingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1120,62 +2222,31 @@ func (m *MsgConvertVouchersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVouchersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTokenMappingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgTransferTokensResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmGasUsed", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EvmGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferTokensResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1197,7 +2268,7 @@ func (m *MsgTransferTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertSRC20) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1220,15 +2291,15 @@ func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTokenMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertSRC20: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTokenMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertSRC20: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1256,11 +2327,11 @@ func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1288,11 +2359,11 @@ func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1320,7 +2391,9 @@ func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1343,7 +2416,7 @@ func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTokenMappingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertSRC20Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1366,12 +2439,64 @@ func (m *MsgUpdateTokenMappingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTokenMappingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertSRC20Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertSRC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmGasUsed", wireType)
+			}
+			m.EvmGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1,8 +1,12 @@
 package types
 
 import (
+	"fmt"
 	"math/big"
 	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var (
@@ -10,6 +14,13 @@ var (
 	TenPowTen = Ten.Exp(Ten, Ten, nil)
 )
 
+const (
+	// MainnetBech32Prefix defines the Bech32 prefix of the mainnet accounts
+	MainnetBech32Prefix = "seele"
+	// TestnetBech32Prefix defines the Bech32 prefix of the testnet accounts
+	TestnetBech32Prefix = "tseele"
+)
+
 const (
 	ibcDenomPrefix     = "ibc/"
 	ibcDenomLen        = len(ibcDenomPrefix) + 64
@@ -32,3 +43,153 @@ func IsValidGravityDenom(denom string) bool {
 func IsValidDenomToWrap(denom string) bool {
 	return IsValidIBCDenom(denom) || IsValidGravityDenom(denom) || denom == "snp"
 }
+
+// TransferOriginOfDenom returns the bridge the vouchers of a denom come from, unspecified for the native denoms
+func TransferOriginOfDenom(denom string) TransferOrigin {
+	switch {
+	case IsValidIBCDenom(denom):
+		return TransferOriginIBC
+	case IsValidGravityDenom(denom):
+		return TransferOriginGravity
+	default:
+		return TransferOriginUnspecified
+	}
+}
+
+// AddressBytes decodes a hex or bech32 address of any prefix,
+// so the same account is found whatever the encoding used.
+func AddressBytes(address string) ([]byte, bool) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address).Bytes(), true
+	}
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil || len(bz) == 0 || len(bz) > 255 {
+		return nil, false
+	}
+	return bz, true
+}
+
+// TransferStatus returns the status of the transfer ledger record matching the ethereum transfer status
+func (s EthereumTransferStatus) TransferStatus() TransferStatus {
+	switch s {
+	case EthereumTransferStatusQueued, EthereumTransferStatusPending, EthereumTransferStatusBatched:
+		return TransferStatusPending
+	case EthereumTransferStatusCompleted:
+		return TransferStatusCompleted
+	case EthereumTransferStatusRefunded:
+		return TransferStatusRefunded
+	case EthereumTransferStatusFailed:
+		return TransferStatusFailed
+	default:
+		return TransferStatusUnspecified
+	}
+}
+
+// Validate performs a stateless validation of a transfer to ethereum, the transfers waiting in the gravity module
+// must have an outgoing transaction and the batched ones a batch nonce.
+func (t EthereumTransfer) Validate() error {
+	if t.Id == 0 {
+		return fmt.Errorf("invalid ethereum transfer id 0")
+	}
+	for _, addr := range []string{t.Sender, t.BridgeContract, t.TokenContract, t.EthereumRecipient} {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid address %s of ethereum transfer %d", addr, t.Id)
+		}
+	}
+	if err := t.Amount.Validate(); err != nil || !t.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s of ethereum transfer %d", t.Amount, t.Id)
+	}
+	if err := t.BridgeFee.Validate(); err != nil || t.BridgeFee.Denom != t.Amount.Denom {
+		return fmt.Errorf("invalid bridge fee %s of ethereum transfer %d", t.BridgeFee, t.Id)
+	}
+	switch t.Status {
+	case EthereumTransferStatusQueued, EthereumTransferStatusFailed, EthereumTransferStatusRefunded:
+	case EthereumTransferStatusPending, EthereumTransferStatusBatched, EthereumTransferStatusCompleted:
+		if !common.IsHexAddress(t.Erc20Contract) || t.OutgoingTxId == 0 {
+			return fmt.Errorf("ethereum transfer %d with status %s has no outgoing transaction", t.Id, t.Status)
+		}
+		if (t.Status == EthereumTransferStatusPending) != (t.BatchNonce == 0) {
+			return fmt.Errorf("invalid batch nonce %d of ethereum transfer %d with status %s", t.BatchNonce, t.Id, t.Status)
+		}
+	default:
+		return fmt.Errorf("invalid status %s of ethereum transfer %d", t.Status, t.Id)
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of a record of the transfer ledger
+func (r TransferRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("invalid transfer record id 0")
+	}
+	if r.Direction != TransferDirectionInbound && r.Direction != TransferDirectionOutbound {
+		return fmt.Errorf("invalid direction %s of transfer record %d", r.Direction, r.Id)
+	}
+	if _, ok := TransferOrigin_name[int32(r.Origin)]; !ok {
+		return fmt.Errorf("invalid origin %s of transfer record %d", r.Origin, r.Id)
+	}
+	if r.Status == TransferStatusUnspecified {
+		return fmt.Errorf("invalid status %s of transfer record %d", r.Status, r.Id)
+	}
+	if _, ok := TransferStatus_name[int32(r.Status)]; !ok {
+		return fmt.Errorf("invalid status %s of transfer record %d", r.Status, r.Id)
+	}
+	if err := r.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount %s of transfer record %d: %w", r.Amount, r.Id, err)
+	}
+	if r.UpdatedHeight < r.CreatedHeight {
+		return fmt.Errorf("transfer record %d updated at %d before its creation at %d", r.Id, r.UpdatedHeight, r.CreatedHeight)
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of a quarantined gravity deposit
+func (d QuarantinedDeposit) Validate() error {
+	if d.Id == 0 {
+		return fmt.Errorf("invalid quarantined deposit id 0")
+	}
+	for _, addr := range []string{d.TokenContract, d.EthereumSender} {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid address %s of quarantined deposit %d", addr, d.Id)
+		}
+	}
+	if err := d.Amount.Validate(); err != nil || !d.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s of quarantined deposit %d", d.Amount, d.Id)
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of a gravity deposit waiting for a token mapping
+func (d PendingTokenDeposit) Validate() error {
+	if d.Id == 0 {
+		return fmt.Errorf("invalid pending token deposit id 0")
+	}
+	for _, addr := range []string{d.TokenContract, d.Receiver} {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid address %s of pending token deposit %d", addr, d.Id)
+		}
+	}
+	if err := d.Amount.Validate(); err != nil || !d.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s of pending token deposit %d", d.Amount, d.Id)
+	}
+	return nil
+}
+
+// ParseCosmosReceiver parses the cosmos receiver of a gravity deposit,
+// it's either a hex address or a bech32 account address of the mainnet or the testnet.
+func ParseCosmosReceiver(receiver string) (common.Address, error) {
+	if common.IsHexAddress(receiver) {
+		return common.HexToAddress(receiver), nil
+	}
+	hrp, bz, err := bech32.DecodeAndConvert(receiver)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid receiver %s: %w", receiver, err)
+	}
+	if hrp != MainnetBech32Prefix && hrp != TestnetBech32Prefix {
+		return common.Address{}, fmt.Errorf("invalid receiver %s: unexpected prefix %s", receiver, hrp)
+	}
+	if len(bz) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("invalid receiver %s: unexpected length %d", receiver, len(bz))
+	}
+	return common.BytesToAddress(bz), nil
+}
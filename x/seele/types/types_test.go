@@ -1,8 +1,11 @@
 package types
 
 import (
-	"github.com/stretchr/testify/require"
 	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_IsValidIBCDenom(t *testing.T) {
@@ -23,6 +26,12 @@ func Test_IsValidIBCDenom(t *testing.T) {
 	}
 }
 
+func Test_TransferOriginOfDenom(t *testing.T) {
+	require.Equal(t, TransferOriginIBC, TransferOriginOfDenom(IbcCroDenomDefaultValue))
+	require.Equal(t, TransferOriginGravity, TransferOriginOfDenom("gravity0xb7a4F3E9097C08dA09517b5aB877F7a917224ede"))
+	require.Equal(t, TransferOriginUnspecified, TransferOriginOfDenom("snp"))
+}
+
 func Test_IsValidGravityDenom(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -61,3 +70,91 @@ func Test_IsValidDenomToWrap(t *testing.T) {
 		})
 	}
 }
+
+func Test_AddressBytes(t *testing.T) {
+	hex := "0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503"
+	bech32Addr, err := bech32.ConvertAndEncode("seele", common.HexToAddress(hex).Bytes())
+	require.NoError(t, err)
+	cosmosAddr, err := bech32.ConvertAndEncode("cosmos", common.HexToAddress(hex).Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		address string
+		success bool
+	}{
+		{"hex address", hex, true},
+		{"bech32 address", bech32Addr, true},
+		{"bech32 address of another chain", cosmosAddr, true},
+		{"invalid address", "0x123", false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			bz, ok := AddressBytes(tt.address)
+			require.Equal(t, tt.success, ok)
+			if tt.success {
+				require.Equal(t, common.HexToAddress(hex).Bytes(), bz)
+			}
+		})
+	}
+}
+
+func Test_ParseCosmosReceiver(t *testing.T) {
+	hex := "0x68542BD12B41F5D51D6282Ec7D91D7d0D78E4503"
+	mainnet, err := bech32.ConvertAndEncode("seele", common.HexToAddress(hex).Bytes())
+	require.NoError(t, err)
+	testnet, err := bech32.ConvertAndEncode("tseele", common.HexToAddress(hex).Bytes())
+	require.NoError(t, err)
+	cosmosAddr, err := bech32.ConvertAndEncode("cosmos", common.HexToAddress(hex).Bytes())
+	require.NoError(t, err)
+	longAddr, err := bech32.ConvertAndEncode("seele", make([]byte, 32))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		receiver string
+		success  bool
+	}{
+		{"hex address", hex, true},
+		{"mainnet bech32 address", mainnet, true},
+		{"testnet bech32 address", testnet, true},
+		{"bech32 address of another chain", cosmosAddr, false},
+		{"bech32 address of a module", longAddr, false},
+		{"invalid address", "0x123", false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := ParseCosmosReceiver(tt.receiver)
+			if tt.success {
+				require.NoError(t, err)
+				require.Equal(t, common.HexToAddress(hex), addr)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func Test_ModuleSRC20Address(t *testing.T) {
+	// the addresses must never change as they are known off-chain and shared by all the networks
+	tests := []struct {
+		name      string
+		denom     string
+		tokenName string
+		decimals  uint8
+		address   string
+	}{
+		{"snp", "snp", "snp", 18, "0xe99780BC38CC1eB8ba5d6D809CF1d4C84053095F"},
+		{"gravity usdt", "gravity0xdAC17F958D2ee523a2206206994597C13D831ec7", "USDT", 6, "0xaEA0Cc714AAC91076C65bC7682c2C62Ea8bA5484"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			address, err := ModuleSRC20Address(tt.denom, tt.tokenName, tt.decimals)
+			require.NoError(t, err)
+			require.Equal(t, common.HexToAddress(tt.address), address)
+		})
+	}
+}